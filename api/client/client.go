@@ -2,14 +2,22 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	httpclient "github.com/go-openapi/kvstore/gen/client"
 	"github.com/go-openapi/kvstore/gen/client/kv"
+	"github.com/go-openapi/kvstore/gen/client/sessions"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/patch"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/swag"
 )
@@ -20,9 +28,15 @@ func New(uri string) (*KvStore, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &KvStore{client: httpclient.New(httptransport.New(u.Host, u.Path, []string{u.Scheme}), nil)}, nil
+	transport := httptransport.New(u.Host, u.Path, []string{u.Scheme})
+	// the patch body is streamed as is, but the transport needs a producer for the media type
+	transport.Producers[patch.MergePatchMime] = runtime.JSONProducer()
+	return &KvStore{client: httpclient.New(transport, nil)}, nil
 }
 
+// ErrNotFound is returned when the entry doesn't exist
+var ErrNotFound = errors.New("entry not found")
+
 // KvStore wraps the swagger client for central handling of error cases etc
 type KvStore struct {
 	client *httpclient.Kvstore
@@ -38,7 +52,148 @@ func (k *KvStore) FindKeys(prefix string) ([]string, error) {
 	if err != nil {
 		return nil, err
 	}
-	return keys.Payload, nil
+	return listedKeys(keys.Payload), nil
+}
+
+// ListKeys for a given prefix, the keys that contain the delimiter after the prefix
+// are rolled up into a common prefix that ends with the delimiter.
+func (k *KvStore) ListKeys(prefix, delimiter string) ([]string, error) {
+	params := kv.NewFindKeysParams()
+	if prefix != "" {
+		params.SetPrefix(swag.String(prefix))
+	}
+	if delimiter != "" {
+		params.SetDelimiter(swag.String(delimiter))
+	}
+	keys, err := k.client.Kv.FindKeys(params)
+	if err != nil {
+		if e, ok := err.(*kv.FindKeysDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return listedKeys(keys.Payload), nil
+}
+
+// FindEntries lists the entries below the prefix that hold a JSON document matching the filter,
+// when fields is not empty the values only hold those fields. Either a filter or fields are required.
+func (k *KvStore) FindEntries(prefix, filter, fields string) ([]*models.Entry, error) {
+	if filter == "" && fields == "" {
+		return nil, errors.New("finding entries needs a filter or fields")
+	}
+	params := kv.NewFindKeysParams()
+	if prefix != "" {
+		params.SetPrefix(swag.String(prefix))
+	}
+	if filter != "" {
+		params.SetFilter(swag.String(filter))
+	}
+	if fields != "" {
+		params.SetFields(swag.String(fields))
+	}
+	listed, err := k.client.Kv.FindKeys(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.FindKeysBadRequest:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.FindKeysDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	entries := make([]*models.Entry, 0, len(listed.Payload))
+	for _, item := range listed.Payload {
+		entry, _ := item.(map[string]interface{})
+		key, _ := entry["key"].(string)
+		entries = append(entries, &models.Entry{Key: swag.String(key), Value: entry["value"]})
+	}
+	return entries, nil
+}
+
+// SelectKeys lists the keys that start with the prefix of the entries with labels that match the selector
+func (k *KvStore) SelectKeys(prefix, selector string) ([]string, error) {
+	params := kv.NewFindKeysParams().WithSelector(swag.String(selector))
+	if prefix != "" {
+		params.SetPrefix(swag.String(prefix))
+	}
+	keys, err := k.client.Kv.FindKeys(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.FindKeysBadRequest:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.FindKeysDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return listedKeys(keys.Payload), nil
+}
+
+// SetLabels replaces the labels of the entry at key and returns the new version of the entry,
+// when version is not 0 the entry needs to have that version
+func (k *KvStore) SetLabels(key string, labels map[string]string, version uint64) (uint64, error) {
+	params := kv.NewSetLabelsParams().WithKey(key).WithBody(&models.LabelSet{Labels: labels})
+	if version != 0 {
+		params.SetIfMatch(swag.String(strconv.FormatUint(version, 10)))
+	}
+	res, err := k.client.Kv.SetLabels(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.SetLabelsBadRequest:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.SetLabelsNotFound:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.SetLabelsConflict:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.SetLabelsDefault:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, e
+		}
+	}
+	return strconv.ParseUint(res.ETag, 10, 64)
+}
+
+// formatLabels formats the labels as a comma separated list of name=value pairs
+func formatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// parseLabels parses the comma separated list of name=value pairs the server sends
+func parseLabels(list string) map[string]string {
+	labels := make(map[string]string)
+	if list == "" {
+		return labels
+	}
+	for _, pair := range strings.Split(list, ",") {
+		if parts := strings.SplitN(pair, "=", 2); len(parts) == 2 {
+			labels[parts[0]] = parts[1]
+		}
+	}
+	return labels
+}
+
+// Stats for the entries with a key that starts with the prefix
+func (k *KvStore) Stats(prefix string) (*models.Stats, error) {
+	params := kv.NewGetStatsParams()
+	if prefix != "" {
+		params.SetPrefix(swag.String(prefix))
+	}
+	res, err := k.client.Kv.GetStats(params)
+	if err != nil {
+		if e, ok := err.(*kv.GetStatsDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
 }
 
 // Delete an entry from the store
@@ -50,13 +205,122 @@ func (k *KvStore) Delete(key string) error {
 	return nil
 }
 
+// DeletePrefix removes all the entries with a key that starts with the prefix,
+// it returns the number of entries that were removed.
+func (k *KvStore) DeletePrefix(prefix string) (int64, error) {
+	res, err := k.client.Kv.DeleteKeys(kv.NewDeleteKeysParams().WithPrefix(prefix).WithConfirm(true))
+	if err != nil {
+		if e, ok := err.(*kv.DeleteKeysDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return 0, fmt.Errorf("failed to delete prefix %q because: %v", prefix, err)
+	}
+	return swag.Int64Value(res.Payload.Deleted), nil
+}
+
+// Copy the entry at key to the destination key, when the version is not 0
+// the entry needs to have that version. It returns the version of the copy.
+func (k *KvStore) Copy(key, destination string, version uint64) (uint64, error) {
+	params := kv.NewCopyEntryParams().WithKey(key).WithDestination(destination)
+	if version != 0 {
+		params.SetIfMatch(swag.String(strconv.FormatUint(version, 10)))
+	}
+
+	_, created, err := k.client.Kv.CopyEntry(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.CopyEntryConflict:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.CopyEntryNotFound:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.CopyEntryGone:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.CopyEntryDefault:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, e
+		}
+	}
+	if created == nil {
+		return 0, nil
+	}
+	return strconv.ParseUint(created.ETag, 10, 64)
+}
+
+// Move the entry at key to the destination key, when the version is not 0
+// the entry needs to have that version. It returns the version of the moved entry.
+func (k *KvStore) Move(key, destination string, version uint64) (uint64, error) {
+	params := kv.NewMoveEntryParams().WithKey(key).WithDestination(destination)
+	if version != 0 {
+		params.SetIfMatch(swag.String(strconv.FormatUint(version, 10)))
+	}
+
+	_, created, err := k.client.Kv.MoveEntry(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.MoveEntryConflict:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.MoveEntryNotFound:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.MoveEntryGone:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.MoveEntryDefault:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, e
+		}
+	}
+	if created == nil {
+		return 0, nil
+	}
+	return strconv.ParseUint(created.ETag, 10, 64)
+}
+
+// MovePrefix moves all the entries below the prefix to the destination prefix,
+// it returns the number of entries that were moved.
+func (k *KvStore) MovePrefix(prefix, destination string) (int64, error) {
+	res, _, err := k.client.Kv.MoveEntry(kv.NewMoveEntryParams().WithKey(prefix).WithDestination(destination).WithPrefix(swag.Bool(true)))
+	if err != nil {
+		if e, ok := err.(*kv.MoveEntryDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return 0, fmt.Errorf("failed to move prefix %q because: %v", prefix, err)
+	}
+	if res == nil {
+		return 0, nil
+	}
+	return swag.Int64Value(res.Payload.Count), nil
+}
+
+// Incr adds the delta to the counter at key, when the counter doesn't exist yet
+// it starts at the initial value. It returns the new value of the counter.
+func (k *KvStore) Incr(key string, delta, initial int64) (int64, error) {
+	params := kv.NewIncrEntryParams().WithKey(key).WithDelta(swag.Int64(delta)).WithInitial(swag.Int64(initial))
+	res, err := k.client.Kv.IncrEntry(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.IncrEntryConflict:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.IncrEntryDefault:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, e
+		}
+	}
+	return swag.Int64Value(res.Payload.Value), nil
+}
+
 // Entry in the k/v store
 type Entry struct {
 	// Data the payload to save
 	Data []byte
 	// Version is required when this is an update
 	Version uint64
-	_       struct{}
+	// Session binds the entry to a session when it is not empty
+	Session string
+	// Labels of the entry, a put without labels keeps the labels of the entry
+	Labels map[string]string
+	_      struct{}
 }
 
 // Put an entry in the k/v store
@@ -66,6 +330,12 @@ func (k *KvStore) Put(key string, data *Entry) error {
 	if data.Version != 0 {
 		params.SetIfMatch(swag.String(strconv.FormatUint(data.Version, 10)))
 	}
+	if data.Session != "" {
+		params.SetSession(swag.String(data.Session))
+	}
+	if data.Labels != nil {
+		params.SetLabels(swag.String(formatLabels(data.Labels)))
+	}
 
 	created, updated, err := k.client.Kv.PutEntry(params)
 	if err != nil {
@@ -100,6 +370,66 @@ func (k *KvStore) Put(key string, data *Entry) error {
 	return nil
 }
 
+// MergePatch applies a JSON merge patch to the JSON document in the entry, when the version
+// of the entry is not 0 the entry needs to have that version. The version is updated after the patch.
+func (k *KvStore) MergePatch(key string, data *Entry) error {
+	params := kv.NewPatchEntryParams().WithKey(key).WithBody(ioutil.NopCloser(bytes.NewBuffer(data.Data)))
+	if data.Version != 0 {
+		params.SetIfMatch(swag.String(strconv.FormatUint(data.Version, 10)))
+	}
+
+	patched, err := k.client.Kv.PatchEntry(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.PatchEntryConflict:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.PatchEntryNotFound:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.PatchEntryDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+
+	v, err := strconv.ParseUint(patched.ETag, 10, 64)
+	if err != nil {
+		return err
+	}
+	data.Version = v
+	return nil
+}
+
+// Append the data to the entry, the entry is created when it doesn't exist yet. When the version
+// of the entry is not 0 the entry needs to have that version. The version is updated after the append.
+func (k *KvStore) Append(key string, data *Entry) error {
+	params := kv.NewAppendEntryParams().WithKey(key).WithBody(ioutil.NopCloser(bytes.NewBuffer(data.Data)))
+	if data.Version != 0 {
+		params.SetIfMatch(swag.String(strconv.FormatUint(data.Version, 10)))
+	}
+
+	appended, err := k.client.Kv.AppendEntry(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *kv.AppendEntryConflict:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.AppendEntryGone:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *kv.AppendEntryDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+
+	v, err := strconv.ParseUint(appended.ETag, 10, 64)
+	if err != nil {
+		return err
+	}
+	data.Version = v
+	return nil
+}
+
 // Get a value from the store, when the version not 0 it will use that to
 // get a not modified response.
 func (k *KvStore) Get(key string, version uint64) (*Entry, error) {
@@ -113,7 +443,7 @@ func (k *KvStore) Get(key string, version uint64) (*Entry, error) {
 	if err != nil {
 		switch e := err.(type) {
 		case *kv.GetEntryNotFound:
-			return nil, errors.New(swag.StringValue(e.Payload.Message))
+			return nil, ErrNotFound
 		case *kv.GetEntryNotModified:
 			return &Entry{Version: version}, nil
 		case *kv.GetEntryDefault:
@@ -125,6 +455,7 @@ func (k *KvStore) Get(key string, version uint64) (*Entry, error) {
 
 	entry := new(Entry)
 	entry.Data = data.Bytes()
+	entry.Labels = parseLabels(value.XLabels)
 	if value.ETag != "" {
 		v, err := strconv.ParseUint(value.ETag, 10, 64)
 		if err != nil {
@@ -134,3 +465,82 @@ func (k *KvStore) Get(key string, version uint64) (*Entry, error) {
 	}
 	return entry, nil
 }
+
+// CreateSession creates a session that stays alive as long as it gets renewed within the ttl,
+// the behavior decides if the entries bound to the session get deleted or released when it ends.
+func (k *KvStore) CreateSession(name string, ttl time.Duration, behavior string) (*models.Session, error) {
+	body := &models.SessionRequest{
+		Name:     name,
+		TTL:      swag.Int64(int64(ttl / time.Second)),
+		Behavior: behavior,
+	}
+	res, err := k.client.Sessions.CreateSession(sessions.NewCreateSessionParams().WithBody(body))
+	if err != nil {
+		if e, ok := err.(*sessions.CreateSessionDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
+
+// RenewSession extends the life of the session by its ttl
+func (k *KvStore) RenewSession(id string) (*models.Session, error) {
+	res, err := k.client.Sessions.RenewSession(sessions.NewRenewSessionParams().WithID(id))
+	if err != nil {
+		switch e := err.(type) {
+		case *sessions.RenewSessionNotFound:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		case *sessions.RenewSessionDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
+
+// DestroySession ends the session, the entries bound to it get deleted or released
+func (k *KvStore) DestroySession(id string) error {
+	_, err := k.client.Sessions.DestroySession(sessions.NewDestroySessionParams().WithID(id))
+	if err != nil {
+		switch e := err.(type) {
+		case *sessions.DestroySessionNotFound:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *sessions.DestroySessionDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+	return nil
+}
+
+// KeepAlive renews the session every interval until the context is done,
+// it returns the error when renewing the session fails.
+func (k *KvStore) KeepAlive(ctx context.Context, id string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if _, err := k.RenewSession(id); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// listedKeys turns the items of a listing of keys into strings
+func listedKeys(items []interface{}) []string {
+	keys := make([]string, 0, len(items))
+	for _, item := range items {
+		if key, ok := item.(string); ok {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
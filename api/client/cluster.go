@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+
+	"github.com/go-openapi/kvstore/gen/client/cluster"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// ErrMembershipConflict is returned when the member exists or the previous membership change is not committed yet
+var ErrMembershipConflict = errors.New("the member exists or the previous membership change is not committed yet")
+
+// ClusterStatus reports the raft state of the member and the members of its cluster
+func (k *KvStore) ClusterStatus() (*models.ClusterStatus, error) {
+	res, err := k.client.Cluster.GetClusterStatus(cluster.NewGetClusterStatusParams())
+	if err != nil {
+		if e, ok := err.(*cluster.GetClusterStatusDefault); ok {
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
+
+// AddClusterMember adds a member to the cluster
+func (k *KvStore) AddClusterMember(id, peerAddress, apiURL string) error {
+	member := &models.ClusterMember{ID: swag.String(id), PeerAddress: swag.String(peerAddress), APIURL: swag.String(apiURL)}
+	_, err := k.client.Cluster.AddClusterMember(cluster.NewAddClusterMemberParams().WithBody(member))
+	if err != nil {
+		switch e := err.(type) {
+		case *cluster.AddClusterMemberConflict:
+			return ErrMembershipConflict
+		case *cluster.AddClusterMemberDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+	return nil
+}
+
+// RemoveClusterMember removes a member from the cluster
+func (k *KvStore) RemoveClusterMember(id string) error {
+	_, err := k.client.Cluster.RemoveClusterMember(cluster.NewRemoveClusterMemberParams().WithID(id))
+	if err != nil {
+		switch e := err.(type) {
+		case *cluster.RemoveClusterMemberNotFound:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *cluster.RemoveClusterMemberConflict:
+			return ErrMembershipConflict
+		case *cluster.RemoveClusterMemberDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,298 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/kvstore/gen/client/elections"
+	"github.com/go-openapi/kvstore/gen/client/locks"
+	"github.com/go-openapi/kvstore/gen/models"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+)
+
+// ErrNotHeld is returned when releasing a lease that isn't held
+var ErrNotHeld = errors.New("the lease is not held")
+
+// leaseOps are the api calls behind a lease, the token is the one acquire returned
+type leaseOps struct {
+	acquire func(wait time.Duration) (int64, error)
+	renew   func(token int64) error
+	release func(token int64) error
+}
+
+// lease is acquired once and then kept alive in the background until it is released or lost
+type lease struct {
+	ops leaseOps
+	ttl time.Duration
+
+	mu    sync.Mutex
+	token int64
+	stop  chan struct{}
+	done  chan struct{}
+	lost  chan struct{}
+}
+
+func (l *lease) acquire(wait time.Duration) (int64, error) {
+	token, err := l.ops.acquire(wait)
+	if err != nil {
+		return 0, err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.stop != nil {
+		// acquired again while holding the lease, the server renewed it
+		return l.token, nil
+	}
+	l.token = token
+	l.stop = make(chan struct{})
+	l.done = make(chan struct{})
+	l.lost = make(chan struct{})
+	go l.keepAlive(l.token, l.stop, l.done, l.lost)
+	return l.token, nil
+}
+
+// keepAlive renews the lease three times per ttl, when renewing fails the lease is lost.
+// It closes done when it stops renewing.
+func (l *lease) keepAlive(token int64, stop, done, lost chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(l.ttl / 3)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if err := l.ops.renew(token); err != nil {
+				l.mu.Lock()
+				released := l.stop != stop
+				if !released {
+					l.stop = nil
+				}
+				l.mu.Unlock()
+				// a renewal that fails because of a release in the meantime doesn't lose the lease
+				if !released {
+					close(lost)
+				}
+				return
+			}
+		}
+	}
+}
+
+func (l *lease) release() error {
+	l.mu.Lock()
+	stop, done, token := l.stop, l.done, l.token
+	l.stop = nil
+	l.mu.Unlock()
+
+	if stop == nil {
+		return ErrNotHeld
+	}
+	close(stop)
+	// a renewal that is under way needs to finish first, otherwise it could renew the released lease
+	<-done
+	return l.ops.release(token)
+}
+
+// Token is the fencing token of the lease, it is 0 when the lease was never acquired
+func (l *lease) Token() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.token
+}
+
+// Lost is closed when the lease couldn't be renewed, it is nil when the lease was never acquired
+func (l *lease) Lost() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.lost
+}
+
+// the wait happens on the server, so the request needs to be allowed to take that long
+func waitTimeout(wait time.Duration) time.Duration {
+	return wait + httptransport.DefaultTimeout
+}
+
+// Lock is a distributed mutex, while it is held it gets renewed automatically
+type Lock struct {
+	lease
+	k    *KvStore
+	name string
+}
+
+// NewLock creates a lock for the name, the holder identifies this process to others
+func (k *KvStore) NewLock(name, holder string, ttl time.Duration) *Lock {
+	l := &Lock{k: k, name: name}
+	l.ttl = ttl
+	l.ops = leaseOps{
+		acquire: func(wait time.Duration) (int64, error) {
+			params := locks.NewAcquireLockParamsWithTimeout(waitTimeout(wait)).
+				WithName(name).
+				WithHolder(holder).
+				WithTTL(int64(ttl / time.Second)).
+				WithWait(swag.Int64(int64(wait / time.Second)))
+			res, err := k.client.Locks.AcquireLock(params)
+			if err != nil {
+				switch e := err.(type) {
+				case *locks.AcquireLockConflict:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				case *locks.AcquireLockDefault:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return 0, e
+				}
+			}
+			return swag.Int64Value(res.Payload.Token), nil
+		},
+		renew: func(token int64) error {
+			_, err := k.client.Locks.RenewLock(locks.NewRenewLockParams().WithName(name).WithToken(token))
+			if err != nil {
+				switch e := err.(type) {
+				case *locks.RenewLockConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *locks.RenewLockDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+		release: func(token int64) error {
+			_, err := k.client.Locks.ReleaseLock(locks.NewReleaseLockParams().WithName(name).WithToken(token))
+			if err != nil {
+				switch e := err.(type) {
+				case *locks.ReleaseLockConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *locks.ReleaseLockDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+	}
+	return l
+}
+
+// Lock acquires the lock, waiting up to wait for it to become available.
+// It returns the fencing token to pass along to whatever the lock protects.
+func (l *Lock) Lock(wait time.Duration) (int64, error) {
+	return l.acquire(wait)
+}
+
+// Unlock releases the lock and stops renewing it
+func (l *Lock) Unlock() error {
+	return l.release()
+}
+
+// Holder reports who holds the lock, this is nil when nobody does
+func (l *Lock) Holder() (*models.Lock, error) {
+	res, err := l.k.client.Locks.GetLock(locks.NewGetLockParams().WithName(l.name))
+	if err != nil {
+		switch e := err.(type) {
+		case *locks.GetLockNotFound:
+			return nil, nil
+		case *locks.GetLockDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
+
+// Election elects a leader among candidates, while this candidate leads the leadership gets renewed automatically
+type Election struct {
+	lease
+	k    *KvStore
+	name string
+}
+
+// NewElection creates an election for the name, the candidate identifies this process to others
+func (k *KvStore) NewElection(name, candidate string, ttl time.Duration) *Election {
+	el := &Election{k: k, name: name}
+	el.ttl = ttl
+	el.ops = leaseOps{
+		acquire: func(wait time.Duration) (int64, error) {
+			params := elections.NewCampaignParamsWithTimeout(waitTimeout(wait)).
+				WithName(name).
+				WithCandidate(candidate).
+				WithTTL(int64(ttl / time.Second)).
+				WithWait(swag.Int64(int64(wait / time.Second)))
+			res, err := k.client.Elections.Campaign(params)
+			if err != nil {
+				switch e := err.(type) {
+				case *elections.CampaignConflict:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				case *elections.CampaignDefault:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return 0, e
+				}
+			}
+			return swag.Int64Value(res.Payload.Token), nil
+		},
+		renew: func(token int64) error {
+			_, err := k.client.Elections.RenewLeadership(elections.NewRenewLeadershipParams().WithName(name).WithToken(token))
+			if err != nil {
+				switch e := err.(type) {
+				case *elections.RenewLeadershipConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *elections.RenewLeadershipDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+		release: func(token int64) error {
+			_, err := k.client.Elections.Resign(elections.NewResignParams().WithName(name).WithToken(token))
+			if err != nil {
+				switch e := err.(type) {
+				case *elections.ResignConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *elections.ResignDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+	}
+	return el
+}
+
+// Campaign tries to become the leader, waiting up to wait for the current leader to go away.
+// It returns the fencing token of the leadership.
+func (el *Election) Campaign(wait time.Duration) (int64, error) {
+	return el.acquire(wait)
+}
+
+// Resign gives up the leadership and stops renewing it
+func (el *Election) Resign() error {
+	return el.release()
+}
+
+// Leader reports the current leader, this is nil when there is no leader
+func (el *Election) Leader() (*models.Lock, error) {
+	res, err := el.k.client.Elections.GetLeader(elections.NewGetLeaderParams().WithName(el.name))
+	if err != nil {
+		switch e := err.(type) {
+		case *elections.GetLeaderNotFound:
+			return nil, nil
+		case *elections.GetLeaderDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
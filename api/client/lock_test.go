@@ -0,0 +1,125 @@
+package client
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeLease counts the calls to the lease operations, renewing fails once failRenew is set
+type fakeLease struct {
+	mu        sync.Mutex
+	acquired  int
+	renewed   int
+	released  []int64
+	failRenew bool
+}
+
+func (f *fakeLease) ops() leaseOps {
+	return leaseOps{
+		acquire: func(time.Duration) (int64, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.acquired++
+			return int64(f.acquired), nil
+		},
+		renew: func(int64) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			if f.failRenew {
+				return errors.New("the lock is no longer held with this token")
+			}
+			f.renewed++
+			return nil
+		},
+		release: func(token int64) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.released = append(f.released, token)
+			return nil
+		},
+	}
+}
+
+func (f *fakeLease) renewals() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.renewed
+}
+
+func TestLeaseRenewal(t *testing.T) {
+	f := new(fakeLease)
+	l := &lease{ops: f.ops(), ttl: 30 * time.Millisecond}
+	if l.Lost() != nil || l.Token() != 0 {
+		t.Error("a lease that was never acquired has a token")
+	}
+	if err := l.release(); err != ErrNotHeld {
+		t.Errorf("releasing a lease that was never acquired got %v", err)
+	}
+
+	token, err := l.acquire(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// acquiring a held lease keeps the token the lease is renewed with
+	again, err := l.acquire(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != 1 || again != token || l.Token() != token {
+		t.Errorf("the lease has token %d after acquiring %d and %d", l.Token(), token, again)
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for f.renewals() < 3 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if f.renewals() < 3 {
+		t.Fatalf("the lease was renewed %d times", f.renewals())
+	}
+
+	if err := l.release(); err != nil {
+		t.Fatal(err)
+	}
+	renewed := f.renewals()
+	time.Sleep(50 * time.Millisecond)
+	if f.renewals() != renewed {
+		t.Error("the lease was renewed after the release")
+	}
+	if len(f.released) != 1 || f.released[0] != token {
+		t.Errorf("released %v, want %d", f.released, token)
+	}
+	select {
+	case <-l.Lost():
+		t.Error("a released lease was lost")
+	default:
+	}
+}
+
+func TestLeaseLost(t *testing.T) {
+	f := &fakeLease{failRenew: true}
+	l := &lease{ops: f.ops(), ttl: 30 * time.Millisecond}
+	if _, err := l.acquire(0); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case <-l.Lost():
+	case <-time.After(5 * time.Second):
+		t.Fatal("the lease wasn't lost when renewing failed")
+	}
+	if err := l.release(); err != ErrNotHeld {
+		t.Errorf("releasing a lost lease got %v", err)
+	}
+
+	// a lost lease can be acquired again with a new token
+	token, err := l.acquire(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != 2 {
+		t.Errorf("acquiring the lease again got token %d", token)
+	}
+	l.release()
+}
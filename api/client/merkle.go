@@ -0,0 +1,59 @@
+package client
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/kvstore/gen/client/merkle"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(v)
+	}
+	return strings.Join(parts, ",")
+}
+
+// MerkleLevel reads the nodes of a level of the merkle tree of the store, all the nodes of the level without nodes
+func (k *KvStore) MerkleLevel(level int, nodes []int) (*models.MerkleLevel, error) {
+	params := merkle.NewGetMerkleLevelParams().WithLevel(swag.Int64(int64(level)))
+	if len(nodes) > 0 {
+		params.SetNodes(swag.String(joinInts(nodes)))
+	}
+	res, err := k.client.Merkle.GetMerkleLevel(params)
+	if err != nil {
+		if e, ok := err.(*merkle.GetMerkleLevelDefault); ok {
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
+
+// MerkleKeys lists the keys in the buckets of the merkle tree of the store
+func (k *KvStore) MerkleKeys(buckets []int) ([]*models.MerkleKey, error) {
+	res, err := k.client.Merkle.ListMerkleKeys(merkle.NewListMerkleKeysParams().WithBuckets(joinInts(buckets)))
+	if err != nil {
+		if e, ok := err.(*merkle.ListMerkleKeysDefault); ok {
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
+
+// RepairFrom makes the store copy the entries the peer updated later from the peer
+func (k *KvStore) RepairFrom(peer string) (*models.RepairResult, error) {
+	res, err := k.client.Merkle.RepairFrom(merkle.NewRepairFromParams().WithBody(&models.RepairRequest{Peer: swag.String(peer)}))
+	if err != nil {
+		if e, ok := err.(*merkle.RepairFromDefault); ok {
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/kvstore/gen/client/queues"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// Message taken from a queue
+type Message struct {
+	ID   int64
+	Body []byte
+	// Receipt is needed to ack or nack the message, it is empty for a peeked message
+	Receipt string
+	// Deliveries is the number of times the message was dequeued
+	Deliveries int64
+	_          struct{}
+}
+
+func newMessage(id, deliveries, receipt string, body []byte) (*Message, error) {
+	msg := &Message{Body: body, Receipt: receipt}
+	var err error
+	if msg.ID, err = strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, err
+	}
+	if msg.Deliveries, err = strconv.ParseInt(deliveries, 10, 64); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Enqueue adds the data to the end of the queue and returns the id of the message
+func (k *KvStore) Enqueue(queue string, data []byte) (int64, error) {
+	body := ioutil.NopCloser(bytes.NewBuffer(data))
+	res, err := k.client.Queues.EnqueueMessage(queues.NewEnqueueMessageParams().WithName(queue).WithBody(body))
+	if err != nil {
+		if e, ok := err.(*queues.EnqueueMessageDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return 0, err
+	}
+	return strconv.ParseInt(res.XMessageID, 10, 64)
+}
+
+// Dequeue takes the first visible message from the queue, waiting up to wait for one to arrive.
+// The message stays invisible for the visibility timeout, when it isn't acked in that time it gets delivered again.
+// This returns nil when there was no message.
+func (k *KvStore) Dequeue(queue string, visibility, wait time.Duration) (*Message, error) {
+	params := queues.NewDequeueMessageParamsWithTimeout(waitTimeout(wait)).
+		WithName(queue).
+		WithVisibility(swag.Int64(int64(visibility / time.Second))).
+		WithWait(swag.Int64(int64(wait / time.Second)))
+
+	data := bytes.NewBuffer(nil)
+	res, empty, err := k.client.Queues.DequeueMessage(params, data)
+	if err != nil {
+		if e, ok := err.(*queues.DequeueMessageDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	if empty != nil {
+		return nil, nil
+	}
+	return newMessage(res.XMessageID, res.XDeliveries, res.XReceipt, data.Bytes())
+}
+
+// Peek gets the first visible message without dequeuing it, this returns nil when there is no message
+func (k *KvStore) Peek(queue string) (*Message, error) {
+	data := bytes.NewBuffer(nil)
+	res, empty, err := k.client.Queues.PeekMessage(queues.NewPeekMessageParams().WithName(queue), data)
+	if err != nil {
+		if e, ok := err.(*queues.PeekMessageDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	if empty != nil {
+		return nil, nil
+	}
+	return newMessage(res.XMessageID, res.XDeliveries, "", data.Bytes())
+}
+
+// Ack removes the dequeued message from the queue
+func (k *KvStore) Ack(queue string, msg *Message) error {
+	params := queues.NewAckMessageParams().WithName(queue).WithID(msg.ID).WithReceipt(msg.Receipt)
+	_, err := k.client.Queues.AckMessage(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *queues.AckMessageNotFound:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *queues.AckMessageConflict:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *queues.AckMessageDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+	return nil
+}
+
+// Nack puts the dequeued message back in the queue, it gets delivered again after the delay
+func (k *KvStore) Nack(queue string, msg *Message, delay time.Duration) error {
+	params := queues.NewNackMessageParams().
+		WithName(queue).
+		WithID(msg.ID).
+		WithReceipt(msg.Receipt).
+		WithDelay(swag.Int64(int64(delay / time.Second)))
+	_, err := k.client.Queues.NackMessage(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *queues.NackMessageNotFound:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *queues.NackMessageConflict:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		case *queues.NackMessageDefault:
+			return errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return e
+		}
+	}
+	return nil
+}
+
+// QueueLength counts the messages in the queue
+func (k *KvStore) QueueLength(queue string) (*models.Queue, error) {
+	res, err := k.client.Queues.GetQueue(queues.NewGetQueueParams().WithName(queue))
+	if err != nil {
+		if e, ok := err.(*queues.GetQueueDefault); ok {
+			err = errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/go-openapi/kvstore/gen/client/changelog"
+	"github.com/go-openapi/kvstore/gen/client/replication"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// ErrChangesPruned is returned when the changes that were asked for are no longer in the changelog
+var ErrChangesPruned = errors.New("the changes that were asked for were pruned from the changelog")
+
+// snapshotTimeout is the time a snapshot can take to transfer
+const snapshotTimeout = time.Hour
+
+// Changelog reads the changes starting at the from sequence, a from of 0 reads from the oldest change that is kept
+func (k *KvStore) Changelog(from uint64, limit int64) (*models.Changelog, error) {
+	params := changelog.NewReadChangelogParams()
+	if from != 0 {
+		params.SetFrom(swag.Uint64(from))
+	}
+	if limit > 0 {
+		params.SetLimit(swag.Int64(limit))
+	}
+	res, err := k.client.Changelog.ReadChangelog(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *changelog.ReadChangelogGone:
+			return nil, ErrChangesPruned
+		case *changelog.ReadChangelogDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
+
+// Snapshot writes a snapshot of all the entries to w
+func (k *KvStore) Snapshot(w io.Writer) error {
+	_, err := k.client.Replication.GetSnapshot(replication.NewGetSnapshotParamsWithTimeout(snapshotTimeout), w)
+	if err != nil {
+		if e, ok := err.(*replication.GetSnapshotDefault); ok {
+			return errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return err
+	}
+	return nil
+}
+
+// ReplicationStatus reports if the store is a primary or a follower and how far a follower is behind
+func (k *KvStore) ReplicationStatus() (*models.ReplicationStatus, error) {
+	res, err := k.client.Replication.GetReplicationStatus(replication.NewGetReplicationStatusParams())
+	if err != nil {
+		if e, ok := err.(*replication.GetReplicationStatusDefault); ok {
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		}
+		return nil, err
+	}
+	return res.Payload, nil
+}
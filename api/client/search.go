@@ -0,0 +1,48 @@
+package client
+
+import (
+	"errors"
+
+	"github.com/go-openapi/kvstore/gen/client/search"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// Search finds the entries below the prefix that contain any of the words in the query, the best match comes first
+func (k *KvStore) Search(query, prefix string, limit int64) ([]*models.SearchResult, error) {
+	params := search.NewSearchParams().WithQ(query)
+	if prefix != "" {
+		params.SetPrefix(swag.String(prefix))
+	}
+	if limit > 0 {
+		params.SetLimit(swag.Int64(limit))
+	}
+	res, err := k.client.Search.Search(params)
+	if err != nil {
+		switch e := err.(type) {
+		case *search.SearchConflict:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		case *search.SearchDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
+
+// RebuildSearch replaces the search index with one built from the stored entries, it returns the number of entries in it
+func (k *KvStore) RebuildSearch() (int64, error) {
+	res, err := k.client.Search.RebuildSearch(search.NewRebuildSearchParams())
+	if err != nil {
+		switch e := err.(type) {
+		case *search.RebuildSearchConflict:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *search.RebuildSearchDefault:
+			return 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, e
+		}
+	}
+	return swag.Int64Value(res.Payload.Indexed), nil
+}
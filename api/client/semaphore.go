@@ -0,0 +1,101 @@
+package client
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-openapi/kvstore/gen/client/semaphores"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// Semaphore allows at most limit holders at the same time, while a slot is held it gets renewed automatically
+type Semaphore struct {
+	lease
+	k    *KvStore
+	name string
+}
+
+// NewSemaphore creates a semaphore for the name with limit slots, the holder identifies this process to others
+func (k *KvStore) NewSemaphore(name, holder string, limit int64, ttl time.Duration) *Semaphore {
+	s := &Semaphore{k: k, name: name}
+	s.ttl = ttl
+	s.ops = leaseOps{
+		acquire: func(wait time.Duration) (int64, error) {
+			params := semaphores.NewAcquireSlotParamsWithTimeout(waitTimeout(wait)).
+				WithName(name).
+				WithHolder(holder).
+				WithLimit(limit).
+				WithTTL(int64(ttl / time.Second)).
+				WithWait(swag.Int64(int64(wait / time.Second)))
+			_, err := k.client.Semaphores.AcquireSlot(params)
+			if err != nil {
+				switch e := err.(type) {
+				case *semaphores.AcquireSlotConflict:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				case *semaphores.AcquireSlotDefault:
+					return 0, errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return 0, e
+				}
+			}
+			return 0, nil
+		},
+		renew: func(int64) error {
+			_, err := k.client.Semaphores.RenewSlot(semaphores.NewRenewSlotParams().WithName(name).WithHolder(holder))
+			if err != nil {
+				switch e := err.(type) {
+				case *semaphores.RenewSlotConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *semaphores.RenewSlotDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+		release: func(int64) error {
+			_, err := k.client.Semaphores.ReleaseSlot(semaphores.NewReleaseSlotParams().WithName(name).WithHolder(holder))
+			if err != nil {
+				switch e := err.(type) {
+				case *semaphores.ReleaseSlotConflict:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				case *semaphores.ReleaseSlotDefault:
+					return errors.New(swag.StringValue(e.Payload.Message))
+				default:
+					return e
+				}
+			}
+			return nil
+		},
+	}
+	return s
+}
+
+// Acquire takes a slot, waiting up to wait for one to become available
+func (s *Semaphore) Acquire(wait time.Duration) error {
+	_, err := s.acquire(wait)
+	return err
+}
+
+// Release gives the slot back and stops renewing it
+func (s *Semaphore) Release() error {
+	return s.release()
+}
+
+// Holders reports who holds the slots of the semaphore, this is nil when the semaphore was never used
+func (s *Semaphore) Holders() (*models.Semaphore, error) {
+	res, err := s.k.client.Semaphores.GetSemaphore(semaphores.NewGetSemaphoreParams().WithName(s.name))
+	if err != nil {
+		switch e := err.(type) {
+		case *semaphores.GetSemaphoreNotFound:
+			return nil, nil
+		case *semaphores.GetSemaphoreDefault:
+			return nil, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return nil, e
+		}
+	}
+	return res.Payload, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/go-openapi/kvstore/gen/client/sequences"
+	"github.com/go-openapi/swag"
+)
+
+// NextIDs hands out count ids of the sequence, it returns the first and the last id
+func (k *KvStore) NextIDs(name string, count int64) (int64, int64, error) {
+	res, err := k.client.Sequences.NextIds(sequences.NewNextIdsParams().WithName(name).WithCount(swag.Int64(count)))
+	if err != nil {
+		switch e := err.(type) {
+		case *sequences.NextIdsConflict:
+			return 0, 0, errors.New(swag.StringValue(e.Payload.Message))
+		case *sequences.NextIdsDefault:
+			return 0, 0, errors.New(swag.StringValue(e.Payload.Message))
+		default:
+			return 0, 0, e
+		}
+	}
+	return swag.Int64Value(res.Payload.First), swag.Int64Value(res.Payload.Last), nil
+}
+
+// Sequence hands out the ids of a sequence from a block it takes from the server,
+// the ids that are left in the block when the process stops are never handed out
+type Sequence struct {
+	k         *KvStore
+	name      string
+	blockSize int64
+
+	mu   sync.Mutex
+	next int64
+	last int64
+}
+
+// NewSequence creates a sequence that takes blockSize ids from the server at a time
+func (k *KvStore) NewSequence(name string, blockSize int64) *Sequence {
+	if blockSize < 1 {
+		blockSize = 1
+	}
+	return &Sequence{k: k, name: name, blockSize: blockSize}
+}
+
+// Next hands out the next id, it only calls the server when the local block is used up
+func (s *Sequence) Next() (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.next == 0 || s.next > s.last {
+		first, last, err := s.k.NextIDs(s.name, s.blockSize)
+		if err != nil {
+			return 0, err
+		}
+		s.next, s.last = first, last
+	}
+	id := s.next
+	s.next++
+	return id, nil
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+// sequenceServer hands out the ids of sequences like the server does and counts the requests
+type sequenceServer struct {
+	mu       sync.Mutex
+	next     int64
+	requests int
+	counts   []int64
+}
+
+func (s *sequenceServer) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	count, err := strconv.ParseInt(r.URL.Query().Get("count"), 10, 64)
+	if err != nil || r.Method != http.MethodPost || r.URL.Path != "/sequences/s/_next" {
+		http.Error(rw, "unexpected request "+r.URL.String(), http.StatusBadRequest)
+		return
+	}
+	s.requests++
+	s.counts = append(s.counts, count)
+	first := s.next + 1
+	s.next += count
+	rw.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(rw).Encode(map[string]int64{"first": first, "last": s.next})
+}
+
+func TestSequenceBlocks(t *testing.T) {
+	srv := &sequenceServer{}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	k, err := New(ts.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seq := k.NewSequence("s", 3)
+	for want := int64(1); want <= 7; want++ {
+		id, err := seq.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if id != want {
+			t.Errorf("got id %d, want %d", id, want)
+		}
+	}
+	// the ids come from the local block until it is used up
+	if srv.requests != 3 {
+		t.Errorf("took %d blocks from the server", srv.requests)
+	}
+
+	// another process gets its own block, the rest of the block of a process that stops is never handed out
+	other := k.NewSequence("s", 3)
+	if id, err := other.Next(); err != nil || id != 10 {
+		t.Errorf("another sequence starts at %d, %v", id, err)
+	}
+	if id, err := seq.Next(); err != nil || id != 8 {
+		t.Errorf("the sequence continues at %d, %v", id, err)
+	}
+
+	// a block size below 1 takes one id at a time
+	single := k.NewSequence("s", 0)
+	if id, err := single.Next(); err != nil || id != 13 {
+		t.Errorf("a sequence without a block size starts at %d, %v", id, err)
+	}
+	if last := srv.counts[len(srv.counts)-1]; last != 1 {
+		t.Errorf("a sequence without a block size takes %d ids at a time", last)
+	}
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewAckMessage handles a request for removing a dequeued message from a queue
+func NewAckMessage(rt *kvstore.Runtime) queues.AckMessageHandler {
+	return &ackMessage{rt: rt}
+}
+
+type ackMessage struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the ack message request
+func (d *ackMessage) Handle(params queues.AckMessageParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().Ack(params.Name, uint64(params.ID), params.Receipt); err != nil {
+		switch err {
+		case persist.ErrNotFound:
+			return queues.NewAckMessageNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		case persist.ErrReceiptMismatch:
+			return queues.NewAckMessageConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		default:
+			return queues.NewAckMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+	return queues.NewAckMessageNoContent().WithXRequestID(rid)
+}
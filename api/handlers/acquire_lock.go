@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/locks"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func modelsLock(name string, lock persist.Lock) *models.Lock {
+	expiresAt := strfmt.DateTime(time.Unix(0, lock.ExpiresAt).UTC())
+	return &models.Lock{
+		Name:      swag.String(name),
+		Holder:    swag.String(lock.Holder),
+		Token:     swag.Int64(int64(lock.Token)),
+		TTL:       swag.Int64(int64(time.Duration(lock.TTL) / time.Second)),
+		ExpiresAt: &expiresAt,
+	}
+}
+
+// acquireLock tries to acquire the lock until it succeeds, the wait is over or the request goes away.
+// A waiting request wakes up when the lock gets released or when it expires.
+func acquireLock(ctx context.Context, db persist.Store, name, holder string, ttl, wait time.Duration) (persist.Lock, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		released := db.LockReleased(name)
+		lock, err := db.AcquireLock(name, holder, ttl)
+		if err != persist.ErrLockHeld || !time.Now().Before(deadline) {
+			if err != nil {
+				return persist.Lock{}, err
+			}
+			return lock, nil
+		}
+
+		next := deadline
+		if expiresAt := time.Unix(0, lock.ExpiresAt); expiresAt.Before(next) {
+			next = expiresAt
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return persist.Lock{}, persist.ErrLockHeld
+		case <-released:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// NewAcquireLock handles a request for acquiring a lock
+func NewAcquireLock(rt *kvstore.Runtime) locks.AcquireLockHandler {
+	return &acquireLockHandler{rt: rt}
+}
+
+type acquireLockHandler struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the acquire lock request
+func (d *acquireLockHandler) Handle(params locks.AcquireLockParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	ttl := time.Duration(params.TTL) * time.Second
+	wait := time.Duration(swag.Int64Value(params.Wait)) * time.Second
+	lock, err := acquireLock(params.HTTPRequest.Context(), d.rt.DB(), params.Name, params.Holder, ttl, wait)
+	if err != nil {
+		if err == persist.ErrLockHeld {
+			return locks.NewAcquireLockConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return locks.NewAcquireLockDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return locks.NewAcquireLockOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
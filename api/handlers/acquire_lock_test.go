@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/spf13/viper"
+)
+
+func newTestStore(t *testing.T) persist.Store {
+	cfg := viper.New()
+	cfg.Set("store.path", filepath.Join(t.TempDir(), "store"))
+	store, err := persist.NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestAcquireLockWaits(t *testing.T) {
+	store := newTestStore(t)
+	held, err := store.AcquireLock("l", "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a waiting acquire gets the lock as soon as it is released
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		store.ReleaseLock("l", held.Token)
+	}()
+	start := time.Now()
+	lock, err := acquireLock(context.Background(), store, "l", "b", 200*time.Millisecond, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lock.Holder != "b" || lock.Token <= held.Token {
+		t.Errorf("the waiting acquire got %+v", lock)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the waiting acquire took %v", elapsed)
+	}
+
+	// or as soon as it expires
+	start = time.Now()
+	expired, err := acquireLock(context.Background(), store, "l", "c", time.Minute, 10*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expired.Holder != "c" || expired.Token <= lock.Token {
+		t.Errorf("the acquire after the expiry got %+v", expired)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("the acquire after the expiry took %v", elapsed)
+	}
+
+	// and gives up when the wait is over or the request goes away
+	if _, err := acquireLock(context.Background(), store, "l", "d", time.Minute, 20*time.Millisecond); err != persist.ErrLockHeld {
+		t.Errorf("the acquire after the wait got %v", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := acquireLock(ctx, store, "l", "d", time.Minute, 10*time.Second); err != persist.ErrLockHeld {
+		t.Errorf("the acquire of a request that went away got %v", err)
+	}
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/semaphores"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func modelsSemaphore(sem persist.Semaphore) *models.Semaphore {
+	holders := make([]*models.Slot, 0, len(sem.Holders))
+	for _, slot := range sem.Holders {
+		expiresAt := strfmt.DateTime(time.Unix(0, slot.ExpiresAt).UTC())
+		holders = append(holders, &models.Slot{
+			Holder:    swag.String(slot.Holder),
+			TTL:       swag.Int64(int64(time.Duration(slot.TTL) / time.Second)),
+			ExpiresAt: &expiresAt,
+		})
+	}
+	return &models.Semaphore{
+		Name:    swag.String(sem.Name),
+		Limit:   swag.Int64(sem.Limit),
+		Holders: holders,
+	}
+}
+
+// acquireSlot tries to acquire a slot until it succeeds, the wait is over or the request goes away.
+// A waiting request wakes up when a slot gets released or when the first lease expires.
+func acquireSlot(ctx context.Context, db persist.Store, name, holder string, limit int64, ttl, wait time.Duration) (persist.Semaphore, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		released := db.SlotReleased(name)
+		sem, err := db.AcquireSlot(name, holder, limit, ttl)
+		if err != persist.ErrSemaphoreFull || !time.Now().Before(deadline) {
+			return sem, err
+		}
+
+		next := deadline
+		for _, slot := range sem.Holders {
+			if expiresAt := time.Unix(0, slot.ExpiresAt); expiresAt.Before(next) {
+				next = expiresAt
+			}
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return persist.Semaphore{}, persist.ErrSemaphoreFull
+		case <-released:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// NewAcquireSlot handles a request for acquiring a slot of a semaphore
+func NewAcquireSlot(rt *kvstore.Runtime) semaphores.AcquireSlotHandler {
+	return &acquireSlotHandler{rt: rt}
+}
+
+type acquireSlotHandler struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the acquire slot request
+func (d *acquireSlotHandler) Handle(params semaphores.AcquireSlotParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	ttl := time.Duration(params.TTL) * time.Second
+	wait := time.Duration(swag.Int64Value(params.Wait)) * time.Second
+	sem, err := acquireSlot(params.HTTPRequest.Context(), d.rt.DB(), params.Name, params.Holder, params.Limit, ttl, wait)
+	if err != nil {
+		if err == persist.ErrSemaphoreFull || err == persist.ErrSemaphoreLimit {
+			return semaphores.NewAcquireSlotConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return semaphores.NewAcquireSlotDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return semaphores.NewAcquireSlotOK().WithXRequestID(rid).WithPayload(modelsSemaphore(sem))
+}
@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/cluster"
+	clusterops "github.com/go-openapi/kvstore/gen/restapi/operations/cluster"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+var errNotClustered = errors.New("this store is not a member of a cluster")
+
+// NewAddClusterMember handles a request for adding a member to the cluster
+func NewAddClusterMember(rt *kvstore.Runtime) clusterops.AddClusterMemberHandler {
+	return &addClusterMember{rt: rt}
+}
+
+type addClusterMember struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the add cluster member request
+func (d *addClusterMember) Handle(params clusterops.AddClusterMemberParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	node := d.rt.Cluster()
+	if node == nil {
+		return clusterops.NewAddClusterMemberDefault(http.StatusNotFound).WithXRequestID(rid).WithPayload(modelsError(errNotClustered))
+	}
+
+	member := cluster.Member{
+		ID:          swag.StringValue(params.Body.ID),
+		PeerAddress: swag.StringValue(params.Body.PeerAddress),
+		APIURL:      swag.StringValue(params.Body.APIURL),
+	}
+	if err := node.AddMember(params.HTTPRequest.Context(), member); err != nil {
+		switch err {
+		case cluster.ErrMemberExists, cluster.ErrMembershipPending:
+			return clusterops.NewAddClusterMemberConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		case cluster.ErrNotLeader, cluster.ErrLeadershipLost:
+			return clusterops.NewAddClusterMemberDefault(http.StatusServiceUnavailable).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return clusterops.NewAddClusterMemberDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return clusterops.NewAddClusterMemberNoContent().WithXRequestID(rid)
+}
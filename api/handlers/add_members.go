@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewAddMembers handles a request for adding members to a sorted set
+func NewAddMembers(rt *kvstore.Runtime) zsets.AddMembersHandler {
+	return &addMembers{rt: rt}
+}
+
+type addMembers struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the add members request
+func (d *addMembers) Handle(params zsets.AddMembersParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	members := make([]persist.ZMember, 0, len(params.Body.Members))
+	for _, m := range params.Body.Members {
+		if m == nil {
+			continue
+		}
+		members = append(members, persist.ZMember{Member: swag.StringValue(m.Member), Score: swag.Float64Value(m.Score)})
+	}
+
+	added, err := d.rt.DB().ZAdd(params.Name, members)
+	if err != nil {
+		if err == persist.ErrInvalidScore {
+			return zsets.NewAddMembersBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return zsets.NewAddMembersDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewAddMembersOK().WithXRequestID(rid).WithPayload(&models.ZsetCount{Count: swag.Int64(int64(added))})
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewAppendEntry handles a request for appending data to an entry
+func NewAppendEntry(rt *kvstore.Runtime) kv.AppendEntryHandler {
+	return &appendEntry{rt: rt}
+}
+
+type appendEntry struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the append entry request
+func (d *appendEntry) Handle(params kv.AppendEntryParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	opts := persist.AppendOptions{
+		MaxSize:   int(swag.Int64Value(params.MaxSize)),
+		Delimiter: []byte(swag.StringValue(params.Delimiter)),
+	}
+	if swag.StringValue(params.IfMatch) != "" {
+		var err error
+		opts.Version, err = strconv.ParseUint(swag.StringValue(params.IfMatch), 10, 64)
+		if err != nil {
+			return kv.NewAppendEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+
+	data, err := ioutil.ReadAll(params.Body)
+	e := params.Body.Close()
+	if err != nil {
+		return kv.NewAppendEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	if e != nil {
+		return kv.NewAppendEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(e))
+	}
+
+	value, err := d.rt.DB().Append(params.Key, data, opts)
+	if err != nil {
+		if err == persist.ErrVersionMismatch {
+			return kv.NewAppendEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrGone {
+			return kv.NewAppendEntryGone().WithXRequestID(rid).WithPayload(modelsError(errors.New("entry was deleted")))
+		}
+		return kv.NewAppendEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	return kv.NewAppendEntryNoContent().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10))
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/elections"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// electionLock is the name of the lock behind an election, lock names can't contain a slash
+// so this doesn't collide with the locks from the locks api
+func electionLock(name string) string {
+	return "elections/" + name
+}
+
+// NewCampaign handles a request for becoming the leader
+func NewCampaign(rt *kvstore.Runtime) elections.CampaignHandler {
+	return &campaign{rt: rt}
+}
+
+type campaign struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the campaign request
+func (d *campaign) Handle(params elections.CampaignParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	ttl := time.Duration(params.TTL) * time.Second
+	wait := time.Duration(swag.Int64Value(params.Wait)) * time.Second
+	lock, err := acquireLock(params.HTTPRequest.Context(), d.rt.DB(), electionLock(params.Name), params.Candidate, ttl, wait)
+	if err != nil {
+		if err == persist.ErrLockHeld {
+			return elections.NewCampaignConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return elections.NewCampaignDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return elections.NewCampaignOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
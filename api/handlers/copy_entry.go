@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+var errPrefixPrecondition = errors.New("version preconditions can't be used with prefix transfers")
+
+// transferPrecondition builds the precondition for a copy or a move from the request parameters
+func transferPrecondition(ifMatch, destinationVersion *string) (persist.Precondition, error) {
+	var pre persist.Precondition
+	if swag.StringValue(ifMatch) != "" {
+		version, err := strconv.ParseUint(swag.StringValue(ifMatch), 10, 64)
+		if err != nil {
+			return pre, err
+		}
+		pre.SourceVersion = version
+	}
+	if destinationVersion != nil {
+		version, err := strconv.ParseUint(swag.StringValue(destinationVersion), 10, 64)
+		if err != nil {
+			return pre, err
+		}
+		pre.DestinationVersion = &version
+	}
+	return pre, nil
+}
+
+// NewCopyEntry handles a request for copying an entry to another key
+func NewCopyEntry(rt *kvstore.Runtime) kv.CopyEntryHandler {
+	return &copyEntry{rt: rt}
+}
+
+type copyEntry struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the copy entry request
+func (d *copyEntry) Handle(params kv.CopyEntryParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if swag.BoolValue(params.Prefix) {
+		if params.IfMatch != nil || params.DestinationVersion != nil {
+			return kv.NewCopyEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(errPrefixPrecondition))
+		}
+		count, err := d.rt.DB().CopyPrefix(params.Key, params.Destination)
+		if err != nil {
+			return kv.NewCopyEntryDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewCopyEntryOK().WithXRequestID(rid).WithPayload(&models.TransferResult{Count: swag.Int64(int64(count))})
+	}
+
+	pre, err := transferPrecondition(params.IfMatch, params.DestinationVersion)
+	if err != nil {
+		return kv.NewCopyEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	value, err := d.rt.DB().Copy(params.Key, params.Destination, pre)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return kv.NewCopyEntryNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrVersionMismatch {
+			return kv.NewCopyEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrGone {
+			return kv.NewCopyEntryGone().WithXRequestID(rid).WithPayload(modelsError(errors.New("destination entry was deleted")))
+		}
+		return kv.NewCopyEntryDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	url := strfmt.URI((&kv.GetEntryURL{Key: params.Destination}).String())
+	return kv.NewCopyEntryCreated().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10)).WithLocation(url)
+}
@@ -0,0 +1,38 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/indexes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewCreateIndex handles a request for creating an index
+func NewCreateIndex(rt *kvstore.Runtime) indexes.CreateIndexHandler {
+	return &createIndex{rt: rt}
+}
+
+type createIndex struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the create index request
+func (d *createIndex) Handle(params indexes.CreateIndexParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	def, err := d.rt.DB().CreateIndex(persist.Index{
+		Name:    params.Name,
+		Prefix:  swag.StringValue(params.Body.Prefix),
+		Pointer: swag.StringValue(params.Body.Pointer),
+	})
+	if err != nil {
+		if err == persist.ErrIndexExists {
+			return indexes.NewCreateIndexConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return indexes.NewCreateIndexDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return indexes.NewCreateIndexCreated().WithXRequestID(rid).WithPayload(modelsIndex(def))
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewCreateSession handles a request for creating a session
+func NewCreateSession(rt *kvstore.Runtime) sessions.CreateSessionHandler {
+	return &createSession{rt: rt}
+}
+
+type createSession struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the create session request
+func (d *createSession) Handle(params sessions.CreateSessionParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	session, err := d.rt.DB().CreateSession(persist.Session{
+		Name:     params.Body.Name,
+		TTL:      int64(time.Duration(swag.Int64Value(params.Body.TTL)) * time.Second),
+		Behavior: params.Body.Behavior,
+	})
+	if err != nil {
+		return sessions.NewCreateSessionDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return sessions.NewCreateSessionCreated().WithXRequestID(rid).WithPayload(modelsSession(session))
+}
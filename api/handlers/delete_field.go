@@ -0,0 +1,40 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/hashes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteField handles a request for removing a field from a hash
+func NewDeleteField(rt *kvstore.Runtime) hashes.DeleteFieldHandler {
+	return &deleteField{rt: rt}
+}
+
+type deleteField struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the delete field request
+func (d *deleteField) Handle(params hashes.DeleteFieldParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	version, err := fieldVersion(params.IfMatch)
+	if err != nil {
+		return hashes.NewDeleteFieldDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	hashVersion, err := d.rt.DB().HDelete(params.Key, params.Field, version)
+	if err != nil {
+		if err == persist.ErrVersionMismatch {
+			return hashes.NewDeleteFieldConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return hashes.NewDeleteFieldDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return hashes.NewDeleteFieldNoContent().WithXRequestID(rid).WithXHashVersion(strconv.FormatUint(hashVersion, 10))
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteKeys handles a request for deleting all the entries with a prefix
+func NewDeleteKeys(rt *kvstore.Runtime) kv.DeleteKeysHandler {
+	return &deleteKeys{rt: rt}
+}
+
+type deleteKeys struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the delete keys request
+func (d *deleteKeys) Handle(params kv.DeleteKeysParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if !params.Confirm {
+		return kv.NewDeleteKeysDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(errors.New("confirm needs to be true to delete by prefix")))
+	}
+
+	deleted, err := d.rt.DB().DeleteByPrefix(params.Prefix)
+	if err != nil {
+		return kv.NewDeleteKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return kv.NewDeleteKeysOK().WithXRequestID(rid).WithPayload(&models.DeleteResult{Deleted: swag.Int64(int64(deleted))})
+}
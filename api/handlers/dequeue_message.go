@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// dequeue tries to dequeue a message until it succeeds, the wait is over or the request goes away.
+// A waiting request wakes up when a message gets added or when an in flight message becomes visible again.
+func dequeue(ctx context.Context, db persist.Store, name string, visibility, wait time.Duration) (persist.QueueMessage, error) {
+	deadline := time.Now().Add(wait)
+	for {
+		available := db.MessageAvailable(name)
+		msg, err := db.Dequeue(name, visibility)
+		if err != persist.ErrQueueEmpty || !time.Now().Before(deadline) {
+			return msg, err
+		}
+
+		next := deadline
+		if msg.VisibleAt != 0 {
+			if visibleAt := time.Unix(0, msg.VisibleAt); visibleAt.Before(next) {
+				next = visibleAt
+			}
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return persist.QueueMessage{}, persist.ErrQueueEmpty
+		case <-available:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+// NewDequeueMessage handles a request for taking a message from a queue
+func NewDequeueMessage(rt *kvstore.Runtime) queues.DequeueMessageHandler {
+	return &dequeueMessage{rt: rt}
+}
+
+type dequeueMessage struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the dequeue message request
+func (d *dequeueMessage) Handle(params queues.DequeueMessageParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	visibility := time.Duration(swag.Int64Value(params.Visibility)) * time.Second
+	wait := time.Duration(swag.Int64Value(params.Wait)) * time.Second
+	msg, err := dequeue(params.HTTPRequest.Context(), d.rt.DB(), params.Name, visibility, wait)
+	if err != nil {
+		if err == persist.ErrQueueEmpty {
+			return queues.NewDequeueMessageNoContent().WithXRequestID(rid)
+		}
+		return queues.NewDequeueMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	payload := ioutil.NopCloser(bytes.NewBuffer(msg.Body))
+	return queues.NewDequeueMessageOK().
+		WithXRequestID(rid).
+		WithXMessageID(strconv.FormatUint(msg.ID, 10)).
+		WithXReceipt(msg.Receipt).
+		WithXDeliveries(strconv.FormatInt(msg.Deliveries, 10)).
+		WithPayload(payload)
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewDestroySession handles a request for destroying a session
+func NewDestroySession(rt *kvstore.Runtime) sessions.DestroySessionHandler {
+	return &destroySession{rt: rt}
+}
+
+type destroySession struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the destroy session request
+func (d *destroySession) Handle(params sessions.DestroySessionParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().DestroySession(params.ID); err != nil {
+		if err == persist.ErrNotFound {
+			return sessions.NewDestroySessionNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return sessions.NewDestroySessionDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return sessions.NewDestroySessionNoContent().WithXRequestID(rid)
+}
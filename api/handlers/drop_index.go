@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/indexes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewDropIndex handles a request for removing an index
+func NewDropIndex(rt *kvstore.Runtime) indexes.DropIndexHandler {
+	return &dropIndex{rt: rt}
+}
+
+type dropIndex struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the drop index request
+func (d *dropIndex) Handle(params indexes.DropIndexParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().DropIndex(params.Name); err != nil {
+		if err == persist.ErrNotFound {
+			return indexes.NewDropIndexNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return indexes.NewDropIndexDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return indexes.NewDropIndexNoContent().WithXRequestID(rid)
+}
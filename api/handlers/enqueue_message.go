@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewEnqueueMessage handles a request for adding a message to a queue
+func NewEnqueueMessage(rt *kvstore.Runtime) queues.EnqueueMessageHandler {
+	return &enqueueMessage{rt: rt}
+}
+
+type enqueueMessage struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the enqueue message request
+func (d *enqueueMessage) Handle(params queues.EnqueueMessageParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	body, err := ioutil.ReadAll(params.Body)
+	e := params.Body.Close()
+	if err != nil {
+		return queues.NewEnqueueMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	if e != nil {
+		return queues.NewEnqueueMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(e))
+	}
+
+	msg, err := d.rt.DB().Enqueue(params.Name, body)
+	if err != nil {
+		return queues.NewEnqueueMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return queues.NewEnqueueMessageCreated().WithXRequestID(rid).WithXMessageID(strconv.FormatUint(msg.ID, 10))
+}
@@ -1,11 +1,14 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/filter"
 	"github.com/go-openapi/kvstore/gen/models"
 	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
 )
@@ -29,14 +32,126 @@ type findKeys struct {
 func (d *findKeys) Handle(params kv.FindKeysParams) middleware.Responder {
 	rid := swag.StringValue(params.XRequestID)
 
-	values, err := d.rt.DB().FindByPrefix(swag.StringValue(params.Prefix))
+	prefix, delimiter := swag.StringValue(params.Prefix), swag.StringValue(params.Delimiter)
+	listEntries := params.Filter != nil || params.Fields != nil
+	if listEntries && delimiter != "" {
+		return kv.NewFindKeysBadRequest().WithXRequestID(rid).WithPayload(modelsError(errors.New("a delimiter can't be combined with a filter or fields")))
+	}
+	if params.Selector == nil && params.ModifiedSince == nil && !listEntries {
+		keys, err := d.rt.DB().FindKeys(prefix, delimiter)
+		if err != nil {
+			return kv.NewFindKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewFindKeysOK().WithXRequestID(rid).WithPayload(keysPayload(keys))
+	}
+
+	var filters [][]string
+	if params.Selector != nil {
+		selector, err := persist.ParseSelector(*params.Selector)
+		if err != nil {
+			return kv.NewFindKeysBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		keys, err := d.rt.DB().SelectKeys(prefix, selector)
+		if err != nil {
+			return kv.NewFindKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		filters = append(filters, keys)
+	}
+	if params.ModifiedSince != nil {
+		since, err := parseRevision(*params.ModifiedSince)
+		if err != nil {
+			return kv.NewFindKeysBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		keys, err := d.rt.DB().ModifiedKeys(prefix, since)
+		if err != nil {
+			return kv.NewFindKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		filters = append(filters, keys)
+	}
+	if !listEntries {
+		return kv.NewFindKeysOK().WithXRequestID(rid).WithPayload(keysPayload(persist.RollUp(intersectKeys(filters), prefix, delimiter)))
+	}
+
+	var (
+		flt  *filter.Filter
+		proj *filter.Projection
+		err  error
+	)
+	if params.Filter != nil {
+		if flt, err = filter.Parse(*params.Filter); err != nil {
+			return kv.NewFindKeysBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+	if params.Fields != nil {
+		if proj, err = filter.ParseFields(*params.Fields); err != nil {
+			return kv.NewFindKeysBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+	var allowed map[string]bool
+	if len(filters) > 0 {
+		keys := intersectKeys(filters)
+		allowed = make(map[string]bool, len(keys))
+		for _, key := range keys {
+			allowed[key] = true
+		}
+	}
+
+	limit := int(swag.Int64Value(params.Limit))
+	result := make([]interface{}, 0)
+	err = d.rt.DB().ScanPrefix(prefix, func(kv persist.KeyValue) bool {
+		if allowed != nil && !allowed[kv.Key] {
+			return true
+		}
+		doc, err := filter.Decode(kv.Value.Value)
+		if err != nil {
+			return true
+		}
+		if flt != nil && !flt.Match(doc) {
+			return true
+		}
+		if proj != nil {
+			doc = proj.Apply(doc)
+		}
+		result = append(result, &models.Entry{Key: swag.String(kv.Key), Value: doc})
+		return limit == 0 || len(result) < limit
+	})
 	if err != nil {
 		return kv.NewFindKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
 	}
+	return kv.NewFindKeysOK().WithXRequestID(rid).WithPayload(result)
+}
+
+// keysPayload turns the keys into the items of a listing
+func keysPayload(keys []string) []interface{} {
+	payload := make([]interface{}, len(keys))
+	for i, key := range keys {
+		payload[i] = key
+	}
+	return payload
+}
 
-	var keys []string
-	for _, kva := range values {
-		keys = append(keys, kva.Key)
+// intersectKeys keeps the keys that are in all the sorted lists
+func intersectKeys(lists [][]string) []string {
+	result := lists[0]
+	for _, keys := range lists[1:] {
+		var both []string
+		i, j := 0, 0
+		for i < len(result) && j < len(keys) {
+			switch {
+			case result[i] < keys[j]:
+				i++
+			case result[i] > keys[j]:
+				j++
+			default:
+				both = append(both, result[i])
+				i++
+				j++
+			}
+		}
+		result = both
+	}
+	if result == nil {
+		result = []string{}
 	}
-	return kv.NewFindKeysOK().WithXRequestID(rid).WithPayload(keys)
+	return result
 }
@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/cluster"
+	"github.com/go-openapi/kvstore/gen/models"
+	clusterops "github.com/go-openapi/kvstore/gen/restapi/operations/cluster"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetClusterStatus handles a request for the cluster status of the member
+func NewGetClusterStatus(rt *kvstore.Runtime) clusterops.GetClusterStatusHandler {
+	return &getClusterStatus{rt: rt}
+}
+
+type getClusterStatus struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get cluster status request
+func (d *getClusterStatus) Handle(params clusterops.GetClusterStatusParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	node := d.rt.Cluster()
+	if node == nil {
+		return clusterops.NewGetClusterStatusDefault(http.StatusNotFound).WithXRequestID(rid).WithPayload(modelsError(errNotClustered))
+	}
+
+	status := node.Status()
+	result := &models.ClusterStatus{
+		ID:           swag.String(status.ID),
+		State:        swag.String(status.State),
+		Term:         swag.Uint64(status.Term),
+		Leader:       status.Leader,
+		CommitIndex:  swag.Uint64(status.CommitIndex),
+		AppliedIndex: swag.Uint64(status.AppliedIndex),
+		Members:      make([]*models.ClusterMember, 0, len(status.Members)),
+	}
+	for _, m := range status.Members {
+		if m.ID == status.Leader {
+			result.LeaderURL = m.APIURL
+		}
+		result.Members = append(result.Members, clusterMember(m))
+	}
+	return clusterops.NewGetClusterStatusOK().WithXRequestID(rid).WithPayload(result)
+}
+
+func clusterMember(m cluster.Member) *models.ClusterMember {
+	return &models.ClusterMember{
+		ID:          swag.String(m.ID),
+		PeerAddress: swag.String(m.PeerAddress),
+		APIURL:      swag.String(m.APIURL),
+	}
+}
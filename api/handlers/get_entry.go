@@ -47,5 +47,6 @@ func (d *getEntry) Handle(params kv.GetEntryParams) middleware.Responder {
 	}
 
 	payload := ioutil.NopCloser(bytes.NewBuffer(value.Value))
-	return kv.NewGetEntryOK().WithXRequestID(rid).WithPayload(payload).WithETag(strconv.FormatUint(value.Version, 10)).WithLastModified(lastModified)
+	return kv.NewGetEntryOK().WithXRequestID(rid).WithPayload(payload).WithETag(strconv.FormatUint(value.Version, 10)).WithLastModified(lastModified).
+		WithXLabels(persist.FormatLabels(value.Labels)).WithXLastUpdated(value.LastUpdated)
 }
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/hashes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetField handles a request for getting a field of a hash
+func NewGetField(rt *kvstore.Runtime) hashes.GetFieldHandler {
+	return &getField{rt: rt}
+}
+
+type getField struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get field request
+func (d *getField) Handle(params hashes.GetFieldParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	value, version, err := d.rt.DB().HGet(params.Key, params.Field)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return hashes.NewGetFieldNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return hashes.NewGetFieldDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	payload := ioutil.NopCloser(bytes.NewBuffer(value.Value))
+	return hashes.NewGetFieldOK().
+		WithXRequestID(rid).
+		WithETag(strconv.FormatUint(value.Version, 10)).
+		WithXHashVersion(strconv.FormatUint(version, 10)).
+		WithPayload(payload)
+}
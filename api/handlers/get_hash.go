@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/hashes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetHash handles a request for getting all the fields of a hash
+func NewGetHash(rt *kvstore.Runtime) hashes.GetHashHandler {
+	return &getHash{rt: rt}
+}
+
+type getHash struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get hash request
+func (d *getHash) Handle(params hashes.GetHashParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	fields, version, err := d.rt.DB().HGetAll(params.Key)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return hashes.NewGetHashNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return hashes.NewGetHashDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	values := make(map[string]string, len(fields))
+	for field, value := range fields {
+		values[field] = string(value.Value)
+	}
+	return hashes.NewGetHashOK().WithXRequestID(rid).WithPayload(&models.Hash{
+		Key:     swag.String(params.Key),
+		Version: swag.Uint64(version),
+		Fields:  values,
+	})
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/elections"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetLeader handles a request for getting the current leader
+func NewGetLeader(rt *kvstore.Runtime) elections.GetLeaderHandler {
+	return &getLeader{rt: rt}
+}
+
+type getLeader struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get leader request
+func (d *getLeader) Handle(params elections.GetLeaderParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	lock, err := d.rt.DB().GetLock(electionLock(params.Name))
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return elections.NewGetLeaderNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return elections.NewGetLeaderDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return elections.NewGetLeaderOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
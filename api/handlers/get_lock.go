@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/locks"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetLock handles a request for getting the holder of a lock
+func NewGetLock(rt *kvstore.Runtime) locks.GetLockHandler {
+	return &getLock{rt: rt}
+}
+
+type getLock struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get lock request
+func (d *getLock) Handle(params locks.GetLockParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	lock, err := d.rt.DB().GetLock(params.Name)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return locks.NewGetLockNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return locks.NewGetLockDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return locks.NewGetLockOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/merkle"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetMerkleLevel handles a request for the nodes of a level of the merkle tree
+func NewGetMerkleLevel(rt *kvstore.Runtime) merkle.GetMerkleLevelHandler {
+	return &getMerkleLevel{rt: rt}
+}
+
+type getMerkleLevel struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get merkle level request
+func (d *getMerkleLevel) Handle(params merkle.GetMerkleLevelParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	level := int(swag.Int64Value(params.Level))
+	if level > persist.MerkleDepth {
+		err := fmt.Errorf("the merkle tree has %d levels below the root", persist.MerkleDepth)
+		return merkle.NewGetMerkleLevelDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	tree, err := d.rt.DB().MerkleTree()
+	if err != nil {
+		return merkle.NewGetMerkleLevelDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	hashes := tree.Level(level)
+
+	var nodes []int
+	if swag.StringValue(params.Nodes) != "" {
+		nodes, err = parseIndexes(swag.StringValue(params.Nodes), len(hashes))
+		if err != nil {
+			return merkle.NewGetMerkleLevelDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	} else {
+		nodes = make([]int, len(hashes))
+		for i := range nodes {
+			nodes[i] = i
+		}
+	}
+
+	result := &models.MerkleLevel{
+		Depth:  swag.Int64(persist.MerkleDepth),
+		Fanout: swag.Int64(persist.MerkleFanout),
+		Level:  swag.Int64(int64(level)),
+		Nodes:  make([]*models.MerkleNode, 0, len(nodes)),
+	}
+	for _, i := range nodes {
+		result.Nodes = append(result.Nodes, &models.MerkleNode{Index: swag.Int64(int64(i)), Hash: swag.Uint64(hashes[i])})
+	}
+	return merkle.NewGetMerkleLevelOK().WithXRequestID(rid).WithPayload(result)
+}
+
+// parseIndexes parses a comma separated list of indexes below max
+func parseIndexes(value string, max int) ([]int, error) {
+	var result []int
+	for _, part := range strings.Split(value, ",") {
+		i, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not an index", part)
+		}
+		if i < 0 || i >= max {
+			return nil, fmt.Errorf("%d is not between 0 and %d", i, max-1)
+		}
+		result = append(result, i)
+	}
+	return result, nil
+}
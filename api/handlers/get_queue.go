@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetQueue handles a request for getting the length of a queue
+func NewGetQueue(rt *kvstore.Runtime) queues.GetQueueHandler {
+	return &getQueue{rt: rt}
+}
+
+type getQueue struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get queue request
+func (d *getQueue) Handle(params queues.GetQueueParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	stats, err := d.rt.DB().QueueStats(params.Name)
+	if err != nil {
+		return queues.NewGetQueueDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return queues.NewGetQueueOK().WithXRequestID(rid).WithPayload(&models.Queue{
+		Name:     swag.String(params.Name),
+		Length:   swag.Int64(stats.Length),
+		Visible:  swag.Int64(stats.Visible),
+		InFlight: swag.Int64(stats.InFlight),
+	})
+}
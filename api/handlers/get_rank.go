@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetRank handles a request for getting the rank of a member of a sorted set
+func NewGetRank(rt *kvstore.Runtime) zsets.GetRankHandler {
+	return &getRank{rt: rt}
+}
+
+type getRank struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get rank request
+func (d *getRank) Handle(params zsets.GetRankParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	member, err := d.rt.DB().ZRank(params.Name, params.Member, swag.BoolValue(params.Reverse))
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return zsets.NewGetRankNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return zsets.NewGetRankDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewGetRankOK().WithXRequestID(rid).WithPayload(modelsZsetMember(member, true))
+}
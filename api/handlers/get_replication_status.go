@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/replication"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetReplicationStatus handles a request for the replication status of the store
+func NewGetReplicationStatus(rt *kvstore.Runtime) replication.GetReplicationStatusHandler {
+	return &getReplicationStatus{rt: rt}
+}
+
+type getReplicationStatus struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get replication status request
+func (d *getReplicationStatus) Handle(params replication.GetReplicationStatusParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if peers := d.rt.Peers(); len(peers) > 0 {
+		result := &models.ReplicationStatus{
+			Role:     swag.String(models.ReplicationStatusRolePeer),
+			Sequence: swag.Uint64(d.rt.DB().NextChange()),
+			NodeID:   d.rt.DB().NodeID(),
+		}
+		for _, peer := range peers {
+			status := peer.Status()
+			ps := &models.PeerStatus{
+				URL:          swag.String(peer.Primary()),
+				Sequence:     swag.Uint64(status.Applied),
+				State:        swag.String(status.State),
+				PeerSequence: status.PrimaryNext,
+				Lag:          status.Lag(),
+				Staleness:    int64(status.Staleness / time.Millisecond),
+			}
+			if status.LastError != nil {
+				ps.LastError = status.LastError.Error()
+			}
+			result.Peers = append(result.Peers, ps)
+		}
+		return replication.NewGetReplicationStatusOK().WithXRequestID(rid).WithPayload(result)
+	}
+
+	follower := d.rt.Follower()
+	if follower == nil {
+		return replication.NewGetReplicationStatusOK().WithXRequestID(rid).WithPayload(&models.ReplicationStatus{
+			Role:     swag.String(models.ReplicationStatusRolePrimary),
+			Sequence: swag.Uint64(d.rt.DB().NextChange()),
+			NodeID:   d.rt.DB().NodeID(),
+		})
+	}
+
+	status := follower.Status()
+	result := &models.ReplicationStatus{
+		Role:            swag.String(models.ReplicationStatusRoleFollower),
+		Sequence:        swag.Uint64(status.Applied),
+		NodeID:          d.rt.DB().NodeID(),
+		Primary:         follower.Primary(),
+		State:           status.State,
+		PrimarySequence: status.PrimaryNext,
+		Lag:             status.Lag(),
+		Staleness:       int64(status.Staleness / time.Millisecond),
+	}
+	if status.LastError != nil {
+		result.LastError = status.LastError.Error()
+	}
+	return replication.NewGetReplicationStatusOK().WithXRequestID(rid).WithPayload(result)
+}
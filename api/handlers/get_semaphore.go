@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/semaphores"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetSemaphore handles a request for getting the holders of a semaphore
+func NewGetSemaphore(rt *kvstore.Runtime) semaphores.GetSemaphoreHandler {
+	return &getSemaphore{rt: rt}
+}
+
+type getSemaphore struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get semaphore request
+func (d *getSemaphore) Handle(params semaphores.GetSemaphoreParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	sem, err := d.rt.DB().GetSemaphore(params.Name)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return semaphores.NewGetSemaphoreNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return semaphores.NewGetSemaphoreDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return semaphores.NewGetSemaphoreOK().WithXRequestID(rid).WithPayload(modelsSemaphore(sem))
+}
@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+func modelsSession(session persist.Session) *models.Session {
+	expiresAt := strfmt.DateTime(time.Unix(0, session.ExpiresAt).UTC())
+	return &models.Session{
+		ID:        swag.String(session.ID),
+		Name:      session.Name,
+		TTL:       swag.Int64(int64(time.Duration(session.TTL) / time.Second)),
+		Behavior:  swag.String(session.Behavior),
+		ExpiresAt: &expiresAt,
+	}
+}
+
+// NewGetSession handles a request for getting a session
+func NewGetSession(rt *kvstore.Runtime) sessions.GetSessionHandler {
+	return &getSession{rt: rt}
+}
+
+type getSession struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get session request
+func (d *getSession) Handle(params sessions.GetSessionParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	session, err := d.rt.DB().GetSession(params.ID)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return sessions.NewGetSessionNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return sessions.NewGetSessionDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return sessions.NewGetSessionOK().WithXRequestID(rid).WithPayload(modelsSession(session))
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"io"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/replication"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetSnapshot handles a request for a snapshot of the entries
+func NewGetSnapshot(rt *kvstore.Runtime) replication.GetSnapshotHandler {
+	return &getSnapshot{rt: rt}
+}
+
+type getSnapshot struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get snapshot request
+func (d *getSnapshot) Handle(params replication.GetSnapshotParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	r, w := io.Pipe()
+	go func() {
+		w.CloseWithError(d.rt.DB().Dump(w))
+	}()
+	go func() {
+		// the response doesn't close the payload, this stops the dump when the request is done or the client went away
+		<-params.HTTPRequest.Context().Done()
+		r.Close()
+	}()
+	return replication.NewGetSnapshotOK().WithXRequestID(rid).WithPayload(r)
+}
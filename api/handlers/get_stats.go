@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetStats handles a request for the statistics of a prefix
+func NewGetStats(rt *kvstore.Runtime) kv.GetStatsHandler {
+	return &getStats{rt: rt}
+}
+
+type getStats struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get stats request
+func (d *getStats) Handle(params kv.GetStatsParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	stats, err := d.rt.DB().Stats(swag.StringValue(params.Prefix))
+	if err != nil {
+		return kv.NewGetStatsDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := &models.Stats{
+		Keys:       swag.Int64(stats.Keys),
+		TotalBytes: swag.Int64(stats.TotalBytes),
+		DiskBytes:  swag.Int64(stats.DiskBytes),
+	}
+	if stats.Keys > 0 {
+		result.MinSize = stats.MinSize
+		result.MaxSize = stats.MaxSize
+		result.AvgSize = float64(stats.TotalBytes) / float64(stats.Keys)
+		result.Oldest = strfmt.DateTime(time.Unix(0, stats.Oldest).UTC())
+		result.Newest = strfmt.DateTime(time.Unix(0, stats.Newest).UTC())
+	}
+	return kv.NewGetStatsOK().WithXRequestID(rid).WithPayload(result)
+}
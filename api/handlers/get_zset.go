@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+func modelsZsetMember(m persist.ZMember, withRank bool) *models.ZsetMember {
+	result := &models.ZsetMember{
+		Member: swag.String(m.Member),
+		Score:  swag.Float64(m.Score),
+	}
+	if withRank {
+		result.Rank = swag.Int64(m.Rank)
+	}
+	return result
+}
+
+func modelsZsetMembers(members []persist.ZMember, withRank bool) []*models.ZsetMember {
+	result := make([]*models.ZsetMember, 0, len(members))
+	for _, m := range members {
+		result = append(result, modelsZsetMember(m, withRank))
+	}
+	return result
+}
+
+// NewGetZset handles a request for getting the number of members of a sorted set
+func NewGetZset(rt *kvstore.Runtime) zsets.GetZsetHandler {
+	return &getZset{rt: rt}
+}
+
+type getZset struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the get zset request
+func (d *getZset) Handle(params zsets.GetZsetParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	card, err := d.rt.DB().ZCard(params.Name)
+	if err != nil {
+		return zsets.NewGetZsetDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewGetZsetOK().WithXRequestID(rid).WithPayload(&models.Zset{
+		Name: swag.String(params.Name),
+		Card: swag.Int64(card),
+	})
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewIncrEntry handles a request for incrementing a counter
+func NewIncrEntry(rt *kvstore.Runtime) kv.IncrEntryHandler {
+	return &incrEntry{rt: rt}
+}
+
+type incrEntry struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the incr entry request
+func (d *incrEntry) Handle(params kv.IncrEntryParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	counter, value, err := d.rt.DB().Incr(params.Key, swag.Int64Value(params.Delta), swag.Int64Value(params.Initial))
+	if err != nil {
+		if err == persist.ErrNotCounter || err == persist.ErrOverflow {
+			return kv.NewIncrEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewIncrEntryDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return kv.NewIncrEntryOK().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10)).WithPayload(&models.Counter{Value: swag.Int64(counter)})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewIncrScore handles a request for incrementing the score of a member of a sorted set
+func NewIncrScore(rt *kvstore.Runtime) zsets.IncrScoreHandler {
+	return &incrScore{rt: rt}
+}
+
+type incrScore struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the incr score request
+func (d *incrScore) Handle(params zsets.IncrScoreParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	score, err := d.rt.DB().ZIncr(params.Name, params.Member, params.Delta)
+	if err != nil {
+		if err == persist.ErrInvalidScore {
+			return zsets.NewIncrScoreBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return zsets.NewIncrScoreDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	member := persist.ZMember{Member: params.Member, Score: score}
+	return zsets.NewIncrScoreOK().WithXRequestID(rid).WithPayload(modelsZsetMember(member, false))
+}
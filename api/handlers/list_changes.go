@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewListChanges handles a request for listing the changes to the entries
+func NewListChanges(rt *kvstore.Runtime) kv.ListChangesHandler {
+	return &listChanges{rt: rt}
+}
+
+type listChanges struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the list changes request
+func (d *listChanges) Handle(params kv.ListChangesParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	var since int64
+	if params.Since != nil {
+		var err error
+		if since, err = parseRevision(*params.Since); err != nil {
+			return kv.NewListChangesBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+
+	changes, next, err := d.rt.DB().Changes(swag.StringValue(params.Prefix), since, int(swag.Int64Value(params.Limit)))
+	if err != nil {
+		if err == persist.ErrChangesPruned {
+			return kv.NewListChangesGone().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewListChangesDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := make([]*models.Change, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, &models.Change{
+			Revision: swag.Int64(change.Revision),
+			Key:      swag.String(change.Key),
+			Version:  swag.Uint64(change.Version),
+			Op:       swag.String(change.Op),
+		})
+	}
+	return kv.NewListChangesOK().WithXRequestID(rid).WithPayload(&models.ChangeList{
+		Changes: result,
+		Next:    swag.Int64(next),
+	})
+}
+
+// parseRevision parses a revision or an RFC 3339 time, which becomes the revision of that time
+func parseRevision(value string) (int64, error) {
+	if revision, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return revision, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
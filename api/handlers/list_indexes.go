@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/indexes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewListIndexes handles a request for listing the index definitions
+func NewListIndexes(rt *kvstore.Runtime) indexes.ListIndexesHandler {
+	return &listIndexes{rt: rt}
+}
+
+type listIndexes struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the list indexes request
+func (d *listIndexes) Handle(params indexes.ListIndexesParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	defs, err := d.rt.DB().ListIndexes()
+	if err != nil {
+		return indexes.NewListIndexesDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := make([]*models.Index, 0, len(defs))
+	for _, def := range defs {
+		result = append(result, modelsIndex(def))
+	}
+	return indexes.NewListIndexesOK().WithXRequestID(rid).WithPayload(result)
+}
+
+func modelsIndex(def persist.Index) *models.Index {
+	return &models.Index{
+		Name:    swag.String(def.Name),
+		Prefix:  swag.String(def.Prefix),
+		Pointer: swag.String(def.Pointer),
+		Ready:   swag.Bool(def.Ready),
+	}
+}
@@ -0,0 +1,46 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/merkle"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewListMerkleKeys handles a request for the keys in buckets of the merkle tree
+func NewListMerkleKeys(rt *kvstore.Runtime) merkle.ListMerkleKeysHandler {
+	return &listMerkleKeys{rt: rt}
+}
+
+type listMerkleKeys struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the list merkle keys request
+func (d *listMerkleKeys) Handle(params merkle.ListMerkleKeysParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	buckets, err := parseIndexes(params.Buckets, persist.MerkleBuckets)
+	if err != nil {
+		return merkle.NewListMerkleKeysDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	keys, err := d.rt.DB().MerkleKeys(buckets)
+	if err != nil {
+		return merkle.NewListMerkleKeysDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := make([]*models.MerkleKey, 0, len(keys))
+	for _, k := range keys {
+		result = append(result, &models.MerkleKey{
+			Key:         swag.String(k.Key),
+			Version:     swag.Uint64(k.Version),
+			LastUpdated: swag.Int64(k.LastUpdated),
+			Origin:      k.Origin,
+		})
+	}
+	return merkle.NewListMerkleKeysOK().WithXRequestID(rid).WithPayload(result)
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewListSessions handles a request for listing the sessions
+func NewListSessions(rt *kvstore.Runtime) sessions.ListSessionsHandler {
+	return &listSessions{rt: rt}
+}
+
+type listSessions struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the list sessions request
+func (d *listSessions) Handle(params sessions.ListSessionsParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	values, err := d.rt.DB().ListSessions()
+	if err != nil {
+		return sessions.NewListSessionsDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	var result []*models.Session
+	for _, session := range values {
+		result = append(result, modelsSession(session))
+	}
+	return sessions.NewListSessionsOK().WithXRequestID(rid).WithPayload(result)
+}
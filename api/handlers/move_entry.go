@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewMoveEntry handles a request for moving an entry to another key
+func NewMoveEntry(rt *kvstore.Runtime) kv.MoveEntryHandler {
+	return &moveEntry{rt: rt}
+}
+
+type moveEntry struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the move entry request
+func (d *moveEntry) Handle(params kv.MoveEntryParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if swag.BoolValue(params.Prefix) {
+		if params.IfMatch != nil || params.DestinationVersion != nil {
+			return kv.NewMoveEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(errPrefixPrecondition))
+		}
+		count, err := d.rt.DB().MovePrefix(params.Key, params.Destination)
+		if err != nil {
+			return kv.NewMoveEntryDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewMoveEntryOK().WithXRequestID(rid).WithPayload(&models.TransferResult{Count: swag.Int64(int64(count))})
+	}
+
+	pre, err := transferPrecondition(params.IfMatch, params.DestinationVersion)
+	if err != nil {
+		return kv.NewMoveEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	value, err := d.rt.DB().Move(params.Key, params.Destination, pre)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return kv.NewMoveEntryNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrVersionMismatch {
+			return kv.NewMoveEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrGone {
+			return kv.NewMoveEntryGone().WithXRequestID(rid).WithPayload(modelsError(errors.New("destination entry was deleted")))
+		}
+		return kv.NewMoveEntryDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	url := strfmt.URI((&kv.GetEntryURL{Key: params.Destination}).String())
+	return kv.NewMoveEntryCreated().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10)).WithLocation(url)
+}
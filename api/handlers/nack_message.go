@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewNackMessage handles a request for putting a dequeued message back in a queue
+func NewNackMessage(rt *kvstore.Runtime) queues.NackMessageHandler {
+	return &nackMessage{rt: rt}
+}
+
+type nackMessage struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the nack message request
+func (d *nackMessage) Handle(params queues.NackMessageParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	delay := time.Duration(swag.Int64Value(params.Delay)) * time.Second
+	if err := d.rt.DB().Nack(params.Name, uint64(params.ID), params.Receipt, delay); err != nil {
+		switch err {
+		case persist.ErrNotFound:
+			return queues.NewNackMessageNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		case persist.ErrReceiptMismatch:
+			return queues.NewNackMessageConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		default:
+			return queues.NewNackMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+	return queues.NewNackMessageNoContent().WithXRequestID(rid)
+}
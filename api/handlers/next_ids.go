@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sequences"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewNextIds handles a request for handing out the next ids of a sequence
+func NewNextIds(rt *kvstore.Runtime) sequences.NextIdsHandler {
+	return &nextIds{rt: rt}
+}
+
+type nextIds struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the next ids request
+func (d *nextIds) Handle(params sequences.NextIdsParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	count := swag.Int64Value(params.Count)
+	first, err := d.rt.DB().NextIDs(params.Name, count)
+	if err != nil {
+		if err == persist.ErrOverflow {
+			return sequences.NewNextIdsConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return sequences.NewNextIdsDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return sequences.NewNextIdsOK().WithXRequestID(rid).WithPayload(&models.IDRange{
+		First: swag.Int64(first),
+		Last:  swag.Int64(first + count - 1),
+	})
+}
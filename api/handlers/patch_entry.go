@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/patch"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewPatchEntry handles a request for patching the JSON document in an entry
+func NewPatchEntry(rt *kvstore.Runtime) kv.PatchEntryHandler {
+	return &patchEntry{rt: rt}
+}
+
+type patchEntry struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the patch entry request
+func (d *patchEntry) Handle(params kv.PatchEntryParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+	var version uint64
+	if swag.StringValue(params.IfMatch) != "" {
+		var err error
+		version, err = strconv.ParseUint(swag.StringValue(params.IfMatch), 10, 64)
+		if err != nil {
+			return kv.NewPatchEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+
+	mediaType, _, err := runtime.ContentType(params.HTTPRequest.Header)
+	if err != nil {
+		return kv.NewPatchEntryDefault(http.StatusUnsupportedMediaType).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	apply := patch.MergePatch
+	if mediaType == patch.JSONPatchMime {
+		apply = patch.JSONPatch
+	}
+
+	body, err := ioutil.ReadAll(params.Body)
+	e := params.Body.Close()
+	if err != nil {
+		return kv.NewPatchEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	if e != nil {
+		return kv.NewPatchEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(e))
+	}
+
+	value, err := d.rt.DB().Update(params.Key, version, func(doc []byte) ([]byte, error) {
+		return apply(doc, body)
+	})
+	if err != nil {
+		switch err {
+		case persist.ErrNotFound:
+			return kv.NewPatchEntryNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		case persist.ErrVersionMismatch, patch.ErrNotJSON, patch.ErrTestFailed, patch.ErrPathNotFound:
+			return kv.NewPatchEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if _, ok := err.(*patch.InvalidPatchError); ok {
+			return kv.NewPatchEntryDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewPatchEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	return kv.NewPatchEntryNoContent().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10))
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewPeekMessage handles a request for getting the first message of a queue without dequeuing it
+func NewPeekMessage(rt *kvstore.Runtime) queues.PeekMessageHandler {
+	return &peekMessage{rt: rt}
+}
+
+type peekMessage struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the peek message request
+func (d *peekMessage) Handle(params queues.PeekMessageParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	msg, err := d.rt.DB().Peek(params.Name)
+	if err != nil {
+		if err == persist.ErrQueueEmpty {
+			return queues.NewPeekMessageNoContent().WithXRequestID(rid)
+		}
+		return queues.NewPeekMessageDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	payload := ioutil.NopCloser(bytes.NewBuffer(msg.Body))
+	return queues.NewPeekMessageOK().
+		WithXRequestID(rid).
+		WithXMessageID(strconv.FormatUint(msg.ID, 10)).
+		WithXDeliveries(strconv.FormatInt(msg.Deliveries, 10)).
+		WithPayload(payload)
+}
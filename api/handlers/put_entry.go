@@ -44,7 +44,12 @@ func (d *putEntry) Handle(params kv.PutEntryParams) middleware.Responder {
 		return kv.NewPutEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(e))
 	}
 
-	val := &persist.Value{Value: value, Version: version}
+	val := &persist.Value{Value: value, Version: version, Session: swag.StringValue(params.Session)}
+	if params.Labels != nil {
+		if val.Labels, err = persist.ParseLabels(*params.Labels); err != nil {
+			return kv.NewPutEntryDefault(400).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
 	if err := d.rt.DB().Put(key, val); err != nil {
 		if err == persist.ErrVersionMismatch {
 			return kv.NewPutEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
@@ -52,7 +57,13 @@ func (d *putEntry) Handle(params kv.PutEntryParams) middleware.Responder {
 		if err == persist.ErrGone {
 			return kv.NewPutEntryGone().WithXRequestID(rid).WithPayload(modelsError(errors.New("entry was deleted")))
 		}
-		if err == persist.ErrNotFound {
+		if err == persist.ErrSessionConflict {
+			return kv.NewPutEntryConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrInvalidLabel {
+			return kv.NewPutEntryDefault(400).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		if err == persist.ErrNotFound || err == persist.ErrSessionNotFound {
 			return kv.NewPutEntryNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
 		}
 		return kv.NewPutEntryDefault(0).WithXRequestID(rid).WithPayload(modelsError(err))
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/indexes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewQueryIndex handles a request for finding the entries with a value in an index
+func NewQueryIndex(rt *kvstore.Runtime) indexes.QueryIndexHandler {
+	return &queryIndex{rt: rt}
+}
+
+type queryIndex struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the query index request
+func (d *queryIndex) Handle(params indexes.QueryIndexParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	def, keys, err := d.rt.DB().QueryIndex(params.Name, params.Value)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return indexes.NewQueryIndexNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return indexes.NewQueryIndexDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	if keys == nil {
+		keys = []string{}
+	}
+	return indexes.NewQueryIndexOK().WithXRequestID(rid).WithPayload(&models.IndexMatches{
+		Ready: swag.Bool(def.Ready),
+		Keys:  keys,
+	})
+}
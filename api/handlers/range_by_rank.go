@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRangeByRank handles a request for listing the members of a sorted set by rank
+func NewRangeByRank(rt *kvstore.Runtime) zsets.RangeByRankHandler {
+	return &rangeByRank{rt: rt}
+}
+
+type rangeByRank struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the range by rank request
+func (d *rangeByRank) Handle(params zsets.RangeByRankParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	members, err := d.rt.DB().ZRange(params.Name, swag.Int64Value(params.Start), swag.Int64Value(params.Stop), swag.BoolValue(params.Reverse))
+	if err != nil {
+		return zsets.NewRangeByRankDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewRangeByRankOK().WithXRequestID(rid).WithPayload(modelsZsetMembers(members, true))
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"math"
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRangeByScore handles a request for listing the members of a sorted set by score
+func NewRangeByScore(rt *kvstore.Runtime) zsets.RangeByScoreHandler {
+	return &rangeByScore{rt: rt}
+}
+
+type rangeByScore struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the range by score request
+func (d *rangeByScore) Handle(params zsets.RangeByScoreParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	min, max := math.Inf(-1), math.Inf(1)
+	if params.Min != nil {
+		min = *params.Min
+	}
+	if params.Max != nil {
+		max = *params.Max
+	}
+
+	members, err := d.rt.DB().ZRangeByScore(params.Name, min, max, int(swag.Int64Value(params.Offset)), int(swag.Int64Value(params.Limit)))
+	if err != nil {
+		return zsets.NewRangeByScoreDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewRangeByScoreOK().WithXRequestID(rid).WithPayload(modelsZsetMembers(members, false))
+}
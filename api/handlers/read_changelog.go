@@ -0,0 +1,61 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/changelog"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewReadChangelog handles a request for reading the changelog
+func NewReadChangelog(rt *kvstore.Runtime) changelog.ReadChangelogHandler {
+	return &readChangelog{rt: rt}
+}
+
+type readChangelog struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the read changelog request
+func (d *readChangelog) Handle(params changelog.ReadChangelogParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	changes, next, first, err := d.rt.DB().ChangeLog(swag.Uint64Value(params.From), int(swag.Int64Value(params.Limit)))
+	if err != nil {
+		if err == persist.ErrChangesPruned {
+			return changelog.NewReadChangelogGone().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return changelog.NewReadChangelogDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := make([]*models.ChangelogEntry, 0, len(changes))
+	for _, change := range changes {
+		entry := &models.ChangelogEntry{
+			Sequence: swag.Uint64(change.Sequence),
+			Revision: swag.Int64(change.Revision),
+			Key:      swag.String(change.Key),
+			Version:  swag.Uint64(change.Version),
+			Op:       swag.String(change.Op),
+		}
+		if change.Value != nil {
+			entry.Value = change.Value.Value
+			entry.LastUpdated = change.Value.LastUpdated
+			entry.Origin = change.Value.Origin
+			entry.Labels = change.Value.Labels
+		}
+		if change.Tombstone != nil {
+			entry.LastUpdated = change.Tombstone.DeletedAt
+			entry.Origin = change.Tombstone.Origin
+		}
+		result = append(result, entry)
+	}
+	return changelog.NewReadChangelogOK().WithXRequestID(rid).WithPayload(&models.Changelog{
+		Changes: result,
+		First:   swag.Uint64(first),
+		Next:    swag.Uint64(next),
+	})
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/search"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRebuildSearch handles a request for rebuilding the search index
+func NewRebuildSearch(rt *kvstore.Runtime) search.RebuildSearchHandler {
+	return &rebuildSearch{rt: rt}
+}
+
+type rebuildSearch struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the rebuild search request
+func (d *rebuildSearch) Handle(params search.RebuildSearchParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	indexed, err := d.rt.DB().RebuildSearch()
+	if err != nil {
+		if err == persist.ErrSearchDisabled {
+			return search.NewRebuildSearchConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return search.NewRebuildSearchDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return search.NewRebuildSearchOK().WithXRequestID(rid).WithPayload(&models.SearchRebuild{
+		Indexed: swag.Int64(int64(indexed)),
+	})
+}
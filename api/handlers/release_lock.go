@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/locks"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewReleaseLock handles a request for releasing a lock
+func NewReleaseLock(rt *kvstore.Runtime) locks.ReleaseLockHandler {
+	return &releaseLock{rt: rt}
+}
+
+type releaseLock struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the release lock request
+func (d *releaseLock) Handle(params locks.ReleaseLockParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().ReleaseLock(params.Name, uint64(params.Token)); err != nil {
+		if err == persist.ErrLockLost {
+			return locks.NewReleaseLockConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return locks.NewReleaseLockDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return locks.NewReleaseLockNoContent().WithXRequestID(rid)
+}
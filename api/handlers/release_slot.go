@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/semaphores"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewReleaseSlot handles a request for releasing a slot of a semaphore
+func NewReleaseSlot(rt *kvstore.Runtime) semaphores.ReleaseSlotHandler {
+	return &releaseSlot{rt: rt}
+}
+
+type releaseSlot struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the release slot request
+func (d *releaseSlot) Handle(params semaphores.ReleaseSlotParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().ReleaseSlot(params.Name, params.Holder); err != nil {
+		if err == persist.ErrSlotLost {
+			return semaphores.NewReleaseSlotConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return semaphores.NewReleaseSlotDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return semaphores.NewReleaseSlotNoContent().WithXRequestID(rid)
+}
@@ -0,0 +1,43 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/cluster"
+	clusterops "github.com/go-openapi/kvstore/gen/restapi/operations/cluster"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRemoveClusterMember handles a request for removing a member from the cluster
+func NewRemoveClusterMember(rt *kvstore.Runtime) clusterops.RemoveClusterMemberHandler {
+	return &removeClusterMember{rt: rt}
+}
+
+type removeClusterMember struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the remove cluster member request
+func (d *removeClusterMember) Handle(params clusterops.RemoveClusterMemberParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	node := d.rt.Cluster()
+	if node == nil {
+		return clusterops.NewRemoveClusterMemberDefault(http.StatusNotFound).WithXRequestID(rid).WithPayload(modelsError(errNotClustered))
+	}
+
+	if err := node.RemoveMember(params.HTTPRequest.Context(), params.ID); err != nil {
+		switch err {
+		case cluster.ErrMemberNotFound:
+			return clusterops.NewRemoveClusterMemberNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		case cluster.ErrMembershipPending:
+			return clusterops.NewRemoveClusterMemberConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		case cluster.ErrNotLeader, cluster.ErrLeadershipLost:
+			return clusterops.NewRemoveClusterMemberDefault(http.StatusServiceUnavailable).WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return clusterops.NewRemoveClusterMemberDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return clusterops.NewRemoveClusterMemberNoContent().WithXRequestID(rid)
+}
@@ -0,0 +1,31 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRemoveMembers handles a request for removing members from a sorted set
+func NewRemoveMembers(rt *kvstore.Runtime) zsets.RemoveMembersHandler {
+	return &removeMembers{rt: rt}
+}
+
+type removeMembers struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the remove members request
+func (d *removeMembers) Handle(params zsets.RemoveMembersParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	removed, err := d.rt.DB().ZRemove(params.Name, params.Body.Members)
+	if err != nil {
+		return zsets.NewRemoveMembersDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return zsets.NewRemoveMembersOK().WithXRequestID(rid).WithPayload(&models.ZsetCount{Count: swag.Int64(int64(removed))})
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/elections"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRenewLeadership handles a request for renewing the lease on the leadership
+func NewRenewLeadership(rt *kvstore.Runtime) elections.RenewLeadershipHandler {
+	return &renewLeadership{rt: rt}
+}
+
+type renewLeadership struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the renew leadership request
+func (d *renewLeadership) Handle(params elections.RenewLeadershipParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	lock, err := d.rt.DB().RenewLock(electionLock(params.Name), uint64(params.Token))
+	if err != nil {
+		if err == persist.ErrLockLost {
+			return elections.NewRenewLeadershipConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return elections.NewRenewLeadershipDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return elections.NewRenewLeadershipOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
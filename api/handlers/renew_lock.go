@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/locks"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRenewLock handles a request for renewing the lease on a lock
+func NewRenewLock(rt *kvstore.Runtime) locks.RenewLockHandler {
+	return &renewLock{rt: rt}
+}
+
+type renewLock struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the renew lock request
+func (d *renewLock) Handle(params locks.RenewLockParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	lock, err := d.rt.DB().RenewLock(params.Name, uint64(params.Token))
+	if err != nil {
+		if err == persist.ErrLockLost {
+			return locks.NewRenewLockConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return locks.NewRenewLockDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return locks.NewRenewLockOK().WithXRequestID(rid).WithPayload(modelsLock(params.Name, lock))
+}
@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRenewSession handles a request for renewing a session
+func NewRenewSession(rt *kvstore.Runtime) sessions.RenewSessionHandler {
+	return &renewSession{rt: rt}
+}
+
+type renewSession struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the renew session request
+func (d *renewSession) Handle(params sessions.RenewSessionParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	session, err := d.rt.DB().RenewSession(params.ID)
+	if err != nil {
+		if err == persist.ErrNotFound {
+			return sessions.NewRenewSessionNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return sessions.NewRenewSessionDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return sessions.NewRenewSessionOK().WithXRequestID(rid).WithPayload(modelsSession(session))
+}
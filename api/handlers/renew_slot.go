@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/semaphores"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRenewSlot handles a request for renewing the lease on a slot of a semaphore
+func NewRenewSlot(rt *kvstore.Runtime) semaphores.RenewSlotHandler {
+	return &renewSlot{rt: rt}
+}
+
+type renewSlot struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the renew slot request
+func (d *renewSlot) Handle(params semaphores.RenewSlotParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	sem, err := d.rt.DB().RenewSlot(params.Name, params.Holder)
+	if err != nil {
+		if err == persist.ErrSlotLost {
+			return semaphores.NewRenewSlotConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return semaphores.NewRenewSlotDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return semaphores.NewRenewSlotOK().WithXRequestID(rid).WithPayload(modelsSemaphore(sem))
+}
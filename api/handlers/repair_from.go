@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/merkle"
+	"github.com/go-openapi/kvstore/replication"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewRepairFrom handles a request for repairing the store from a peer
+func NewRepairFrom(rt *kvstore.Runtime) merkle.RepairFromHandler {
+	return &repairFrom{rt: rt}
+}
+
+type repairFrom struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the repair from request
+func (d *repairFrom) Handle(params merkle.RepairFromParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	result, err := replication.Repair(d.rt.DB(), swag.StringValue(params.Body.Peer))
+	if err != nil {
+		return merkle.NewRepairFromDefault(http.StatusBadGateway).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return merkle.NewRepairFromOK().WithXRequestID(rid).WithPayload(&models.RepairResult{
+		Buckets: swag.Int64(int64(result.Buckets)),
+		Copied:  swag.Int64(int64(result.Copied)),
+		Kept:    swag.Int64(int64(result.Kept)),
+	})
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/elections"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewResign handles a request for giving up the leadership
+func NewResign(rt *kvstore.Runtime) elections.ResignHandler {
+	return &resign{rt: rt}
+}
+
+type resign struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the resign request
+func (d *resign) Handle(params elections.ResignParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	if err := d.rt.DB().ReleaseLock(electionLock(params.Name), uint64(params.Token)); err != nil {
+		if err == persist.ErrLockLost {
+			return elections.NewResignConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return elections.NewResignDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return elections.NewResignNoContent().WithXRequestID(rid)
+}
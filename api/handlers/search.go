@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/search"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewSearch handles a request for finding the entries that contain words
+func NewSearch(rt *kvstore.Runtime) search.SearchHandler {
+	return &searchEntries{rt: rt}
+}
+
+type searchEntries struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the search request
+func (d *searchEntries) Handle(params search.SearchParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	found, err := d.rt.DB().Search(params.Q, swag.StringValue(params.Prefix), int(swag.Int64Value(params.Limit)))
+	if err != nil {
+		if err == persist.ErrSearchDisabled {
+			return search.NewSearchConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return search.NewSearchDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	result := make([]*models.SearchResult, 0, len(found))
+	for _, r := range found {
+		result = append(result, &models.SearchResult{
+			Key:     swag.String(r.Key),
+			Score:   swag.Float64(r.Score),
+			Snippet: swag.String(r.Snippet),
+		})
+	}
+	return search.NewSearchOK().WithXRequestID(rid).WithPayload(result)
+}
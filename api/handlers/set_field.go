@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/hashes"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// fieldVersion parses the optional If-Match header of the field operations
+func fieldVersion(ifMatch *string) (*uint64, error) {
+	if swag.StringValue(ifMatch) == "" {
+		return nil, nil
+	}
+	version, err := strconv.ParseUint(*ifMatch, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// NewSetField handles a request for setting a field of a hash
+func NewSetField(rt *kvstore.Runtime) hashes.SetFieldHandler {
+	return &setField{rt: rt}
+}
+
+type setField struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the set field request
+func (d *setField) Handle(params hashes.SetFieldParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	version, err := fieldVersion(params.IfMatch)
+	if err != nil {
+		return hashes.NewSetFieldDefault(http.StatusBadRequest).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+
+	data, err := ioutil.ReadAll(params.Body)
+	e := params.Body.Close()
+	if err != nil {
+		return hashes.NewSetFieldDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	if e != nil {
+		return hashes.NewSetFieldDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(e))
+	}
+
+	value, hashVersion, err := d.rt.DB().HSet(params.Key, params.Field, data, version)
+	if err != nil {
+		if err == persist.ErrVersionMismatch {
+			return hashes.NewSetFieldConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return hashes.NewSetFieldDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return hashes.NewSetFieldNoContent().
+		WithXRequestID(rid).
+		WithETag(strconv.FormatUint(value.Version, 10)).
+		WithXHashVersion(strconv.FormatUint(hashVersion, 10))
+}
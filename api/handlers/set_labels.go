@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/kvstore"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+)
+
+// NewSetLabels handles a request for replacing the labels of an entry
+func NewSetLabels(rt *kvstore.Runtime) kv.SetLabelsHandler {
+	return &setLabels{rt: rt}
+}
+
+type setLabels struct {
+	rt *kvstore.Runtime
+}
+
+// Handle the set labels request
+func (d *setLabels) Handle(params kv.SetLabelsParams) middleware.Responder {
+	rid := swag.StringValue(params.XRequestID)
+
+	var version uint64
+	if swag.StringValue(params.IfMatch) != "" {
+		var err error
+		version, err = strconv.ParseUint(swag.StringValue(params.IfMatch), 10, 64)
+		if err != nil {
+			return kv.NewSetLabelsBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+	}
+
+	labels := params.Body.Labels
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	value, err := d.rt.DB().SetLabels(params.Key, labels, version)
+	if err != nil {
+		switch err {
+		case persist.ErrInvalidLabel:
+			return kv.NewSetLabelsBadRequest().WithXRequestID(rid).WithPayload(modelsError(err))
+		case persist.ErrNotFound:
+			return kv.NewSetLabelsNotFound().WithXRequestID(rid).WithPayload(modelsError(err))
+		case persist.ErrVersionMismatch:
+			return kv.NewSetLabelsConflict().WithXRequestID(rid).WithPayload(modelsError(err))
+		}
+		return kv.NewSetLabelsDefault(http.StatusInternalServerError).WithXRequestID(rid).WithPayload(modelsError(err))
+	}
+	return kv.NewSetLabelsNoContent().WithXRequestID(rid).WithETag(strconv.FormatUint(value.Version, 10))
+}
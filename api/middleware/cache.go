@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+
+	"github.com/go-openapi/kvstore/replication"
+)
+
+// Cache is a store that caches the entries of an upstream store
+type Cache interface {
+	Upstream() *url.URL
+	Read(string) (string, error)
+	Invalidate(string)
+}
+
+// NewCache serves the reads of entries from the cache and forwards every other request to the upstream.
+//
+// A read makes the cache revalidate its copy of the entry when the copy is no longer fresh, the responses say how
+// with an X-Cache header. When the upstream is unreachable the reads get the copy the cache has, those responses
+// have an X-Cache header of stale and a Warning header. The writes to an entry make the cache revalidate it on the
+// next read, the copies of the other entries a write changes get replaced from the changelog of the upstream.
+func NewCache(cache Cache, base string, suffixes, reserved []string) func(http.Handler) http.Handler {
+	proxy := httputil.NewSingleHostReverseProxy(cache.Upstream())
+	proxy.ErrorHandler = func(rw http.ResponseWriter, r *http.Request, err error) {
+		writeClusterError(rw, http.StatusBadGateway, "the upstream is unreachable: "+err.Error())
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			escaped, suffix, ok := splitKeyPath(r.URL.EscapedPath(), base, suffixes)
+			key, err := url.PathUnescape(escaped)
+			if !ok || err != nil || key == "" || contains(reserved, key) {
+				proxy.ServeHTTP(rw, r)
+				return
+			}
+
+			if suffix == "" && (r.Method == http.MethodGet || r.Method == http.MethodHead) {
+				how, err := cache.Read(key)
+				if err != nil {
+					writeClusterError(rw, http.StatusBadGateway, "the entry is not cached and the upstream is unreachable: "+err.Error())
+					return
+				}
+				rw.Header().Set("X-Cache", how)
+				if how == replication.CacheStale {
+					rw.Header().Set("Warning", `110 - "Response is Stale"`)
+				}
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			if r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions {
+				defer cache.Invalidate(key)
+			}
+			proxy.ServeHTTP(rw, r)
+		})
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,198 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/kvstore/cluster"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// Cluster is the raft cluster a store is a member of
+type Cluster interface {
+	IsLeader() bool
+	Leader() (cluster.Member, bool)
+	Propose(context.Context, []byte) ([]byte, error)
+	ReadIndex(context.Context) error
+	Stamp() (int64, string)
+}
+
+// The consistency a read asks for with the X-Consistency header
+const (
+	ConsistencyLinearizable = "linearizable"
+	ConsistencyStale        = "stale"
+)
+
+// forwardedHeader marks a request a member forwarded to the leader, the leader doesn't forward it again
+const forwardedHeader = "X-Kvstore-Forwarded"
+
+// clusterAdminPrefix is the path of the cluster admin api, the leader serves it without replaying it
+const clusterAdminPrefix = "/cluster"
+
+// nondeterministicPrefixes are the paths of the writes that depend on the clock or on random ids of the member
+// that serves them, these can't be replayed on the other members
+var nondeterministicPrefixes = []string{"/sessions", "/locks", "/elections", "/semaphores", "/queues", "/sequences"}
+
+// commandHeaders are the headers the writes depend on, these are part of the command that gets replayed
+var commandHeaders = []string{"Accept", "Content-Type", "If-Match", "If-None-Match", "X-Request-Id"}
+
+// NewCluster sends the writes through the raft log of the cluster.
+//
+// The leader proposes the writes as commands, every member replays them against its store once they are
+// committed and the leader responds with the response it recorded. A follower forwards the writes to the leader,
+// or redirects to the leader when redirect is set. The reads are linearizable unless they ask for stale reads
+// with an X-Consistency header, a follower forwards the linearizable reads to the leader.
+func NewCluster(c Cluster, redirect bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			read := r.Method == http.MethodGet || r.Method == http.MethodHead || r.Method == http.MethodOptions
+			if read {
+				consistency := r.Header.Get("X-Consistency")
+				switch consistency {
+				case "", ConsistencyLinearizable:
+				case ConsistencyStale:
+					next.ServeHTTP(rw, r)
+					return
+				default:
+					writeClusterError(rw, http.StatusBadRequest, "the consistency "+consistency+" is not stale or linearizable")
+					return
+				}
+				// the status of a member is about that member
+				if r.URL.Path == clusterAdminPrefix {
+					next.ServeHTTP(rw, r)
+					return
+				}
+			}
+			if !read {
+				for _, prefix := range nondeterministicPrefixes {
+					if strings.HasPrefix(r.URL.Path, prefix) {
+						writeClusterError(rw, http.StatusNotImplemented, prefix+" can't be written in cluster mode")
+						return
+					}
+				}
+			}
+
+			if !c.IsLeader() {
+				toLeader(c, redirect, rw, r)
+				return
+			}
+			if read {
+				if err := c.ReadIndex(r.Context()); err != nil {
+					writeClusterError(rw, http.StatusServiceUnavailable, err.Error())
+					return
+				}
+				next.ServeHTTP(rw, r)
+				return
+			}
+			if strings.HasPrefix(r.URL.Path, clusterAdminPrefix) {
+				next.ServeHTTP(rw, r)
+				return
+			}
+			propose(c, rw, r)
+		})
+	}
+}
+
+// toLeader forwards the request to the leader or redirects to it
+func toLeader(c Cluster, redirect bool, rw http.ResponseWriter, r *http.Request) {
+	leader, ok := c.Leader()
+	if !ok || r.Header.Get(forwardedHeader) != "" {
+		writeClusterError(rw, http.StatusServiceUnavailable, "there is no leader, try again after the election")
+		return
+	}
+	target, err := url.Parse(leader.APIURL)
+	if err != nil {
+		writeClusterError(rw, http.StatusInternalServerError, err.Error())
+		return
+	}
+	rw.Header().Set("X-Leader", leader.APIURL)
+	if redirect {
+		http.Redirect(rw, r, strings.TrimSuffix(leader.APIURL, "/")+r.URL.RequestURI(), http.StatusTemporaryRedirect)
+		return
+	}
+	r.Header.Set(forwardedHeader, "true")
+	httputil.NewSingleHostReverseProxy(target).ServeHTTP(rw, r)
+}
+
+// propose proposes the write as a command and responds with the response of replaying it on the leader
+func propose(c Cluster, rw http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeClusterError(rw, http.StatusBadRequest, err.Error())
+		return
+	}
+	cmd := &cluster.Command{Method: r.Method, URI: r.URL.RequestURI(), Header: make(map[string]string), Body: body}
+	cmd.Time, cmd.Origin = c.Stamp()
+	for _, h := range commandHeaders {
+		if v := r.Header.Get(h); v != "" {
+			cmd.Header[h] = v
+		}
+	}
+	data, err := cmd.MarshalMsg(nil)
+	if err != nil {
+		writeClusterError(rw, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	data, err = c.Propose(r.Context(), data)
+	if err != nil {
+		status := http.StatusServiceUnavailable
+		if err == context.Canceled || err == context.DeadlineExceeded {
+			status = http.StatusGatewayTimeout
+		}
+		writeClusterError(rw, status, err.Error())
+		return
+	}
+	var result cluster.CommandResult
+	if _, err := result.UnmarshalMsg(data); err != nil {
+		writeClusterError(rw, http.StatusInternalServerError, err.Error())
+		return
+	}
+	for k, v := range result.Header {
+		rw.Header().Set(k, v)
+	}
+	rw.WriteHeader(result.Status)
+	_, _ = rw.Write(result.Body)
+}
+
+// NewClusterApply replays the committed commands against the handler, the handler writes to the store of the member.
+// The result is the response the handler recorded.
+func NewClusterApply(handler http.Handler) cluster.ApplyFunc {
+	return func(data []byte) []byte {
+		rec := httptest.NewRecorder()
+		var cmd cluster.Command
+		if _, err := cmd.UnmarshalMsg(data); err != nil {
+			writeClusterError(rec, http.StatusInternalServerError, err.Error())
+		} else {
+			req := httptest.NewRequest(cmd.Method, cmd.URI, bytes.NewReader(cmd.Body))
+			for k, v := range cmd.Header {
+				req.Header.Set(k, v)
+			}
+			handler.ServeHTTP(rec, req)
+		}
+
+		result := &cluster.CommandResult{Status: rec.Code, Header: make(map[string]string), Body: rec.Body.Bytes()}
+		for k := range rec.Header() {
+			result.Header[k] = rec.Header().Get(k)
+		}
+		out, _ := result.MarshalMsg(nil)
+		return out
+	}
+}
+
+func writeClusterError(rw http.ResponseWriter, code int, message string) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(code)
+	_ = json.NewEncoder(rw).Encode(&models.Error{
+		Code:    swag.Int64(int64(code)),
+		Message: swag.String(message),
+	})
+}
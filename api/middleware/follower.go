@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/swag"
+)
+
+// Follower is a store that follows a primary
+type Follower interface {
+	Primary() string
+	Staleness() time.Duration
+}
+
+// unreplicatedPrefixes are the paths of the data that is not in the changelog of the primary,
+// a follower doesn't have it
+var unreplicatedPrefixes = []string{
+	"/sessions", "/locks", "/elections", "/semaphores", "/queues", "/sequences", "/zsets", "/hashes", "/indexes",
+}
+
+// NewFollower serves the reads of a follower and rejects its writes, the writes go to the primary.
+//
+// The responses to the reads have an X-Staleness header with the time in milliseconds since the follower
+// last applied all the changes of the primary, the reads can miss the writes of this period.
+// Only the entries are replicated, the requests for the other data are rejected with a 501 and go to the primary.
+func NewFollower(follower Follower) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			for _, prefix := range unreplicatedPrefixes {
+				if strings.HasPrefix(r.URL.Path, prefix) {
+					rw.Header().Set("X-Primary", follower.Primary())
+					writeClusterError(rw, http.StatusNotImplemented, prefix+" is not replicated, it is served by the primary "+follower.Primary())
+					return
+				}
+			}
+
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				staleness := follower.Staleness() / time.Millisecond
+				rw.Header().Set("X-Staleness", strconv.FormatInt(int64(staleness), 10))
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			rw.Header().Set("Content-Type", "application/json")
+			rw.Header().Set("X-Primary", follower.Primary())
+			rw.WriteHeader(http.StatusForbidden)
+			_ = json.NewEncoder(rw).Encode(&models.Error{
+				Code:    swag.Int64(http.StatusForbidden),
+				Message: swag.String("this store follows " + follower.Primary() + ", writes go to the primary"),
+			})
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Package middleware contains the http middlewares that prepare requests for the kvstore api
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/loads"
+)
+
+// KeySuffixes collects the routes that are nested below an entry key in the spec,
+// for a path like /kv/{key}/_copy this returns _copy
+func KeySuffixes(doc *loads.Document, base string) []string {
+	var suffixes []string
+	for path := range doc.Analyzer.AllPaths() {
+		if strings.HasPrefix(path, base+"{key}/") {
+			suffixes = append(suffixes, strings.TrimPrefix(path, base+"{key}"))
+		}
+	}
+	return suffixes
+}
+
+// ReservedKeys collects the routes right below the base path in the spec, the router matches these before
+// an entry key, for a path like /kv/_stats this returns _stats
+func ReservedKeys(doc *loads.Document, base string) []string {
+	var reserved []string
+	for path := range doc.Analyzer.AllPaths() {
+		name := strings.TrimPrefix(path, base)
+		if strings.HasPrefix(path, base) && name != "{key}" && !strings.Contains(name, "/") {
+			reserved = append(reserved, name)
+		}
+	}
+	return reserved
+}
+
+// NewHierarchicalKeys allows keys that contain slashes to be used without escaping them.
+//
+// The router matches a path parameter against a single path segment, so for requests below the base path
+// this escapes the slashes in the key before routing. When the path ends with one of the suffixes, that part
+// of the path is not considered to be part of the key, so a key that ends with one of the suffixes can only be
+// used with its slashes escaped.
+func NewHierarchicalKeys(base string, suffixes []string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			key, suffix, ok := splitKeyPath(r.URL.EscapedPath(), base, suffixes)
+			if !ok || !strings.Contains(key, "/") {
+				next.ServeHTTP(rw, r)
+				return
+			}
+
+			// the unescaped path stays the same, only the way it is escaped changes
+			u := *r.URL
+			u.RawPath = base + strings.Replace(key, "/", "%2F", -1) + suffix
+			r2 := new(http.Request)
+			*r2 = *r
+			r2.URL = &u
+			next.ServeHTTP(rw, r2)
+		})
+	}
+}
+
+// splitKeyPath splits an escaped path below the base path into the key and the suffix of the nested route,
+// it reports if the path is below the base path
+func splitKeyPath(path, base string, suffixes []string) (string, string, bool) {
+	if !strings.HasPrefix(path, base) {
+		return "", "", false
+	}
+	key := strings.TrimPrefix(path, base)
+	for _, s := range suffixes {
+		if len(key) > len(s) && strings.HasSuffix(key, s) {
+			return strings.TrimSuffix(key, s), s, true
+		}
+	}
+	return key, "", true
+}
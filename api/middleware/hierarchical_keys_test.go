@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHierarchicalKeys(t *testing.T) {
+	suffixes := []string{"/_copy", "/_labels"}
+	tests := []struct {
+		path, want string
+	}{
+		{"/kv/a", "/kv/a"},
+		{"/kv/a/b", "/kv/a%2Fb"},
+		{"/kv/a/b/_copy", "/kv/a%2Fb/_copy"},
+		{"/kv/a/_copy", "/kv/a/_copy"},
+		{"/kv/_copy", "/kv/_copy"},
+		{"/kv/a/_copy/b", "/kv/a%2F_copy%2Fb"},
+		// a key that ends with the name of an operation is shadowed by it unless its slashes are escaped
+		{"/kv/a%2F_copy", "/kv/a%2F_copy"},
+		{"/kv/a/b%2F_labels", "/kv/a%2Fb%2F_labels"},
+		{"/other/a/b", "/other/a/b"},
+	}
+	for _, tt := range tests {
+		var got string
+		h := NewHierarchicalKeys("/kv/", suffixes)(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			got = r.URL.EscapedPath()
+		}))
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", tt.path, nil))
+		if got != tt.want {
+			t.Errorf("%s: routed as %s, want %s", tt.path, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,590 @@
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+)
+
+var errPartitioned = errors.New("partitioned")
+
+// faults decides which raft requests get through, it is shared by the transports of the members of a test cluster
+type faults struct {
+	mu      sync.Mutex
+	blocked map[[2]string]bool
+}
+
+// isolate cuts the member off from the others in both directions
+func (f *faults) isolate(id string, others ...string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, o := range others {
+		f.blocked[[2]string{id, o}] = true
+		f.blocked[[2]string{o, id}] = true
+	}
+}
+
+func (f *faults) heal() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.blocked = make(map[[2]string]bool)
+}
+
+func (f *faults) check(from, to string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.blocked[[2]string{from, to}] {
+		return errPartitioned
+	}
+	return nil
+}
+
+// faultyTransport sends the requests of a member over http unless the faults block them
+type faultyTransport struct {
+	from   string
+	faults *faults
+	next   Transport
+}
+
+func (f *faultyTransport) RequestVote(m Member, req *VoteRequest) (*VoteResponse, error) {
+	if err := f.faults.check(f.from, m.ID); err != nil {
+		return nil, err
+	}
+	resp, err := f.next.RequestVote(m, req)
+	if err == nil {
+		// the response can get lost too
+		err = f.faults.check(m.ID, f.from)
+	}
+	return resp, err
+}
+
+func (f *faultyTransport) AppendEntries(m Member, req *AppendRequest) (*AppendResponse, error) {
+	if err := f.faults.check(f.from, m.ID); err != nil {
+		return nil, err
+	}
+	resp, err := f.next.AppendEntries(m, req)
+	if err == nil {
+		err = f.faults.check(m.ID, f.from)
+	}
+	return resp, err
+}
+
+func (f *faultyTransport) InstallSnapshot(m Member, req *SnapshotRequest, dump io.Reader) (*SnapshotResponse, error) {
+	if err := f.faults.check(f.from, m.ID); err != nil {
+		return nil, err
+	}
+	resp, err := f.next.InstallSnapshot(m, req, dump)
+	if err == nil {
+		err = f.faults.check(m.ID, f.from)
+	}
+	return resp, err
+}
+
+// testMember is a member of a test cluster with a store of its own
+type testMember struct {
+	member Member
+	dir    string
+	store  persist.Store
+	node   *Node
+}
+
+type testCluster struct {
+	t         *testing.T
+	faults    *faults
+	threshold uint64
+	members   map[string]*testMember
+}
+
+func newTestCluster(t *testing.T, size int, threshold uint64) *testCluster {
+	c := &testCluster{
+		t:         t,
+		faults:    &faults{blocked: make(map[[2]string]bool)},
+		threshold: threshold,
+		members:   make(map[string]*testMember),
+	}
+	t.Cleanup(c.stop)
+
+	first := c.start(c.newMember("n1"), true)
+	c.waitForLeader()
+	for i := 2; i <= size; i++ {
+		m := c.newMember(fmt.Sprintf("n%d", i))
+		c.start(m, false)
+		if err := first.node.AddMember(context.Background(), m.member); err != nil {
+			t.Fatalf("adding %s failed: %v", m.member.ID, err)
+		}
+	}
+	return c
+}
+
+func freeAddress(t *testing.T) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().String()
+}
+
+func (c *testCluster) newMember(id string) *testMember {
+	m := &testMember{
+		member: Member{ID: id, PeerAddress: freeAddress(c.t)},
+		dir:    c.t.TempDir(),
+	}
+	c.members[id] = m
+	return m
+}
+
+// apply puts key=value commands over the value that is there and answers with the version of the entry,
+// the command can be the body of a Command
+func apply(store persist.Store) ApplyFunc {
+	return func(data []byte) []byte {
+		var cmd Command
+		if _, err := cmd.UnmarshalMsg(data); err == nil {
+			data = cmd.Body
+		}
+		kv := bytes.SplitN(data, []byte("="), 2)
+		key := string(kv[0])
+		v, _ := store.Get(key)
+		if err := store.Put(key, &persist.Value{Value: kv[1], Version: v.Version}); err != nil {
+			return []byte(err.Error())
+		}
+		v, _ = store.Get(key)
+		return []byte(fmt.Sprint(v.Version))
+	}
+}
+
+func (c *testCluster) start(m *testMember, bootstrap bool) *testMember {
+	cfg := viper.New()
+	cfg.Set("store.path", filepath.Join(m.dir, "store"))
+	store, err := persist.NewGoLevelDBStore(cfg)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	node, err := NewNode(store, Config{
+		Self:              m.member,
+		Path:              filepath.Join(m.dir, "raft"),
+		Bootstrap:         bootstrap,
+		HeartbeatInterval: 20 * time.Millisecond,
+		ElectionTimeout:   150 * time.Millisecond,
+		SnapshotThreshold: c.threshold,
+		Transport:         &faultyTransport{from: m.member.ID, faults: c.faults, next: NewHTTPTransport(time.Second)},
+		Log:               log,
+	})
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	if err := node.Start(apply(store)); err != nil {
+		c.t.Fatal(err)
+	}
+	m.store, m.node = store, node
+	return m
+}
+
+func (c *testCluster) kill(m *testMember) {
+	if m.node == nil {
+		return
+	}
+	m.node.Stop()
+	m.store.Close()
+	m.node, m.store = nil, nil
+}
+
+func (c *testCluster) stop() {
+	for _, m := range c.members {
+		c.kill(m)
+	}
+}
+
+func (c *testCluster) ids() []string {
+	var ids []string
+	for id := range c.members {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// waitForLeader waits until one of the running members leads and the others agree
+func (c *testCluster) waitForLeader(exclude ...string) *testMember {
+	var leader *testMember
+	waitFor(c.t, "a leader", func() bool {
+		leader = nil
+		for _, m := range c.members {
+			if m.node == nil || contains(exclude, m.member.ID) || !m.node.IsLeader() {
+				continue
+			}
+			if leader != nil {
+				return false
+			}
+			leader = m
+		}
+		if leader == nil {
+			return false
+		}
+		for _, m := range c.members {
+			if m.node == nil || contains(exclude, m.member.ID) || m == leader {
+				continue
+			}
+			if l, ok := m.node.Leader(); !ok || l.ID != leader.member.ID {
+				return false
+			}
+		}
+		return true
+	})
+	return leader
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *testCluster) propose(m *testMember, command string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	_, err := m.node.Propose(ctx, []byte(command))
+	return err
+}
+
+// waitForValue waits until the store of the member has the value at key
+func (c *testCluster) waitForValue(m *testMember, key, value string) {
+	waitFor(c.t, fmt.Sprintf("%s=%s on %s", key, value, m.member.ID), func() bool {
+		v, err := m.store.Get(key)
+		return err == nil && string(v.Value) == value
+	})
+}
+
+func TestElection(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	for _, m := range c.members {
+		status := m.node.Status()
+		if len(status.Members) != 3 {
+			t.Errorf("%s knows the members %v", m.member.ID, status.Members)
+		}
+		if m != leader && status.State != StateFollower {
+			t.Errorf("%s is a %s next to the leader %s", m.member.ID, status.State, leader.member.ID)
+		}
+	}
+	if err := c.propose(c.members[followerOf(c, leader)], "a=1"); err != ErrNotLeader {
+		t.Errorf("a follower accepted a proposal: %v", err)
+	}
+}
+
+func followerOf(c *testCluster, leader *testMember) string {
+	for id, m := range c.members {
+		if m != leader && m.node != nil {
+			return id
+		}
+	}
+	return ""
+}
+
+func TestReplication(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+
+	for i := 0; i < 50; i++ {
+		if err := c.propose(leader, fmt.Sprintf("k%d=%d", i, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result, err := leader.node.Propose(context.Background(), []byte("k0=again"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := leader.store.Get("k0"); string(result) != fmt.Sprint(v.Version) {
+		t.Errorf("the proposal resulted in %q instead of the version %d", result, v.Version)
+	}
+	for _, m := range c.members {
+		c.waitForValue(m, "k49", "49")
+		c.waitForValue(m, "k0", "again")
+	}
+}
+
+func TestFailover(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	if err := c.propose(leader, "a=1"); err != nil {
+		t.Fatal(err)
+	}
+
+	c.kill(leader)
+	next := c.waitForLeader(leader.member.ID)
+	if err := c.propose(next, "b=2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the old leader catches up when it comes back
+	c.start(leader, false)
+	c.waitForValue(leader, "a", "1")
+	c.waitForValue(leader, "b", "2")
+	if l, ok := leader.node.Leader(); !ok || l.ID != next.member.ID {
+		t.Errorf("the old leader follows %v instead of %s", l, next.member.ID)
+	}
+}
+
+func TestMinorityPartition(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	old := c.waitForLeader()
+	if err := c.propose(old, "a=before"); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range c.members {
+		c.waitForValue(m, "a", "before")
+	}
+
+	var others []string
+	for _, id := range c.ids() {
+		if id != old.member.ID {
+			others = append(others, id)
+		}
+	}
+	c.faults.isolate(old.member.ID, others...)
+
+	// the old leader can't commit without a majority, the majority elects a new leader that can
+	if err := c.propose(old, "a=minority"); err == nil {
+		t.Error("a leader without a majority committed a proposal")
+	}
+	next := c.waitForLeader(old.member.ID)
+	if err := c.propose(next, "a=majority"); err != nil {
+		t.Fatal(err)
+	}
+
+	c.faults.heal()
+	c.waitForLeader()
+	for _, m := range c.members {
+		c.waitForValue(m, "a", "majority")
+	}
+	if old.node.IsLeader() {
+		t.Error("the old leader still leads after the partition healed")
+	}
+}
+
+func TestSnapshotInstall(t *testing.T) {
+	c := newTestCluster(t, 3, 10)
+	leader := c.waitForLeader()
+	lagging := c.members[followerOf(c, leader)]
+	c.faults.isolate(lagging.member.ID, c.ids()...)
+
+	for i := 0; i < 50; i++ {
+		if err := c.propose(leader, fmt.Sprintf("k%d=%d", i, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if first := leader.node.storage.firstIndex(); first <= lagging.node.Status().LastIndex+1 {
+		t.Fatalf("the log of the leader starts at %d, that is not past the lagging member", first)
+	}
+
+	c.faults.heal()
+	for i := 0; i < 50; i++ {
+		c.waitForValue(lagging, fmt.Sprintf("k%d", i), fmt.Sprint(i))
+	}
+
+	// the entries after the snapshot get appended
+	if err := c.propose(leader, "after=1"); err != nil {
+		t.Fatal(err)
+	}
+	c.waitForValue(lagging, "after", "1")
+}
+
+func TestMembership(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	if err := c.propose(leader, "a=1"); err != nil {
+		t.Fatal(err)
+	}
+
+	n4 := c.start(c.newMember("n4"), false)
+	if err := leader.node.AddMember(context.Background(), n4.member); err != nil {
+		t.Fatal(err)
+	}
+	if err := leader.node.AddMember(context.Background(), n4.member); err != ErrMemberExists {
+		t.Errorf("adding a member twice resulted in %v", err)
+	}
+	c.waitForValue(n4, "a", "1")
+
+	removed := c.members[followerOf(c, leader)]
+	if err := leader.node.RemoveMember(context.Background(), removed.member.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := leader.node.RemoveMember(context.Background(), removed.member.ID); err != ErrMemberNotFound {
+		t.Errorf("removing a member twice resulted in %v", err)
+	}
+	c.kill(removed)
+	delete(c.members, removed.member.ID)
+
+	// the leader removes itself and the others go on without it
+	if err := leader.node.RemoveMember(context.Background(), leader.member.ID); err != nil {
+		t.Fatal(err)
+	}
+	c.kill(leader)
+	delete(c.members, leader.member.ID)
+	next := c.waitForLeader()
+	if err := c.propose(next, "b=2"); err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range c.members {
+		c.waitForValue(m, "b", "2")
+		if status := m.node.Status(); len(status.Members) != 2 {
+			t.Errorf("%s knows the members %v", m.member.ID, status.Members)
+		}
+	}
+}
+
+func TestReadIndex(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	if err := c.propose(leader, "a=1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := leader.node.ReadIndex(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if v, err := leader.store.Get("a"); err != nil || string(v.Value) != "1" {
+		t.Errorf("the leader reads %q, %v after the read index", v.Value, err)
+	}
+	if err := c.members[followerOf(c, leader)].node.ReadIndex(context.Background()); err != ErrNotLeader {
+		t.Errorf("a follower served a linearizable read: %v", err)
+	}
+
+	// a leader that can't reach a majority can't tell if it still leads
+	c.faults.isolate(leader.member.ID, c.ids()...)
+	ctx, cancel := context.WithTimeout(context.Background(), 300*time.Millisecond)
+	defer cancel()
+	if err := leader.node.ReadIndex(ctx); err == nil {
+		t.Error("an isolated leader served a linearizable read")
+	}
+}
+
+func TestCommandStamps(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+
+	at, origin := leader.node.Stamp()
+	data, err := (&Command{Body: []byte("k=v"), Time: at, Origin: origin}).MarshalMsg(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	if _, err := leader.node.Propose(ctx, data); err != nil {
+		t.Fatal(err)
+	}
+
+	// every member writes the entry with the time and the origin of the leader
+	for _, m := range c.members {
+		c.waitForValue(m, "k", "v")
+		v, err := m.store.Get("k")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.LastUpdated != at || v.Origin != origin {
+			t.Errorf("%s wrote the entry at %d by %s, want %d by %s", m.member.ID, v.LastUpdated, v.Origin, at, origin)
+		}
+	}
+}
+
+func TestAppliedWithTheWrites(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	for i := 0; i < 5; i++ {
+		if err := c.propose(leader, fmt.Sprintf("k%d=%d", i, i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	follower := c.members[followerOf(c, leader)]
+	c.waitForValue(follower, "k4", "4")
+	applied, err := follower.store.CommandApplied()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if applied == 0 {
+		t.Fatal("the store doesn't have the index of the commands it applied")
+	}
+
+	// the member stopped after the writes of the commands but before it stored their index in the log
+	c.kill(follower)
+	s, err := openStorage(filepath.Join(follower.dir, "raft"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.setApplied(1); err != nil {
+		t.Fatal(err)
+	}
+	s.close()
+
+	c.start(follower, false)
+	if got := follower.node.Status().AppliedIndex; got < applied {
+		t.Errorf("the member starts with entry %d applied, the store has %d", got, applied)
+	}
+}
+
+func TestHalfRestoredStore(t *testing.T) {
+	c := newTestCluster(t, 3, 0)
+	leader := c.waitForLeader()
+	if err := c.propose(leader, "before=1"); err != nil {
+		t.Fatal(err)
+	}
+	follower := c.members[followerOf(c, leader)]
+	c.waitForValue(follower, "before", "1")
+
+	// the member stopped while restoring a snapshot, the store holds a part of it
+	c.kill(follower)
+	s, err := openStorage(filepath.Join(follower.dir, "raft"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.beginRestore(); err != nil {
+		t.Fatal(err)
+	}
+	s.close()
+	cfg := viper.New()
+	cfg.Set("store.path", filepath.Join(follower.dir, "store"))
+	store, err := persist.NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("partial", &persist.Value{Value: []byte("x")}); err != nil {
+		t.Fatal(err)
+	}
+	store.Close()
+
+	// it starts over with an empty store and gets everything from the leader again
+	c.start(follower, false)
+	if err := c.propose(leader, "after=2"); err != nil {
+		t.Fatal(err)
+	}
+	c.waitForValue(follower, "before", "1")
+	c.waitForValue(follower, "after", "2")
+	if _, err := follower.store.Get("partial"); err != persist.ErrNotFound {
+		t.Errorf("the half restored entry is still there: %v", err)
+	}
+}
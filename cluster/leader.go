@@ -0,0 +1,411 @@
+package cluster
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+func (n *Node) becomeLeader() {
+	n.state = StateLeader
+	n.leader = n.cfg.Self.ID
+	n.progress = make(map[string]*progress)
+	n.log.Infof("%s leads in term %d", n.cfg.Self.ID, n.storage.state.Term)
+
+	// the leader knows which entries are committed once an entry of its own term is committed
+	index, err := n.appendEntry(EntryNoop, nil)
+	if err != nil {
+		n.log.Errorf("appending the first entry of term %d failed: %v", n.storage.state.Term, err)
+		n.stepDown(n.storage.state.Term)
+		return
+	}
+	n.noopIndex = index
+	n.syncProgress()
+	n.advanceCommit()
+}
+
+// appendEntry appends an entry of the current term to the log of the leader
+func (n *Node) appendEntry(typ string, data []byte) (uint64, error) {
+	entry := Entry{Index: n.storage.lastIndex + 1, Term: n.storage.state.Term, Type: typ, Data: data}
+	if err := n.storage.append([]Entry{entry}); err != nil {
+		return 0, err
+	}
+	if typ == EntryMembers {
+		n.trackConfig([]Entry{entry})
+		n.syncProgress()
+	}
+	return entry.Index, nil
+}
+
+// syncProgress starts replicating to the members that were added and stops replicating to the ones that were removed
+func (n *Node) syncProgress() {
+	for id, p := range n.progress {
+		if _, ok := n.config.member(id); !ok {
+			close(p.stop)
+			delete(n.progress, id)
+		}
+	}
+	for _, m := range n.config.members {
+		if _, ok := n.progress[m.ID]; ok || m.ID == n.cfg.Self.ID {
+			continue
+		}
+		p := &progress{
+			member:  m,
+			next:    n.storage.lastIndex + 1,
+			trigger: make(chan struct{}, 1),
+			stop:    make(chan struct{}),
+		}
+		n.progress[m.ID] = p
+		n.wg.Add(1)
+		go n.replicate(p, n.storage.state.Term)
+	}
+}
+
+// triggerReplication makes the replication send the new entries without waiting for the next heartbeat
+func (n *Node) triggerReplication() {
+	for _, p := range n.progress {
+		p.wake()
+	}
+}
+
+func (p *progress) wake() {
+	select {
+	case p.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// replicate sends the entries to a member until it stops leading
+func (n *Node) replicate(p *progress, term uint64) {
+	defer n.wg.Done()
+	ticker := time.NewTicker(n.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		n.send(p, term)
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		case <-p.trigger:
+		}
+	}
+}
+
+// send sends the entries the member doesn't have yet or a snapshot when they were compacted
+func (n *Node) send(p *progress, term uint64) {
+	n.mu.Lock()
+	if n.state != StateLeader || n.storage.state.Term != term {
+		n.mu.Unlock()
+		return
+	}
+	if p.next < n.storage.firstIndex() {
+		n.mu.Unlock()
+		n.sendSnapshot(p, term)
+		return
+	}
+	round := n.readRound
+	prev := p.next - 1
+	prevTerm, err := n.storage.term(prev)
+	var entries []Entry
+	if err == nil {
+		entries, err = n.storage.entries(p.next, n.storage.lastIndex, maxAppendEntries)
+	}
+	if err != nil {
+		n.mu.Unlock()
+		n.log.Errorf("reading the entries for %s failed: %v", p.member.ID, err)
+		return
+	}
+	req := &AppendRequest{
+		Term:         term,
+		Leader:       n.cfg.Self.ID,
+		PrevLogIndex: prev,
+		PrevLogTerm:  prevTerm,
+		Entries:      entries,
+		LeaderCommit: n.commitIndex,
+	}
+	n.mu.Unlock()
+
+	resp, err := n.transport.AppendEntries(p.member, req)
+	if err != nil {
+		n.log.Debugf("appending to %s failed: %v", p.member.ID, err)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if resp.Term > n.storage.state.Term {
+		n.stepDown(resp.Term)
+		return
+	}
+	if n.state != StateLeader || n.storage.state.Term != term {
+		return
+	}
+	if round > p.acked {
+		p.acked = round
+		n.cond.Broadcast()
+	}
+
+	if resp.Success {
+		if match := prev + uint64(len(entries)); match > p.match {
+			p.match = match
+		}
+		p.next = p.match + 1
+		n.advanceCommit()
+	} else {
+		next := resp.LastIndex + 1
+		if next >= p.next {
+			next = p.next - 1
+		}
+		if next < 1 {
+			next = 1
+		}
+		p.next = next
+	}
+	if p.next <= n.storage.lastIndex {
+		p.wake()
+	}
+}
+
+// firstWrite closes started when the dump writes for the first time, the dump has its snapshot of the store then
+type firstWrite struct {
+	io.Writer
+	once    sync.Once
+	started chan struct{}
+}
+
+func (f *firstWrite) Write(p []byte) (int, error) {
+	f.start()
+	return f.Writer.Write(p)
+}
+
+func (f *firstWrite) start() {
+	f.once.Do(func() { close(f.started) })
+}
+
+// sendSnapshot sends a dump of the store with the entries that were applied to it
+func (n *Node) sendSnapshot(p *progress, term uint64) {
+	// nothing gets applied until the dump took its snapshot of the store, so it matches the applied index
+	n.applyMu.Lock()
+	n.mu.Lock()
+	index := n.storage.applied
+	logTerm, err := n.storage.term(index)
+	members, ok := n.membersAt(index)
+	n.mu.Unlock()
+	if err != nil || !ok {
+		n.applyMu.Unlock()
+		n.log.Errorf("the members as of entry %d for the snapshot of %s are not known: %v", index, p.member.ID, err)
+		return
+	}
+
+	pr, pw := io.Pipe()
+	w := &firstWrite{Writer: pw, started: make(chan struct{})}
+	go func() {
+		err := n.store.Dump(w)
+		w.start()
+		pw.CloseWithError(err)
+	}()
+	<-w.started
+	n.applyMu.Unlock()
+
+	n.log.Infof("%s sends a snapshot up to entry %d to %s", n.cfg.Self.ID, index, p.member.ID)
+	req := &SnapshotRequest{Term: term, Leader: n.cfg.Self.ID, Index: index, LogTerm: logTerm, Members: members}
+	resp, err := n.transport.InstallSnapshot(p.member, req, pr)
+	pr.Close()
+	if err != nil {
+		n.log.Errorf("sending a snapshot to %s failed: %v", p.member.ID, err)
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if resp.Term > n.storage.state.Term {
+		n.stepDown(resp.Term)
+		return
+	}
+	if n.state != StateLeader || n.storage.state.Term != term {
+		return
+	}
+	if index > p.match {
+		p.match = index
+	}
+	p.next = p.match + 1
+	n.advanceCommit()
+	p.wake()
+}
+
+// advanceCommit commits the entries of the current term that a majority of the members have
+func (n *Node) advanceCommit() {
+	term := n.storage.state.Term
+	for index := n.storage.lastIndex; index > n.commitIndex; index-- {
+		t, err := n.storage.term(index)
+		if err != nil || t != term {
+			// the entries of earlier terms only get committed along with one of the current term
+			break
+		}
+		replicated := n.quorum(func(m Member) bool {
+			if m.ID == n.cfg.Self.ID {
+				return true
+			}
+			p, ok := n.progress[m.ID]
+			return ok && p.match >= index
+		})
+		if replicated {
+			n.commitIndex = index
+			n.cond.Broadcast()
+			n.triggerReplication()
+			break
+		}
+	}
+
+	// a leader that removed itself leads until the removal is committed
+	if _, ok := n.config.member(n.cfg.Self.ID); !ok && n.config.index <= n.commitIndex {
+		n.log.Infof("%s was removed from the cluster", n.cfg.Self.ID)
+		n.stepDown(term)
+		n.leader = ""
+	}
+}
+
+// Propose appends the command to the log and waits until it is applied,
+// it returns the result of applying the command on this member
+func (n *Node) Propose(ctx context.Context, data []byte) ([]byte, error) {
+	n.mu.Lock()
+	if n.state != StateLeader {
+		n.mu.Unlock()
+		return nil, ErrNotLeader
+	}
+	index, err := n.appendEntry(EntryCommand, data)
+	if err != nil {
+		n.mu.Unlock()
+		return nil, err
+	}
+	w := n.wait(index)
+	n.mu.Unlock()
+
+	return n.result(ctx, index, w)
+}
+
+func (n *Node) wait(index uint64) *waiter {
+	w := &waiter{term: n.storage.state.Term, done: make(chan result, 1)}
+	n.waiters[index] = w
+	n.triggerReplication()
+	n.advanceCommit()
+	return w
+}
+
+func (n *Node) result(ctx context.Context, index uint64, w *waiter) ([]byte, error) {
+	select {
+	case r := <-w.done:
+		return r.data, r.err
+	case <-ctx.Done():
+		n.mu.Lock()
+		if n.waiters[index] == w {
+			delete(n.waiters, index)
+		}
+		n.mu.Unlock()
+		return nil, ctx.Err()
+	case <-n.done:
+		return nil, ErrStopped
+	}
+}
+
+// ReadIndex waits until the store of the leader has every write that was committed when it was called,
+// the reads after it are linearizable
+func (n *Node) ReadIndex(ctx context.Context) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.state != StateLeader {
+		return ErrNotLeader
+	}
+	term := n.storage.state.Term
+	leading := func() bool { return n.state == StateLeader && n.storage.state.Term == term }
+
+	if err := n.waitFor(ctx, func() bool { return !leading() || n.commitIndex >= n.noopIndex }); err != nil {
+		return err
+	}
+	if !leading() {
+		return ErrLeadershipLost
+	}
+	readIndex := n.commitIndex
+
+	// a majority answering a heartbeat sent after this confirms no other leader committed anything since
+	n.readRound++
+	round := n.readRound
+	n.triggerReplication()
+	confirmed := func() bool {
+		return n.quorum(func(m Member) bool {
+			if m.ID == n.cfg.Self.ID {
+				return true
+			}
+			p, ok := n.progress[m.ID]
+			return ok && p.acked >= round
+		})
+	}
+	if err := n.waitFor(ctx, func() bool { return !leading() || confirmed() }); err != nil {
+		return err
+	}
+	if !leading() {
+		return ErrLeadershipLost
+	}
+	return n.waitFor(ctx, func() bool { return n.storage.applied >= readIndex })
+}
+
+// AddMember adds a member to the cluster, the new member needs to be started without bootstrapping
+func (n *Node) AddMember(ctx context.Context, member Member) error {
+	return n.changeMembers(ctx, func(members []Member) ([]Member, error) {
+		for _, m := range members {
+			if m.ID == member.ID {
+				return nil, ErrMemberExists
+			}
+		}
+		return append(members, member), nil
+	})
+}
+
+// RemoveMember removes a member from the cluster, the leader can remove itself
+func (n *Node) RemoveMember(ctx context.Context, id string) error {
+	return n.changeMembers(ctx, func(members []Member) ([]Member, error) {
+		for i, m := range members {
+			if m.ID == id {
+				return append(members[:i], members[i+1:]...), nil
+			}
+		}
+		return nil, ErrMemberNotFound
+	})
+}
+
+// changeMembers adds or removes one member at a time, the majorities of the old and the new members always overlap then
+func (n *Node) changeMembers(ctx context.Context, change func([]Member) ([]Member, error)) error {
+	n.mu.Lock()
+	if n.state != StateLeader {
+		n.mu.Unlock()
+		return ErrNotLeader
+	}
+	if n.config.index > n.commitIndex || n.noopIndex > n.commitIndex {
+		n.mu.Unlock()
+		return ErrMembershipPending
+	}
+	members, err := change(append([]Member(nil), n.config.members...))
+	if err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	data, err := (&Configuration{Members: members}).MarshalMsg(nil)
+	if err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	index, err := n.appendEntry(EntryMembers, data)
+	if err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	n.log.Infof("%s changes the members to %v", n.cfg.Self.ID, members)
+	w := n.wait(index)
+	n.mu.Unlock()
+
+	_, err = n.result(ctx, index, w)
+	return err
+}
@@ -0,0 +1,787 @@
+// Package cluster runs the store as a member of a raft cluster.
+//
+// The leader appends the writes to the raft log as commands, every member applies the committed commands
+// to its own store in the order of the log. The log gets compacted into the store, a member that is behind
+// the start of the log of the leader gets a dump of the store of the leader instead of the entries.
+package cluster
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/sirupsen/logrus"
+)
+
+// Cluster errors
+var (
+	ErrNotLeader         = errors.New("this member is not the leader")
+	ErrLeadershipLost    = errors.New("the leadership was lost before the entry was applied, it may or may not get applied")
+	ErrMembershipPending = errors.New("the previous membership change is not committed yet")
+	ErrMemberExists      = errors.New("a member with this id already exists")
+	ErrMemberNotFound    = errors.New("member not found")
+	ErrStopped           = errors.New("the member stopped")
+)
+
+// Entry types
+const (
+	EntryCommand = "command"
+	EntryMembers = "members"
+	EntryNoop    = "noop"
+)
+
+// Member states
+const (
+	StateFollower  = "follower"
+	StateCandidate = "candidate"
+	StateLeader    = "leader"
+)
+
+// maxAppendEntries is the number of entries sent to a member at once
+const maxAppendEntries = 256
+
+// ApplyFunc applies the data of a committed command to the store, the leader hands the result
+// to the one that proposed the command
+type ApplyFunc func(data []byte) []byte
+
+// Config for a member of a cluster
+type Config struct {
+	Self Member
+	// Path of the leveldb the raft log is kept in
+	Path string
+	// Bootstrap starts a new cluster with this member as the only member when the raft log is empty
+	Bootstrap bool
+	// HeartbeatInterval is the time between the appends of the leader when there is nothing to append
+	HeartbeatInterval time.Duration
+	// ElectionTimeout is the minimum time without hearing from a leader before a member starts an election,
+	// the elections start at a random time between this and twice this
+	ElectionTimeout time.Duration
+	// SnapshotThreshold is the number of applied entries that get compacted into the store at once
+	SnapshotThreshold uint64
+	Transport         Transport
+	Log               logrus.FieldLogger
+}
+
+// configuration is the members that took effect with the members entry at index
+type configuration struct {
+	index   uint64
+	members []Member
+}
+
+func (c configuration) member(id string) (Member, bool) {
+	for _, m := range c.members {
+		if m.ID == id {
+			return m, true
+		}
+	}
+	return Member{}, false
+}
+
+// progress of the replication to a member, kept by the leader
+type progress struct {
+	member Member
+	next   uint64
+	match  uint64
+	// acked is the last read round the member answered
+	acked   uint64
+	trigger chan struct{}
+	stop    chan struct{}
+}
+
+type result struct {
+	data []byte
+	err  error
+}
+
+// waiter waits for the entry it proposed at index in term to be applied
+type waiter struct {
+	term uint64
+	done chan result
+}
+
+// Node is a member of a raft cluster
+type Node struct {
+	cfg       Config
+	store     persist.Store
+	storage   *storage
+	transport Transport
+	log       logrus.FieldLogger
+	apply     ApplyFunc
+
+	mu               sync.Mutex
+	cond             *sync.Cond
+	state            string
+	leader           string
+	lastContact      time.Time
+	electionDeadline time.Time
+	restoring        bool
+	commitIndex      uint64
+	// config is the latest members entry, prevConfig the one before it
+	config, prevConfig configuration
+	// noopIndex is the index of the entry a leader appends when it gets elected,
+	// it knows which entries are committed once that entry is committed
+	noopIndex uint64
+	progress  map[string]*progress
+	readRound uint64
+	waiters   map[uint64]*waiter
+	stopped   bool
+
+	// applyMu serializes applying the entries, restoring a snapshot and starting a dump of the store
+	applyMu sync.Mutex
+
+	done   chan struct{}
+	wg     sync.WaitGroup
+	server *http.Server
+}
+
+// NewNode opens the raft log of a member of a cluster that applies the entries to the store
+func NewNode(store persist.Store, cfg Config) (*Node, error) {
+	s, err := openStorage(cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewHTTPTransport(cfg.ElectionTimeout)
+	}
+	n := &Node{
+		cfg:       cfg,
+		store:     store,
+		storage:   s,
+		transport: cfg.Transport,
+		log:       cfg.Log,
+		state:     StateFollower,
+		waiters:   make(map[uint64]*waiter),
+		done:      make(chan struct{}),
+	}
+	n.cond = sync.NewCond(&n.mu)
+	if err := n.recoverApplied(); err != nil {
+		s.close()
+		return nil, err
+	}
+	n.commitIndex = s.applied
+
+	if cfg.Bootstrap && s.lastIndex == 0 && s.state.Term == 0 {
+		data, err := (&Configuration{Members: []Member{cfg.Self}}).MarshalMsg(nil)
+		if err == nil {
+			err = s.append([]Entry{{Index: 1, Term: 1, Type: EntryMembers, Data: data}})
+		}
+		if err == nil {
+			err = s.setState(HardState{Term: 1})
+		}
+		if err != nil {
+			s.close()
+			return nil, err
+		}
+	}
+	if err := n.loadConfig(); err != nil {
+		s.close()
+		return nil, err
+	}
+	return n, nil
+}
+
+// recoverApplied finds the entries the store has, a command counts as applied once its writes are in the store.
+// A store that was left half restored gets emptied together with the log, the leader sends everything again.
+func (n *Node) recoverApplied() error {
+	if n.storage.restoring {
+		n.log.Warnf("%s stopped while restoring a snapshot, it starts over with an empty store", n.cfg.Self.ID)
+		return n.discardStore()
+	}
+	applied, err := n.store.CommandApplied()
+	if err != nil || applied <= n.storage.applied {
+		return err
+	}
+	return n.storage.setApplied(applied)
+}
+
+// discardStore empties the store and the log
+func (n *Node) discardStore() error {
+	if _, err := n.store.DeleteByPrefix(""); err != nil {
+		return err
+	}
+	if err := n.store.SetCommandApplied(0); err != nil {
+		return err
+	}
+	return n.storage.reset()
+}
+
+// Stamp returns the time and the origin for the writes of a command the leader proposes
+func (n *Node) Stamp() (int64, string) {
+	return n.store.Now(), n.store.NodeID()
+}
+
+// Start serves the raft requests of the other members and starts taking part in the cluster
+func (n *Node) Start(apply ApplyFunc) error {
+	l, err := net.Listen("tcp", n.cfg.Self.PeerAddress)
+	if err != nil {
+		return err
+	}
+	n.apply = apply
+	n.server = &http.Server{Handler: n.Handler()}
+
+	n.mu.Lock()
+	n.resetElectionDeadline()
+	n.mu.Unlock()
+
+	n.wg.Add(3)
+	go func() {
+		defer n.wg.Done()
+		_ = n.server.Serve(l)
+	}()
+	go n.run()
+	go n.applyCommitted()
+	return nil
+}
+
+// Stop leaves the cluster running without this member and closes the raft log
+func (n *Node) Stop() error {
+	n.mu.Lock()
+	if n.stopped {
+		n.mu.Unlock()
+		return nil
+	}
+	n.stopped = true
+	close(n.done)
+	n.stopLeading()
+	n.cond.Broadcast()
+	n.mu.Unlock()
+
+	if n.server != nil {
+		n.server.Close()
+	}
+	n.wg.Wait()
+	return n.storage.close()
+}
+
+// ID of this member
+func (n *Node) ID() string {
+	return n.cfg.Self.ID
+}
+
+// IsLeader reports if this member leads the cluster
+func (n *Node) IsLeader() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.state == StateLeader
+}
+
+// Leader returns the member that leads the cluster as far as this member knows
+func (n *Node) Leader() (Member, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.leader == "" {
+		return Member{}, false
+	}
+	return n.config.member(n.leader)
+}
+
+// Status of a member
+type Status struct {
+	ID           string
+	State        string
+	Term         uint64
+	Leader       string
+	CommitIndex  uint64
+	AppliedIndex uint64
+	LastIndex    uint64
+	Members      []Member
+}
+
+// Status reports the state of this member and the members it knows about
+func (n *Node) Status() Status {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return Status{
+		ID:           n.cfg.Self.ID,
+		State:        n.state,
+		Term:         n.storage.state.Term,
+		Leader:       n.leader,
+		CommitIndex:  n.commitIndex,
+		AppliedIndex: n.storage.applied,
+		LastIndex:    n.storage.lastIndex,
+		Members:      append([]Member(nil), n.config.members...),
+	}
+}
+
+func (n *Node) run() {
+	defer n.wg.Done()
+	ticker := time.NewTicker(n.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-n.done:
+			return
+		case <-ticker.C:
+		}
+
+		n.mu.Lock()
+		_, member := n.config.member(n.cfg.Self.ID)
+		// a member that is not in the cluster (yet) waits for the leader to reach it
+		if n.state != StateLeader && member && !n.restoring && !n.stopped && time.Now().After(n.electionDeadline) {
+			n.startElection()
+		}
+		n.mu.Unlock()
+	}
+}
+
+func (n *Node) resetElectionDeadline() {
+	timeout := n.cfg.ElectionTimeout + time.Duration(rand.Int63n(int64(n.cfg.ElectionTimeout)))
+	n.electionDeadline = time.Now().Add(timeout)
+}
+
+// loadConfig finds the last two members entries in the log, the snapshot has the members before those
+func (n *Node) loadConfig() error {
+	snap := configuration{index: n.storage.snapshot.Index, members: n.storage.snapshot.Members}
+	n.config, n.prevConfig = snap, snap
+
+	var found []configuration
+	for i := n.storage.lastIndex; i >= n.storage.firstIndex() && len(found) < 2; i-- {
+		entry, err := n.storage.entry(i)
+		if err != nil {
+			return err
+		}
+		if entry.Type != EntryMembers {
+			continue
+		}
+		var c Configuration
+		if _, err := c.UnmarshalMsg(entry.Data); err != nil {
+			return err
+		}
+		found = append(found, configuration{index: entry.Index, members: c.Members})
+	}
+	if len(found) > 0 {
+		n.config = found[0]
+	}
+	if len(found) > 1 {
+		n.prevConfig = found[1]
+	}
+	return nil
+}
+
+// membersAt returns the members as of the entry at index, when that is still known
+func (n *Node) membersAt(index uint64) ([]Member, bool) {
+	switch {
+	case n.config.index <= index:
+		return n.config.members, true
+	case n.prevConfig.index <= index:
+		return n.prevConfig.members, true
+	}
+	return nil, false
+}
+
+// quorum reports if the members that the vote function counts are a majority of the members
+func (n *Node) quorum(vote func(Member) bool) bool {
+	var votes int
+	for _, m := range n.config.members {
+		if vote(m) {
+			votes++
+		}
+	}
+	return votes*2 > len(n.config.members)
+}
+
+// stepDown moves to a newer term and forgets the vote, a leader or a candidate becomes a follower
+func (n *Node) stepDown(term uint64) {
+	if term > n.storage.state.Term {
+		if err := n.storage.setState(HardState{Term: term}); err != nil {
+			n.log.Errorf("storing term %d failed: %v", term, err)
+		}
+		n.leader = ""
+	}
+	if n.state == StateLeader {
+		n.log.Infof("%s stops leading in term %d", n.cfg.Self.ID, n.storage.state.Term)
+		n.stopLeading()
+	}
+	n.state = StateFollower
+}
+
+// stopLeading stops the replication and fails the proposals that wait for entries that are not committed
+func (n *Node) stopLeading() {
+	for _, p := range n.progress {
+		close(p.stop)
+	}
+	n.progress = nil
+	for index, w := range n.waiters {
+		if index > n.commitIndex || n.stopped {
+			w.done <- result{err: ErrLeadershipLost}
+			delete(n.waiters, index)
+		}
+	}
+	n.cond.Broadcast()
+}
+
+func (n *Node) startElection() {
+	term := n.storage.state.Term + 1
+	if err := n.storage.setState(HardState{Term: term, VotedFor: n.cfg.Self.ID}); err != nil {
+		n.log.Errorf("storing term %d failed: %v", term, err)
+		return
+	}
+	n.state = StateCandidate
+	n.leader = ""
+	n.resetElectionDeadline()
+	n.log.Debugf("%s starts an election in term %d", n.cfg.Self.ID, term)
+
+	req := &VoteRequest{
+		Term:         term,
+		Candidate:    n.cfg.Self.ID,
+		LastLogIndex: n.storage.lastIndex,
+		LastLogTerm:  n.storage.lastTerm,
+	}
+	granted := map[string]bool{n.cfg.Self.ID: true}
+	won := func() bool {
+		return n.quorum(func(m Member) bool { return granted[m.ID] })
+	}
+	if won() {
+		n.becomeLeader()
+		return
+	}
+
+	for _, m := range n.config.members {
+		if m.ID == n.cfg.Self.ID {
+			continue
+		}
+		go func(m Member) {
+			resp, err := n.transport.RequestVote(m, req)
+			if err != nil {
+				return
+			}
+			n.mu.Lock()
+			defer n.mu.Unlock()
+			if resp.Term > n.storage.state.Term {
+				n.stepDown(resp.Term)
+				return
+			}
+			if n.stopped || n.state != StateCandidate || n.storage.state.Term != term || !resp.Granted {
+				return
+			}
+			granted[m.ID] = true
+			if won() {
+				n.becomeLeader()
+			}
+		}(m)
+	}
+}
+
+func (n *Node) handleVote(req *VoteRequest) *VoteResponse {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// a member that hears from a leader doesn't help others to replace it,
+	// this keeps the members that were removed from disrupting the cluster
+	if n.state == StateLeader || (n.leader != "" && time.Since(n.lastContact) < n.cfg.ElectionTimeout) {
+		return &VoteResponse{Term: n.storage.state.Term}
+	}
+	if req.Term < n.storage.state.Term {
+		return &VoteResponse{Term: n.storage.state.Term}
+	}
+	if req.Term > n.storage.state.Term {
+		n.stepDown(req.Term)
+	}
+
+	upToDate := req.LastLogTerm > n.storage.lastTerm ||
+		(req.LastLogTerm == n.storage.lastTerm && req.LastLogIndex >= n.storage.lastIndex)
+	votedFor := n.storage.state.VotedFor
+	if !upToDate || (votedFor != "" && votedFor != req.Candidate) {
+		return &VoteResponse{Term: n.storage.state.Term}
+	}
+	if err := n.storage.setState(HardState{Term: req.Term, VotedFor: req.Candidate}); err != nil {
+		n.log.Errorf("storing the vote for %s failed: %v", req.Candidate, err)
+		return &VoteResponse{Term: n.storage.state.Term}
+	}
+	n.resetElectionDeadline()
+	return &VoteResponse{Term: req.Term, Granted: true}
+}
+
+func (n *Node) handleAppend(req *AppendRequest) (*AppendResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if req.Term < n.storage.state.Term {
+		return &AppendResponse{Term: n.storage.state.Term, LastIndex: n.storage.lastIndex}, nil
+	}
+	if req.Term > n.storage.state.Term || n.state != StateFollower {
+		n.stepDown(req.Term)
+	}
+	n.leader = req.Leader
+	n.lastContact = time.Now()
+	n.resetElectionDeadline()
+	term := n.storage.state.Term
+
+	if req.PrevLogIndex > n.storage.lastIndex {
+		return &AppendResponse{Term: term, LastIndex: n.storage.lastIndex}, nil
+	}
+	if req.PrevLogIndex > n.storage.snapshot.Index {
+		prevTerm, err := n.storage.term(req.PrevLogIndex)
+		if err != nil {
+			return nil, err
+		}
+		if prevTerm != req.PrevLogTerm {
+			// the leader can skip the entries of the conflicting term, the committed ones always match
+			hint := req.PrevLogIndex - 1
+			for hint > n.commitIndex && hint > n.storage.snapshot.Index {
+				t, err := n.storage.term(hint)
+				if err != nil {
+					return nil, err
+				}
+				if t != prevTerm {
+					break
+				}
+				hint--
+			}
+			return &AppendResponse{Term: term, LastIndex: hint}, nil
+		}
+	}
+
+	// the entries that are in the snapshot or already in the log get skipped
+	entries := req.Entries
+	for len(entries) > 0 {
+		e := entries[0]
+		if e.Index > n.storage.lastIndex {
+			break
+		}
+		if e.Index > n.storage.snapshot.Index {
+			t, err := n.storage.term(e.Index)
+			if err != nil {
+				return nil, err
+			}
+			if t != e.Term {
+				break
+			}
+		}
+		entries = entries[1:]
+	}
+	if len(entries) > 0 {
+		truncated := entries[0].Index <= n.storage.lastIndex
+		if err := n.storage.append(entries); err != nil {
+			return nil, err
+		}
+		if truncated {
+			if err := n.loadConfig(); err != nil {
+				return nil, err
+			}
+		} else {
+			n.trackConfig(entries)
+		}
+	}
+
+	lastNew := req.PrevLogIndex + uint64(len(req.Entries))
+	if req.LeaderCommit > n.commitIndex {
+		commit := req.LeaderCommit
+		if lastNew < commit {
+			commit = lastNew
+		}
+		if commit > n.commitIndex {
+			n.commitIndex = commit
+			n.cond.Broadcast()
+		}
+	}
+	return &AppendResponse{Term: term, Success: true, LastIndex: lastNew}, nil
+}
+
+// trackConfig takes the members entries among the appended entries into effect
+func (n *Node) trackConfig(entries []Entry) {
+	for _, e := range entries {
+		if e.Type != EntryMembers {
+			continue
+		}
+		var c Configuration
+		if _, err := c.UnmarshalMsg(e.Data); err != nil {
+			n.log.Errorf("decoding the members of entry %d failed: %v", e.Index, err)
+			continue
+		}
+		n.prevConfig = n.config
+		n.config = configuration{index: e.Index, members: c.Members}
+	}
+}
+
+func (n *Node) handleSnapshot(req *SnapshotRequest, dump io.Reader) (*SnapshotResponse, error) {
+	n.mu.Lock()
+	if req.Term < n.storage.state.Term {
+		defer n.mu.Unlock()
+		return &SnapshotResponse{Term: n.storage.state.Term}, nil
+	}
+	if req.Term > n.storage.state.Term || n.state != StateFollower {
+		n.stepDown(req.Term)
+	}
+	n.leader = req.Leader
+	n.lastContact = time.Now()
+	n.resetElectionDeadline()
+	if req.Index <= n.storage.applied {
+		defer n.mu.Unlock()
+		return &SnapshotResponse{Term: n.storage.state.Term}, nil
+	}
+	if err := n.storage.beginRestore(); err != nil {
+		defer n.mu.Unlock()
+		return nil, err
+	}
+	// restoring can take longer than the election timeout
+	n.restoring = true
+	n.mu.Unlock()
+
+	n.applyMu.Lock()
+	defer n.applyMu.Unlock()
+	_, err := n.store.Restore(dump)
+	if err == nil {
+		err = n.store.SetCommandApplied(req.Index)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.restoring = false
+	n.lastContact = time.Now()
+	n.resetElectionDeadline()
+	if err != nil {
+		// the store is half restored, the leader sends everything again
+		if derr := n.discardStore(); derr != nil {
+			n.log.Errorf("emptying the half restored store failed: %v", derr)
+		} else if derr = n.loadConfig(); derr != nil {
+			n.log.Errorf("loading the members failed: %v", derr)
+		}
+		n.commitIndex = n.storage.applied
+		return nil, err
+	}
+	meta := SnapshotMeta{Index: req.Index, Term: req.LogTerm, Members: req.Members}
+	if err := n.storage.restore(meta); err != nil {
+		return nil, err
+	}
+	if n.commitIndex < req.Index {
+		n.commitIndex = req.Index
+	}
+	snap := configuration{index: req.Index, members: req.Members}
+	n.config, n.prevConfig = snap, snap
+	n.log.Infof("%s restored a snapshot of %s up to entry %d", n.cfg.Self.ID, req.Leader, req.Index)
+	n.cond.Broadcast()
+	return &SnapshotResponse{Term: n.storage.state.Term}, nil
+}
+
+// applyCommitted applies the committed entries in order
+func (n *Node) applyCommitted() {
+	defer n.wg.Done()
+
+	for {
+		n.mu.Lock()
+		for !n.stopped && n.storage.applied >= n.commitIndex {
+			n.cond.Wait()
+		}
+		if n.stopped {
+			n.mu.Unlock()
+			return
+		}
+		n.mu.Unlock()
+
+		if err := n.applyNext(); err != nil {
+			n.log.Errorf("applying the committed entries failed: %v", err)
+			select {
+			case <-n.done:
+				return
+			case <-time.After(n.cfg.HeartbeatInterval):
+			}
+		}
+		n.compact()
+	}
+}
+
+func (n *Node) applyNext() error {
+	n.applyMu.Lock()
+	defer n.applyMu.Unlock()
+
+	n.mu.Lock()
+	entries, err := n.storage.entries(n.storage.applied+1, n.commitIndex, maxAppendEntries)
+	n.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	// the store keeps the index of the commands it applied together with their writes,
+	// the applied index in the log catches up with it when the member starts
+	for _, e := range entries {
+		var data []byte
+		if e.Type == EntryCommand {
+			// a command that doesn't decode gets the time of this member, applying it records the error
+			var cmd Command
+			if _, err := cmd.UnmarshalMsg(e.Data); err != nil {
+				cmd = Command{}
+			}
+			err := n.store.ApplyCommand(e.Index, cmd.Time, cmd.Origin, func() {
+				data = n.apply(e.Data)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		n.mu.Lock()
+		err := n.storage.setApplied(e.Index)
+		if w, ok := n.waiters[e.Index]; ok {
+			delete(n.waiters, e.Index)
+			if w.term == e.Term {
+				w.done <- result{data: data}
+			} else {
+				w.done <- result{err: ErrLeadershipLost}
+			}
+		}
+		n.cond.Broadcast()
+		n.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// compact replaces the applied entries with a snapshot meta once there are enough of them,
+// the store has everything the entries did
+func (n *Node) compact() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	applied := n.storage.applied
+	if n.cfg.SnapshotThreshold == 0 || applied-n.storage.snapshot.Index < n.cfg.SnapshotThreshold {
+		return
+	}
+	members, ok := n.membersAt(applied)
+	if !ok {
+		return
+	}
+	term, err := n.storage.term(applied)
+	if err == nil {
+		err = n.storage.compact(SnapshotMeta{Index: applied, Term: term, Members: members})
+	}
+	if err != nil {
+		n.log.Errorf("compacting the log up to entry %d failed: %v", applied, err)
+	}
+}
+
+// waitFor waits while holding the lock until the condition holds, the context is done or the member stops
+func (n *Node) waitFor(ctx context.Context, cond func() bool) error {
+	finished := make(chan struct{})
+	defer close(finished)
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-finished:
+			return
+		}
+		n.mu.Lock()
+		n.cond.Broadcast()
+		n.mu.Unlock()
+	}()
+
+	for !cond() {
+		if n.stopped {
+			return ErrStopped
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		n.cond.Wait()
+	}
+	return nil
+}
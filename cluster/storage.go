@@ -0,0 +1,282 @@
+package cluster
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	storageStateKey    = "state"
+	storageSnapshotKey = "snapshot"
+	storageAppliedKey  = "applied"
+	// storageRestoringKey is there while a snapshot is restored into the store
+	storageRestoringKey = "restoring"
+	storageLogPrefix    = "log/"
+)
+
+var storageSyncWrite = &opt.WriteOptions{Sync: true}
+
+func storageLogKey(index uint64) []byte {
+	key := make([]byte, len(storageLogPrefix)+8)
+	copy(key, storageLogPrefix)
+	binary.BigEndian.PutUint64(key[len(storageLogPrefix):], index)
+	return key
+}
+
+// storage keeps the raft log, the hard state and the snapshot meta in a leveldb of its own.
+// It is not safe for concurrent use, the node guards it with its lock.
+type storage struct {
+	db *leveldb.DB
+
+	state     HardState
+	snapshot  SnapshotMeta
+	applied   uint64
+	lastIndex uint64
+	lastTerm  uint64
+	// restoring is set when the store was left half restored
+	restoring bool
+}
+
+func openStorage(path string) (*storage, error) {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	s := &storage{db: db}
+	if err := s.load(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *storage) load() error {
+	if err := s.get(storageStateKey, &s.state); err != nil {
+		return err
+	}
+	if err := s.get(storageSnapshotKey, &s.snapshot); err != nil {
+		return err
+	}
+	data, err := s.db.Get([]byte(storageAppliedKey), nil)
+	switch err {
+	case nil:
+		s.applied = binary.BigEndian.Uint64(data)
+	case leveldb.ErrNotFound:
+	default:
+		return err
+	}
+
+	if s.restoring, err = s.db.Has([]byte(storageRestoringKey), nil); err != nil {
+		return err
+	}
+
+	s.lastIndex, s.lastTerm = s.snapshot.Index, s.snapshot.Term
+	iter := s.db.NewIterator(util.BytesPrefix([]byte(storageLogPrefix)), nil)
+	defer iter.Release()
+	if iter.Last() {
+		var entry Entry
+		if _, err := entry.UnmarshalMsg(iter.Value()); err != nil {
+			return fmt.Errorf("msgp unmarshal failed: %v", err)
+		}
+		s.lastIndex, s.lastTerm = entry.Index, entry.Term
+	}
+	return iter.Error()
+}
+
+type msgpValue interface {
+	MarshalMsg([]byte) ([]byte, error)
+	UnmarshalMsg([]byte) ([]byte, error)
+}
+
+// get reads the value at key, a missing value leaves v as it is
+func (s *storage) get(key string, v msgpValue) error {
+	data, err := s.db.Get([]byte(key), nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := v.UnmarshalMsg(data); err != nil {
+		return fmt.Errorf("msgp unmarshal failed: %v", err)
+	}
+	return nil
+}
+
+func (s *storage) put(batch *leveldb.Batch, key string, v msgpValue) error {
+	data, err := v.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	batch.Put([]byte(key), data)
+	return nil
+}
+
+func (s *storage) close() error {
+	return s.db.Close()
+}
+
+// firstIndex is the index of the first entry in the log, the ones before it are in the snapshot
+func (s *storage) firstIndex() uint64 {
+	return s.snapshot.Index + 1
+}
+
+func (s *storage) setState(state HardState) error {
+	batch := new(leveldb.Batch)
+	if err := s.put(batch, storageStateKey, &state); err != nil {
+		return err
+	}
+	if err := s.db.Write(batch, storageSyncWrite); err != nil {
+		return err
+	}
+	s.state = state
+	return nil
+}
+
+func (s *storage) setApplied(index uint64) error {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], index)
+	if err := s.db.Put([]byte(storageAppliedKey), b[:], storageSyncWrite); err != nil {
+		return err
+	}
+	s.applied = index
+	return nil
+}
+
+// entry returns the entry at index, it needs to be in the log
+func (s *storage) entry(index uint64) (Entry, error) {
+	var entry Entry
+	data, err := s.db.Get(storageLogKey(index), nil)
+	if err != nil {
+		return entry, fmt.Errorf("reading entry %d: %v", index, err)
+	}
+	if _, err := entry.UnmarshalMsg(data); err != nil {
+		return entry, fmt.Errorf("msgp unmarshal failed: %v", err)
+	}
+	return entry, nil
+}
+
+// term returns the term of the entry at index, that is the entry in the log or the last one in the snapshot
+func (s *storage) term(index uint64) (uint64, error) {
+	switch {
+	case index == s.snapshot.Index:
+		return s.snapshot.Term, nil
+	case index == s.lastIndex:
+		return s.lastTerm, nil
+	case index < s.snapshot.Index || index > s.lastIndex:
+		return 0, fmt.Errorf("entry %d is not in the log", index)
+	}
+	entry, err := s.entry(index)
+	return entry.Term, err
+}
+
+// entries returns the entries from lo up to and including hi, but no more than max
+func (s *storage) entries(lo, hi uint64, max int) ([]Entry, error) {
+	iter := s.db.NewIterator(&util.Range{Start: storageLogKey(lo), Limit: storageLogKey(hi + 1)}, nil)
+	defer iter.Release()
+
+	var result []Entry
+	for len(result) < max && iter.Next() {
+		var entry Entry
+		if _, err := entry.UnmarshalMsg(iter.Value()); err != nil {
+			return nil, fmt.Errorf("msgp unmarshal failed: %v", err)
+		}
+		result = append(result, entry)
+	}
+	return result, iter.Error()
+}
+
+// append writes the entries, the entries in the log from the index of the first one on get replaced
+func (s *storage) append(entries []Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	for i := entries[0].Index; i <= s.lastIndex; i++ {
+		batch.Delete(storageLogKey(i))
+	}
+	for i := range entries {
+		data, err := entries[i].MarshalMsg(nil)
+		if err != nil {
+			return err
+		}
+		batch.Put(storageLogKey(entries[i].Index), data)
+	}
+	if err := s.db.Write(batch, storageSyncWrite); err != nil {
+		return err
+	}
+	last := entries[len(entries)-1]
+	s.lastIndex, s.lastTerm = last.Index, last.Term
+	return nil
+}
+
+// compact replaces the entries up to and including the index with the snapshot meta
+func (s *storage) compact(meta SnapshotMeta) error {
+	batch := new(leveldb.Batch)
+	if err := s.put(batch, storageSnapshotKey, &meta); err != nil {
+		return err
+	}
+	for i := s.firstIndex(); i <= meta.Index; i++ {
+		batch.Delete(storageLogKey(i))
+	}
+	if err := s.db.Write(batch, storageSyncWrite); err != nil {
+		return err
+	}
+	s.snapshot = meta
+	return nil
+}
+
+// beginRestore marks the store as being restored until restore replaces the log,
+// when the member stops in between the store is half restored
+func (s *storage) beginRestore() error {
+	if err := s.db.Put([]byte(storageRestoringKey), nil, storageSyncWrite); err != nil {
+		return err
+	}
+	s.restoring = true
+	return nil
+}
+
+// restore replaces the log with the snapshot meta of a snapshot that was restored into the store
+func (s *storage) restore(meta SnapshotMeta) error {
+	batch := new(leveldb.Batch)
+	batch.Delete([]byte(storageRestoringKey))
+	if err := s.put(batch, storageSnapshotKey, &meta); err != nil {
+		return err
+	}
+	for i := s.firstIndex(); i <= s.lastIndex; i++ {
+		batch.Delete(storageLogKey(i))
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], meta.Index)
+	batch.Put([]byte(storageAppliedKey), b[:])
+	if err := s.db.Write(batch, storageSyncWrite); err != nil {
+		return err
+	}
+	s.snapshot = meta
+	s.applied = meta.Index
+	s.lastIndex, s.lastTerm = meta.Index, meta.Term
+	s.restoring = false
+	return nil
+}
+
+// reset empties the log and forgets the snapshot meta, the hard state stays
+func (s *storage) reset() error {
+	batch := new(leveldb.Batch)
+	for i := s.firstIndex(); i <= s.lastIndex; i++ {
+		batch.Delete(storageLogKey(i))
+	}
+	batch.Delete([]byte(storageSnapshotKey))
+	batch.Delete([]byte(storageAppliedKey))
+	batch.Delete([]byte(storageRestoringKey))
+	if err := s.db.Write(batch, storageSyncWrite); err != nil {
+		return err
+	}
+	s.snapshot = SnapshotMeta{}
+	s.applied, s.lastIndex, s.lastTerm = 0, 0, 0
+	s.restoring = false
+	return nil
+}
@@ -0,0 +1,150 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/tinylib/msgp/msgp"
+)
+
+// Transport sends the raft requests to the other members
+type Transport interface {
+	RequestVote(Member, *VoteRequest) (*VoteResponse, error)
+	AppendEntries(Member, *AppendRequest) (*AppendResponse, error)
+	// InstallSnapshot sends the request followed by the dump of the store that is read from the reader
+	InstallSnapshot(Member, *SnapshotRequest, io.Reader) (*SnapshotResponse, error)
+}
+
+// The paths the raft requests are served at
+const (
+	votePath     = "/raft/vote"
+	appendPath   = "/raft/append"
+	snapshotPath = "/raft/snapshot"
+)
+
+const msgpackMime = "application/msgpack"
+
+// NewHTTPTransport sends the raft requests over http, the votes and appends time out after the timeout
+func NewHTTPTransport(timeout time.Duration) Transport {
+	return &httpTransport{
+		client:   &http.Client{Timeout: timeout},
+		snapshot: &http.Client{},
+	}
+}
+
+type httpTransport struct {
+	client   *http.Client
+	snapshot *http.Client
+}
+
+func (h *httpTransport) RequestVote(member Member, req *VoteRequest) (*VoteResponse, error) {
+	resp := new(VoteResponse)
+	return resp, h.call(h.client, member, votePath, encodeMsg(req), resp)
+}
+
+func (h *httpTransport) AppendEntries(member Member, req *AppendRequest) (*AppendResponse, error) {
+	resp := new(AppendResponse)
+	return resp, h.call(h.client, member, appendPath, encodeMsg(req), resp)
+}
+
+func (h *httpTransport) InstallSnapshot(member Member, req *SnapshotRequest, dump io.Reader) (*SnapshotResponse, error) {
+	resp := new(SnapshotResponse)
+	return resp, h.call(h.snapshot, member, snapshotPath, io.MultiReader(encodeMsg(req), dump), resp)
+}
+
+func encodeMsg(v msgpValue) io.Reader {
+	data, err := v.MarshalMsg(nil)
+	if err != nil {
+		// the types of the raft requests always marshal
+		panic(err)
+	}
+	return bytes.NewReader(data)
+}
+
+func (h *httpTransport) call(client *http.Client, member Member, path string, body io.Reader, resp msgpValue) error {
+	res, err := client.Post("http://"+member.PeerAddress+path, msgpackMime, body)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s%s: %s: %s", member.PeerAddress, path, res.Status, bytes.TrimSpace(data))
+	}
+	_, err = resp.UnmarshalMsg(data)
+	return err
+}
+
+// Handler serves the raft requests of the other members
+func (n *Node) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(votePath, func(rw http.ResponseWriter, r *http.Request) {
+		req := new(VoteRequest)
+		if !decodeRequest(rw, r, req) {
+			return
+		}
+		writeResponse(rw, n.handleVote(req))
+	})
+	mux.HandleFunc(appendPath, func(rw http.ResponseWriter, r *http.Request) {
+		req := new(AppendRequest)
+		if !decodeRequest(rw, r, req) {
+			return
+		}
+		resp, err := n.handleAppend(req)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeResponse(rw, resp)
+	})
+	mux.HandleFunc(snapshotPath, func(rw http.ResponseWriter, r *http.Request) {
+		// the request is followed by the dump, so it gets read from the same reader
+		mr := msgp.NewReader(r.Body)
+		req := new(SnapshotRequest)
+		if err := req.DecodeMsg(mr); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		resp, err := n.handleSnapshot(req, mr)
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeResponse(rw, resp)
+	})
+	return mux
+}
+
+func decodeRequest(rw http.ResponseWriter, r *http.Request, req msgpValue) bool {
+	if r.Method != http.MethodPost {
+		http.Error(rw, "raft requests are posted", http.StatusMethodNotAllowed)
+		return false
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err == nil {
+		_, err = req.UnmarshalMsg(data)
+	}
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func writeResponse(rw http.ResponseWriter, resp msgpValue) {
+	data, err := resp.MarshalMsg(nil)
+	if err != nil {
+		http.Error(rw, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rw.Header().Set("Content-Type", msgpackMime)
+	_, _ = rw.Write(data)
+}
@@ -0,0 +1,115 @@
+package cluster
+
+// Member of a cluster
+type Member struct {
+	ID string
+	// PeerAddress is the host:port the raft traffic for the member goes to
+	PeerAddress string
+	// APIURL is the url of the api of the member, writes and linearizable reads get sent there when it leads
+	APIURL string
+	_      struct{}
+}
+
+// Entry in the raft log
+type Entry struct {
+	Index uint64
+	Term  uint64
+	Type  string
+	// Data is the command for a command entry and the members for a members entry
+	Data []byte
+	_    struct{}
+}
+
+// Configuration is the data of a members entry
+type Configuration struct {
+	Members []Member
+	_       struct{}
+}
+
+// HardState is the state that needs to be on disk before answering a request
+type HardState struct {
+	Term     uint64
+	VotedFor string
+	_        struct{}
+}
+
+// SnapshotMeta describes the state the log was compacted into
+type SnapshotMeta struct {
+	Index   uint64
+	Term    uint64
+	Members []Member
+	_       struct{}
+}
+
+// VoteRequest asks a member for its vote in an election
+type VoteRequest struct {
+	Term         uint64
+	Candidate    string
+	LastLogIndex uint64
+	LastLogTerm  uint64
+	_            struct{}
+}
+
+// VoteResponse tells a candidate if it got the vote
+type VoteResponse struct {
+	Term    uint64
+	Granted bool
+	_       struct{}
+}
+
+// AppendRequest replicates the entries of the leader, without entries it is a heartbeat
+type AppendRequest struct {
+	Term         uint64
+	Leader       string
+	PrevLogIndex uint64
+	PrevLogTerm  uint64
+	Entries      []Entry
+	LeaderCommit uint64
+	_            struct{}
+}
+
+// AppendResponse tells the leader if the entries were appended,
+// LastIndex is the index of the last entry that matches the log of the leader or a hint where to look for it
+type AppendResponse struct {
+	Term      uint64
+	Success   bool
+	LastIndex uint64
+	_         struct{}
+}
+
+// SnapshotRequest precedes a dump of the store of the leader, the store had all the entries up to Index applied
+type SnapshotRequest struct {
+	Term    uint64
+	Leader  string
+	Index   uint64
+	LogTerm uint64
+	Members []Member
+	_       struct{}
+}
+
+// SnapshotResponse tells the leader the term of the member that restored the snapshot
+type SnapshotResponse struct {
+	Term uint64
+	_    struct{}
+}
+
+// Command is a write to the api, every member replays it against its own store when it is committed
+type Command struct {
+	Method string
+	URI    string
+	Header map[string]string
+	Body   []byte
+	// Time and Origin are stamped once by the leader, so the writes of the command get the same time
+	// and origin on every member
+	Time   int64
+	Origin string
+	_      struct{}
+}
+
+// CommandResult is the response a member recorded when it replayed a command
+type CommandResult struct {
+	Status int
+	Header map[string]string
+	Body   []byte
+	_      struct{}
+}
@@ -0,0 +1,2448 @@
+package cluster
+
+// NOTE: THIS FILE WAS PRODUCED BY THE
+// MSGP CODE GENERATION TOOL (github.com/tinylib/msgp)
+// DO NOT EDIT
+
+import (
+	"github.com/tinylib/msgp/msgp"
+)
+
+// DecodeMsg implements msgp.Decodable
+func (z *AppendRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Leader":
+			z.Leader, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "PrevLogIndex":
+			z.PrevLogIndex, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "PrevLogTerm":
+			z.PrevLogTerm, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Entries":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Entries) >= int(zb0002) {
+				z.Entries = (z.Entries)[:zb0002]
+			} else {
+				z.Entries = make([]Entry, zb0002)
+			}
+			for za0001 := range z.Entries {
+				err = z.Entries[za0001].DecodeMsg(dc)
+				if err != nil {
+					return
+				}
+			}
+		case "LeaderCommit":
+			z.LeaderCommit, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *AppendRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Term"
+	err = en.Append(0x86, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Leader"
+	err = en.Append(0xa6, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Leader)
+	if err != nil {
+		return
+	}
+	// write "PrevLogIndex"
+	err = en.Append(0xac, 0x50, 0x72, 0x65, 0x76, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.PrevLogIndex)
+	if err != nil {
+		return
+	}
+	// write "PrevLogTerm"
+	err = en.Append(0xab, 0x50, 0x72, 0x65, 0x76, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.PrevLogTerm)
+	if err != nil {
+		return
+	}
+	// write "Entries"
+	err = en.Append(0xa7, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Entries)))
+	if err != nil {
+		return
+	}
+	for za0001 := range z.Entries {
+		err = z.Entries[za0001].EncodeMsg(en)
+		if err != nil {
+			return
+		}
+	}
+	// write "LeaderCommit"
+	err = en.Append(0xac, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LeaderCommit)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *AppendRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Term"
+	o = append(o, 0x86, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Leader"
+	o = append(o, 0xa6, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Leader)
+	// string "PrevLogIndex"
+	o = append(o, 0xac, 0x50, 0x72, 0x65, 0x76, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.PrevLogIndex)
+	// string "PrevLogTerm"
+	o = append(o, 0xab, 0x50, 0x72, 0x65, 0x76, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.PrevLogTerm)
+	// string "Entries"
+	o = append(o, 0xa7, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Entries)))
+	for za0001 := range z.Entries {
+		o, err = z.Entries[za0001].MarshalMsg(o)
+		if err != nil {
+			return
+		}
+	}
+	// string "LeaderCommit"
+	o = append(o, 0xac, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74)
+	o = msgp.AppendUint64(o, z.LeaderCommit)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *AppendRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Leader":
+			z.Leader, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "PrevLogIndex":
+			z.PrevLogIndex, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "PrevLogTerm":
+			z.PrevLogTerm, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Entries":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Entries) >= int(zb0002) {
+				z.Entries = (z.Entries)[:zb0002]
+			} else {
+				z.Entries = make([]Entry, zb0002)
+			}
+			for za0001 := range z.Entries {
+				bts, err = z.Entries[za0001].UnmarshalMsg(bts)
+				if err != nil {
+					return
+				}
+			}
+		case "LeaderCommit":
+			z.LeaderCommit, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *AppendRequest) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 7 + msgp.StringPrefixSize + len(z.Leader) + 13 + msgp.Uint64Size + 12 + msgp.Uint64Size + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Entries {
+		s += z.Entries[za0001].Msgsize()
+	}
+	s += 13 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *AppendResponse) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Success":
+			z.Success, err = dc.ReadBool()
+			if err != nil {
+				return
+			}
+		case "LastIndex":
+			z.LastIndex, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z AppendResponse) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Term"
+	err = en.Append(0x83, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Success"
+	err = en.Append(0xa7, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Success)
+	if err != nil {
+		return
+	}
+	// write "LastIndex"
+	err = en.Append(0xa9, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LastIndex)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z AppendResponse) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Term"
+	o = append(o, 0x83, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Success"
+	o = append(o, 0xa7, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73)
+	o = msgp.AppendBool(o, z.Success)
+	// string "LastIndex"
+	o = append(o, 0xa9, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.LastIndex)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *AppendResponse) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Success":
+			z.Success, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				return
+			}
+		case "LastIndex":
+			z.LastIndex, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z AppendResponse) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 8 + msgp.BoolSize + 10 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Command) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Method":
+			z.Method, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "URI":
+			z.URI, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Header":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				return
+			}
+			if z.Header == nil {
+				z.Header = make(map[string]string, zb0002)
+			} else if len(z.Header) > 0 {
+				for key := range z.Header {
+					delete(z.Header, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				z.Header[za0001] = za0002
+			}
+		case "Body":
+			z.Body, err = dc.ReadBytes(z.Body)
+			if err != nil {
+				return
+			}
+		case "Time":
+			z.Time, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Origin":
+			z.Origin, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Command) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "Method"
+	err = en.Append(0x86, 0xa6, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Method)
+	if err != nil {
+		return
+	}
+	// write "URI"
+	err = en.Append(0xa3, 0x55, 0x52, 0x49)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.URI)
+	if err != nil {
+		return
+	}
+	// write "Header"
+	err = en.Append(0xa6, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Header)))
+	if err != nil {
+		return
+	}
+	for za0001, za0002 := range z.Header {
+		err = en.WriteString(za0001)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			return
+		}
+	}
+	// write "Body"
+	err = en.Append(0xa4, 0x42, 0x6f, 0x64, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Body)
+	if err != nil {
+		return
+	}
+	// write "Time"
+	err = en.Append(0xa4, 0x54, 0x69, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Time)
+	if err != nil {
+		return
+	}
+	// write "Origin"
+	err = en.Append(0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Origin)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Command) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "Method"
+	o = append(o, 0x86, 0xa6, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64)
+	o = msgp.AppendString(o, z.Method)
+	// string "URI"
+	o = append(o, 0xa3, 0x55, 0x52, 0x49)
+	o = msgp.AppendString(o, z.URI)
+	// string "Header"
+	o = append(o, 0xa6, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Header)))
+	for za0001, za0002 := range z.Header {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
+	}
+	// string "Body"
+	o = append(o, 0xa4, 0x42, 0x6f, 0x64, 0x79)
+	o = msgp.AppendBytes(o, z.Body)
+	// string "Time"
+	o = append(o, 0xa4, 0x54, 0x69, 0x6d, 0x65)
+	o = msgp.AppendInt64(o, z.Time)
+	// string "Origin"
+	o = append(o, 0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	o = msgp.AppendString(o, z.Origin)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Command) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Method":
+			z.Method, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "URI":
+			z.URI, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Header":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if z.Header == nil {
+				z.Header = make(map[string]string, zb0002)
+			} else if len(z.Header) > 0 {
+				for key := range z.Header {
+					delete(z.Header, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Header[za0001] = za0002
+			}
+		case "Body":
+			z.Body, bts, err = msgp.ReadBytesBytes(bts, z.Body)
+			if err != nil {
+				return
+			}
+		case "Time":
+			z.Time, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Origin":
+			z.Origin, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Command) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.Method) + 4 + msgp.StringPrefixSize + len(z.URI) + 7 + msgp.MapHeaderSize
+	if z.Header != nil {
+		for za0001, za0002 := range z.Header {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 5 + msgp.BytesPrefixSize + len(z.Body) + 5 + msgp.Int64Size + 7 + msgp.StringPrefixSize + len(z.Origin)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *CommandResult) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Status":
+			z.Status, err = dc.ReadInt()
+			if err != nil {
+				return
+			}
+		case "Header":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				return
+			}
+			if z.Header == nil {
+				z.Header = make(map[string]string, zb0002)
+			} else if len(z.Header) > 0 {
+				for key := range z.Header {
+					delete(z.Header, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				z.Header[za0001] = za0002
+			}
+		case "Body":
+			z.Body, err = dc.ReadBytes(z.Body)
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *CommandResult) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Status"
+	err = en.Append(0x83, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt(z.Status)
+	if err != nil {
+		return
+	}
+	// write "Header"
+	err = en.Append(0xa6, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Header)))
+	if err != nil {
+		return
+	}
+	for za0001, za0002 := range z.Header {
+		err = en.WriteString(za0001)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			return
+		}
+	}
+	// write "Body"
+	err = en.Append(0xa4, 0x42, 0x6f, 0x64, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Body)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *CommandResult) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Status"
+	o = append(o, 0x83, 0xa6, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73)
+	o = msgp.AppendInt(o, z.Status)
+	// string "Header"
+	o = append(o, 0xa6, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Header)))
+	for za0001, za0002 := range z.Header {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
+	}
+	// string "Body"
+	o = append(o, 0xa4, 0x42, 0x6f, 0x64, 0x79)
+	o = msgp.AppendBytes(o, z.Body)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *CommandResult) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Status":
+			z.Status, bts, err = msgp.ReadIntBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Header":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if z.Header == nil {
+				z.Header = make(map[string]string, zb0002)
+			} else if len(z.Header) > 0 {
+				for key := range z.Header {
+					delete(z.Header, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Header[za0001] = za0002
+			}
+		case "Body":
+			z.Body, bts, err = msgp.ReadBytesBytes(bts, z.Body)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *CommandResult) Msgsize() (s int) {
+	s = 1 + 7 + msgp.IntSize + 7 + msgp.MapHeaderSize
+	if z.Header != nil {
+		for za0001, za0002 := range z.Header {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 5 + msgp.BytesPrefixSize + len(z.Body)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Configuration) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Members":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Configuration) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "Members"
+	err = en.Append(0x81, 0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Members)))
+	if err != nil {
+		return
+	}
+	for za0001 := range z.Members {
+		// map header, size 3
+		// write "ID"
+		err = en.Append(0x83, 0xa2, 0x49, 0x44)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].ID)
+		if err != nil {
+			return
+		}
+		// write "PeerAddress"
+		err = en.Append(0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].PeerAddress)
+		if err != nil {
+			return
+		}
+		// write "APIURL"
+		err = en.Append(0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].APIURL)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Configuration) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "Members"
+	o = append(o, 0x81, 0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Members)))
+	for za0001 := range z.Members {
+		// map header, size 3
+		// string "ID"
+		o = append(o, 0x83, 0xa2, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Members[za0001].ID)
+		// string "PeerAddress"
+		o = append(o, 0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		o = msgp.AppendString(o, z.Members[za0001].PeerAddress)
+		// string "APIURL"
+		o = append(o, 0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		o = msgp.AppendString(o, z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Configuration) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Members":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Configuration) Msgsize() (s int) {
+	s = 1 + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Members {
+		s += 1 + 3 + msgp.StringPrefixSize + len(z.Members[za0001].ID) + 12 + msgp.StringPrefixSize + len(z.Members[za0001].PeerAddress) + 7 + msgp.StringPrefixSize + len(z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Entry) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Index":
+			z.Index, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Type":
+			z.Type, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Data":
+			z.Data, err = dc.ReadBytes(z.Data)
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Entry) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "Index"
+	err = en.Append(0x84, 0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Index)
+	if err != nil {
+		return
+	}
+	// write "Term"
+	err = en.Append(0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Type"
+	err = en.Append(0xa4, 0x54, 0x79, 0x70, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Type)
+	if err != nil {
+		return
+	}
+	// write "Data"
+	err = en.Append(0xa4, 0x44, 0x61, 0x74, 0x61)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Data)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Entry) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Index"
+	o = append(o, 0x84, 0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.Index)
+	// string "Term"
+	o = append(o, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Type"
+	o = append(o, 0xa4, 0x54, 0x79, 0x70, 0x65)
+	o = msgp.AppendString(o, z.Type)
+	// string "Data"
+	o = append(o, 0xa4, 0x44, 0x61, 0x74, 0x61)
+	o = msgp.AppendBytes(o, z.Data)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Entry) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Index":
+			z.Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Type":
+			z.Type, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Data":
+			z.Data, bts, err = msgp.ReadBytesBytes(bts, z.Data)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Entry) Msgsize() (s int) {
+	s = 1 + 6 + msgp.Uint64Size + 5 + msgp.Uint64Size + 5 + msgp.StringPrefixSize + len(z.Type) + 5 + msgp.BytesPrefixSize + len(z.Data)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *HardState) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "VotedFor":
+			z.VotedFor, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z HardState) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Term"
+	err = en.Append(0x82, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "VotedFor"
+	err = en.Append(0xa8, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.VotedFor)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z HardState) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Term"
+	o = append(o, 0x82, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "VotedFor"
+	o = append(o, 0xa8, 0x56, 0x6f, 0x74, 0x65, 0x64, 0x46, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.VotedFor)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *HardState) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "VotedFor":
+			z.VotedFor, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z HardState) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 9 + msgp.StringPrefixSize + len(z.VotedFor)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Member) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "PeerAddress":
+			z.PeerAddress, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "APIURL":
+			z.APIURL, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Member) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "ID"
+	err = en.Append(0x83, 0xa2, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.ID)
+	if err != nil {
+		return
+	}
+	// write "PeerAddress"
+	err = en.Append(0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.PeerAddress)
+	if err != nil {
+		return
+	}
+	// write "APIURL"
+	err = en.Append(0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.APIURL)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Member) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "ID"
+	o = append(o, 0x83, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "PeerAddress"
+	o = append(o, 0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+	o = msgp.AppendString(o, z.PeerAddress)
+	// string "APIURL"
+	o = append(o, 0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+	o = msgp.AppendString(o, z.APIURL)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Member) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "PeerAddress":
+			z.PeerAddress, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "APIURL":
+			z.APIURL, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Member) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 12 + msgp.StringPrefixSize + len(z.PeerAddress) + 7 + msgp.StringPrefixSize + len(z.APIURL)
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SnapshotMeta) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Index":
+			z.Index, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Members":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SnapshotMeta) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Index"
+	err = en.Append(0x83, 0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Index)
+	if err != nil {
+		return
+	}
+	// write "Term"
+	err = en.Append(0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Members"
+	err = en.Append(0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Members)))
+	if err != nil {
+		return
+	}
+	for za0001 := range z.Members {
+		// map header, size 3
+		// write "ID"
+		err = en.Append(0x83, 0xa2, 0x49, 0x44)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].ID)
+		if err != nil {
+			return
+		}
+		// write "PeerAddress"
+		err = en.Append(0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].PeerAddress)
+		if err != nil {
+			return
+		}
+		// write "APIURL"
+		err = en.Append(0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].APIURL)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SnapshotMeta) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Index"
+	o = append(o, 0x83, 0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.Index)
+	// string "Term"
+	o = append(o, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Members"
+	o = append(o, 0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Members)))
+	for za0001 := range z.Members {
+		// map header, size 3
+		// string "ID"
+		o = append(o, 0x83, 0xa2, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Members[za0001].ID)
+		// string "PeerAddress"
+		o = append(o, 0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		o = msgp.AppendString(o, z.Members[za0001].PeerAddress)
+		// string "APIURL"
+		o = append(o, 0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		o = msgp.AppendString(o, z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SnapshotMeta) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Index":
+			z.Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Members":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SnapshotMeta) Msgsize() (s int) {
+	s = 1 + 6 + msgp.Uint64Size + 5 + msgp.Uint64Size + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Members {
+		s += 1 + 3 + msgp.StringPrefixSize + len(z.Members[za0001].ID) + 12 + msgp.StringPrefixSize + len(z.Members[za0001].PeerAddress) + 7 + msgp.StringPrefixSize + len(z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SnapshotRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Leader":
+			z.Leader, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Index":
+			z.Index, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "LogTerm":
+			z.LogTerm, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Members":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *SnapshotRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "Term"
+	err = en.Append(0x85, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Leader"
+	err = en.Append(0xa6, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Leader)
+	if err != nil {
+		return
+	}
+	// write "Index"
+	err = en.Append(0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Index)
+	if err != nil {
+		return
+	}
+	// write "LogTerm"
+	err = en.Append(0xa7, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LogTerm)
+	if err != nil {
+		return
+	}
+	// write "Members"
+	err = en.Append(0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Members)))
+	if err != nil {
+		return
+	}
+	for za0001 := range z.Members {
+		// map header, size 3
+		// write "ID"
+		err = en.Append(0x83, 0xa2, 0x49, 0x44)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].ID)
+		if err != nil {
+			return
+		}
+		// write "PeerAddress"
+		err = en.Append(0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].PeerAddress)
+		if err != nil {
+			return
+		}
+		// write "APIURL"
+		err = en.Append(0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Members[za0001].APIURL)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *SnapshotRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "Term"
+	o = append(o, 0x85, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Leader"
+	o = append(o, 0xa6, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Leader)
+	// string "Index"
+	o = append(o, 0xa5, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.Index)
+	// string "LogTerm"
+	o = append(o, 0xa7, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.LogTerm)
+	// string "Members"
+	o = append(o, 0xa7, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Members)))
+	for za0001 := range z.Members {
+		// map header, size 3
+		// string "ID"
+		o = append(o, 0x83, 0xa2, 0x49, 0x44)
+		o = msgp.AppendString(o, z.Members[za0001].ID)
+		// string "PeerAddress"
+		o = append(o, 0xab, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73)
+		o = msgp.AppendString(o, z.Members[za0001].PeerAddress)
+		// string "APIURL"
+		o = append(o, 0xa6, 0x41, 0x50, 0x49, 0x55, 0x52, 0x4c)
+		o = msgp.AppendString(o, z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SnapshotRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Leader":
+			z.Leader, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Index":
+			z.Index, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "LogTerm":
+			z.LogTerm, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Members":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Members) >= int(zb0002) {
+				z.Members = (z.Members)[:zb0002]
+			} else {
+				z.Members = make([]Member, zb0002)
+			}
+			for za0001 := range z.Members {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "ID":
+						z.Members[za0001].ID, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "PeerAddress":
+						z.Members[za0001].PeerAddress, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "APIURL":
+						z.Members[za0001].APIURL, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *SnapshotRequest) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 7 + msgp.StringPrefixSize + len(z.Leader) + 6 + msgp.Uint64Size + 8 + msgp.Uint64Size + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Members {
+		s += 1 + 3 + msgp.StringPrefixSize + len(z.Members[za0001].ID) + 12 + msgp.StringPrefixSize + len(z.Members[za0001].PeerAddress) + 7 + msgp.StringPrefixSize + len(z.Members[za0001].APIURL)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *SnapshotResponse) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z SnapshotResponse) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 1
+	// write "Term"
+	err = en.Append(0x81, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z SnapshotResponse) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 1
+	// string "Term"
+	o = append(o, 0x81, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *SnapshotResponse) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z SnapshotResponse) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *VoteRequest) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Candidate":
+			z.Candidate, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "LastLogIndex":
+			z.LastLogIndex, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "LastLogTerm":
+			z.LastLogTerm, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *VoteRequest) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "Term"
+	err = en.Append(0x84, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Candidate"
+	err = en.Append(0xa9, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Candidate)
+	if err != nil {
+		return
+	}
+	// write "LastLogIndex"
+	err = en.Append(0xac, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LastLogIndex)
+	if err != nil {
+		return
+	}
+	// write "LastLogTerm"
+	err = en.Append(0xab, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.LastLogTerm)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *VoteRequest) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Term"
+	o = append(o, 0x84, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Candidate"
+	o = append(o, 0xa9, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65)
+	o = msgp.AppendString(o, z.Candidate)
+	// string "LastLogIndex"
+	o = append(o, 0xac, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65, 0x78)
+	o = msgp.AppendUint64(o, z.LastLogIndex)
+	// string "LastLogTerm"
+	o = append(o, 0xab, 0x4c, 0x61, 0x73, 0x74, 0x4c, 0x6f, 0x67, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.LastLogTerm)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *VoteRequest) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Candidate":
+			z.Candidate, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "LastLogIndex":
+			z.LastLogIndex, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "LastLogTerm":
+			z.LastLogTerm, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *VoteRequest) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 10 + msgp.StringPrefixSize + len(z.Candidate) + 13 + msgp.Uint64Size + 12 + msgp.Uint64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *VoteResponse) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Granted":
+			z.Granted, err = dc.ReadBool()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z VoteResponse) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "Term"
+	err = en.Append(0x82, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Term)
+	if err != nil {
+		return
+	}
+	// write "Granted"
+	err = en.Append(0xa7, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Granted)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z VoteResponse) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "Term"
+	o = append(o, 0x82, 0xa4, 0x54, 0x65, 0x72, 0x6d)
+	o = msgp.AppendUint64(o, z.Term)
+	// string "Granted"
+	o = append(o, 0xa7, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x64)
+	o = msgp.AppendBool(o, z.Granted)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *VoteResponse) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Term":
+			z.Term, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Granted":
+			z.Granted, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z VoteResponse) Msgsize() (s int) {
+	s = 1 + 5 + msgp.Uint64Size + 8 + msgp.BoolSize
+	return
+}
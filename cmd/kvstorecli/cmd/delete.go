@@ -21,6 +21,7 @@
 package cmd
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/go-openapi/kvstore/api/client"
@@ -28,16 +29,47 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	deletePrefix string
+	deleteDryRun bool
+)
+
 // deleteCmd represents the delete command
 var deleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "Delete an entry.",
-	Long:  `Delete an entry from the k/v store.`,
+	Long: `Delete an entry from the k/v store.
+
+When the --prefix flag is set, all the entries with a key that starts with the prefix
+are deleted. Use --dry-run to list the keys that would be removed.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cl, err := client.New(url)
 		if err != nil {
 			log.Fatalln(err)
 		}
+		if deletePrefix != "" {
+			if deleteDryRun {
+				log.Printf("listing entries that would be deleted for prefix %q", deletePrefix)
+				keys, err := cl.FindKeys(deletePrefix)
+				if err != nil {
+					log.Fatalln(err)
+				}
+				for _, key := range keys {
+					fmt.Println(key)
+				}
+				log.Printf("would delete %d entries", len(keys))
+				return
+			}
+
+			log.Printf("deleting entries for prefix %q", deletePrefix)
+			deleted, err := cl.DeletePrefix(deletePrefix)
+			if err != nil {
+				log.Fatalln(err)
+			}
+			log.Printf("deleted %d entries", deleted)
+			return
+		}
+
 		var key string
 		if len(args) > 0 {
 			key = args[0]
@@ -65,5 +97,7 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// deleteCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	deleteCmd.Flags().StringVar(&deletePrefix, "prefix", "", "Delete all the entries with a key that starts with this prefix")
+	deleteCmd.Flags().BoolVar(&deleteDryRun, "dry-run", false, "List the keys that would be deleted by --prefix without deleting them")
 
 }
@@ -54,6 +54,9 @@ to quickly create a Cobra application.`,
 			log.Fatalln(err)
 		}
 		fmt.Println("Version:", value.Version)
+		if len(value.Labels) > 0 {
+			fmt.Println("Labels:", value.Labels)
+		}
 		fmt.Println(string(value.Data))
 	},
 }
@@ -0,0 +1,70 @@
+// Copyright © 2016 Ivan Porto Carrero
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/go-openapi/kvstore/api/client"
+
+	"github.com/spf13/cobra"
+)
+
+var incrInitial int64
+
+// incrCmd represents the incr command
+var incrCmd = &cobra.Command{
+	Use:   "incr key [delta]",
+	Short: "Increment a counter",
+	Long: `Atomically add a delta to the counter stored in an entry, the delta defaults to 1.
+Use a negative delta after -- to decrement the counter. When the entry doesn't exist yet the counter
+starts at the value of the --initial flag.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cl, err := client.New(url)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		key := args[0]
+		delta := int64(1)
+		if len(args) > 1 {
+			delta, err = strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				log.Fatalln(err)
+			}
+		}
+
+		log.Printf("incrementing counter for key %q by %d", key, delta)
+		value, err := cl.Incr(key, delta, incrInitial)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		fmt.Println(value)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(incrCmd)
+
+	incrCmd.Flags().Int64Var(&incrInitial, "initial", 0, "The value the counter starts at when it doesn't exist yet")
+}
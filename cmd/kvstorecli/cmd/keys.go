@@ -29,11 +29,20 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var (
+	keysDelimiter string
+	keysSelector  string
+)
+
 // keysCmd represents the keys command
 var keysCmd = &cobra.Command{
 	Use:   "keys",
 	Short: "List the known keys",
-	Long:  `List the known keys. Allows for a prefix to be specified to filter the keys`,
+	Long: `List the known keys. Allows for a prefix to be specified to filter the keys.
+
+Keys can contain slashes to build a hierarchy, the --delimiter flag lists a single level
+of that hierarchy: the keys below the prefix that contain the delimiter are shown as a
+common prefix that ends with the delimiter.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		cl, err := client.New(url)
 		if err != nil {
@@ -44,7 +53,12 @@ var keysCmd = &cobra.Command{
 			prefix = args[0]
 		}
 		log.Printf("getting keys for prefix %q", prefix)
-		result, err := cl.FindKeys(prefix)
+		var result []string
+		if keysSelector != "" {
+			result, err = cl.SelectKeys(prefix, keysSelector)
+		} else {
+			result, err = cl.ListKeys(prefix, keysDelimiter)
+		}
 		if err != nil {
 			log.Fatalln(err)
 		}
@@ -65,5 +79,7 @@ func init() {
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
 	// keysCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
+	keysCmd.Flags().StringVar(&keysDelimiter, "delimiter", "", "List a single level of the keys, grouped by this delimiter")
+	keysCmd.Flags().StringVar(&keysSelector, "selector", "", "Only list the keys of the entries with labels that match, like env=prod,tier!=cache")
 
 }
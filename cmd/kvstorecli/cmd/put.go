@@ -23,12 +23,16 @@ package cmd
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/go-openapi/kvstore/api/client"
 	"github.com/spf13/cobra"
 )
 
-var etag uint64
+var (
+	etag      uint64
+	putLabels []string
+)
 
 // putCmd represents the put command
 var putCmd = &cobra.Command{
@@ -54,6 +58,16 @@ var putCmd = &cobra.Command{
 			Data:    []byte(data),
 			Version: etag,
 		}
+		if cmd.Flags().Changed("label") {
+			entry.Labels = make(map[string]string, len(putLabels))
+			for _, label := range putLabels {
+				parts := strings.SplitN(label, "=", 2)
+				if len(parts) != 2 {
+					log.Fatalf("label %q is not a name=value pair", label)
+				}
+				entry.Labels[parts[0]] = parts[1]
+			}
+		}
 		err = cl.Put(key, entry)
 		if err != nil {
 			log.Fatalln(err)
@@ -73,6 +87,7 @@ func init() {
 	// and all subcommands, e.g.:
 	// putCmd.PersistentFlags().String("foo", "", "A help for foo")
 	putCmd.Flags().Uint64Var(&etag, "version", 0, "The version for updating a key in the k/v store")
+	putCmd.Flags().StringSliceVar(&putLabels, "label", nil, "Replaces the labels of the entry with these name=value pairs")
 
 	// Cobra supports local flags which will only run when this command
 	// is called directly, e.g.:
@@ -0,0 +1,83 @@
+// Copyright © 2016 Ivan Porto Carrero
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/swag"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	searchPrefix  string
+	searchLimit   int64
+	searchRebuild bool
+)
+
+// searchCmd represents the search command
+var searchCmd = &cobra.Command{
+	Use:   "search [words...]",
+	Short: "Find the keys of the entries that contain words",
+	Long: `Find the keys of the entries that contain any of the words, the best match comes first.
+Only the entries below the search prefixes the server is configured with are searchable.
+
+The --rebuild flag rebuilds the search index from the stored entries instead, this indexes the
+entries that existed before search was enabled or before the search prefixes changed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cl, err := client.New(url)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		if searchRebuild {
+			indexed, err := cl.RebuildSearch()
+			if err != nil {
+				log.Fatalln(err)
+			}
+			fmt.Println("Indexed:", indexed)
+			return
+		}
+
+		if len(args) == 0 {
+			log.Fatalln("search needs the words to look for")
+		}
+		results, err := cl.Search(strings.Join(args, " "), searchPrefix, searchLimit)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		for _, r := range results {
+			fmt.Printf("%s\t%.3f\t%s\n", swag.StringValue(r.Key), swag.Float64Value(r.Score), swag.StringValue(r.Snippet))
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(searchCmd)
+
+	searchCmd.Flags().StringVar(&searchPrefix, "prefix", "", "Only find the entries with a key that starts with the prefix")
+	searchCmd.Flags().Int64Var(&searchLimit, "limit", 20, "The maximum number of entries to show")
+	searchCmd.Flags().BoolVar(&searchRebuild, "rebuild", false, "Rebuild the search index from the stored entries")
+}
@@ -0,0 +1,121 @@
+// Copyright © 2016 Ivan Porto Carrero
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	semaphoreLimit  int64
+	semaphoreTTL    time.Duration
+	semaphoreWait   time.Duration
+	semaphoreHolder string
+)
+
+// semaphoreCmd represents the semaphore command
+var semaphoreCmd = &cobra.Command{
+	Use:   "semaphore name -- command [args...]",
+	Short: "Run a command while holding a slot of a semaphore",
+	Long: `Acquire a slot of the semaphore, run the command and release the slot when the command exits.
+At most --limit commands hold a slot of the same semaphore at the same time, the others wait up to --wait
+for a slot. The slot is renewed while the command runs, when renewing fails the command gets killed.
+The exit code is the one of the command.`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		cl, err := client.New(url)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		name := args[0]
+		holder := semaphoreHolder
+		if holder == "" {
+			host, _ := os.Hostname()
+			holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+		}
+
+		sem := cl.NewSemaphore(name, holder, semaphoreLimit, semaphoreTTL)
+		log.Printf("acquiring a slot of semaphore %q as %q", name, holder)
+		if err := sem.Acquire(semaphoreWait); err != nil {
+			log.Fatalln(err)
+		}
+
+		child := exec.Command(args[1], args[2:]...)
+		child.Stdin, child.Stdout, child.Stderr = os.Stdin, os.Stdout, os.Stderr
+		if err := child.Start(); err != nil {
+			_ = sem.Release()
+			log.Fatalln(err)
+		}
+
+		// pass the signals on so the command can clean up, the slot is released when it exits
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		exited := make(chan error, 1)
+		go func() { exited <- child.Wait() }()
+
+	wait:
+		for {
+			select {
+			case sig := <-signals:
+				_ = child.Process.Signal(sig)
+			case <-sem.Lost():
+				log.Printf("lost the slot of semaphore %q, killing the command", name)
+				_ = child.Process.Kill()
+				<-exited
+				os.Exit(1)
+			case err = <-exited:
+				break wait
+			}
+		}
+
+		if e := sem.Release(); e != nil {
+			log.Println(e)
+		}
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+				os.Exit(status.ExitStatus())
+			}
+			os.Exit(1)
+		}
+		if err != nil {
+			log.Fatalln(err)
+		}
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(semaphoreCmd)
+
+	semaphoreCmd.Flags().Int64Var(&semaphoreLimit, "limit", 1, "The number of commands that hold a slot at the same time")
+	semaphoreCmd.Flags().DurationVar(&semaphoreTTL, "ttl", 30*time.Second, "The time the slot is held when it isn't renewed")
+	semaphoreCmd.Flags().DurationVar(&semaphoreWait, "wait", 5*time.Minute, "The time to wait for a slot")
+	semaphoreCmd.Flags().StringVar(&semaphoreHolder, "holder", "", "Identifies this holder, defaults to the host name and process id")
+}
@@ -0,0 +1,69 @@
+// Copyright © 2016 Ivan Porto Carrero
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cmd
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/swag"
+
+	"github.com/spf13/cobra"
+)
+
+// statsCmd represents the stats command
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show the number of keys and their sizes",
+	Long:  `Show the number of keys, the sizes of the values and the approximate disk usage for the keys that start with a prefix.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		cl, err := client.New(url)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		var prefix string
+		if len(args) > 0 {
+			prefix = args[0]
+		}
+		log.Printf("getting stats for prefix %q", prefix)
+		stats, err := cl.Stats(prefix)
+		if err != nil {
+			log.Fatalln(err)
+		}
+
+		fmt.Println("Keys:", swag.Int64Value(stats.Keys))
+		fmt.Println("Total bytes:", swag.Int64Value(stats.TotalBytes))
+		fmt.Println("Disk bytes:", swag.Int64Value(stats.DiskBytes))
+		if swag.Int64Value(stats.Keys) == 0 {
+			return
+		}
+		fmt.Println("Min size:", stats.MinSize)
+		fmt.Println("Max size:", stats.MaxSize)
+		fmt.Printf("Avg size: %.2f\n", stats.AvgSize)
+		fmt.Println("Oldest:", stats.Oldest)
+		fmt.Println("Newest:", stats.Newest)
+	},
+}
+
+func init() {
+	RootCmd.AddCommand(statsCmd)
+}
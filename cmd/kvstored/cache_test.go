@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+)
+
+const cacheConfig = `cache:
+  freshness: 300ms
+  poll_interval: 50ms
+  timeout: 500ms
+`
+
+// cachedGet reads the entry at key and returns the value, the status and the X-Cache header
+func cachedGet(t *testing.T, url, key string) (string, int, string) {
+	res, err := http.Get(url + "/kv/" + key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data), res.StatusCode, res.Header.Get("X-Cache")
+}
+
+// lastUpdated reads the entry at key and returns the time it was last updated in unix nanoseconds
+func lastUpdated(t *testing.T, url, key string) string {
+	res, err := http.Get(url + "/kv/" + key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	return res.Header.Get("X-Last-Updated")
+}
+
+func TestCache(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	upstream := newKvstored(t, "")
+	upstream.start(t, bin)
+	uc := upstream.client(t)
+	if err := uc.Put("a/1", &client.Entry{Data: []byte("one")}); err != nil {
+		t.Fatal(err)
+	}
+
+	edge := newKvstored(t, cacheConfig, "--upstream", upstream.url)
+	edge.start(t, bin)
+
+	// the first read gets the entry from the upstream, the next one gets the copy
+	if value, status, how := cachedGet(t, edge.url, "a/1"); value != "one" || status != http.StatusOK || how != "miss" {
+		t.Fatalf("the first read got %q, %d, %s", value, status, how)
+	}
+	if value, _, how := cachedGet(t, edge.url, "a/1"); value != "one" || how != "hit" {
+		t.Errorf("the second read got %q, %s", value, how)
+	}
+	if cached, original := lastUpdated(t, edge.url, "a/1"), lastUpdated(t, upstream.url, "a/1"); cached != original {
+		t.Errorf("the copy was last updated at %s, the entry at %s", cached, original)
+	}
+	if _, status, _ := cachedGet(t, edge.url, "missing"); status != http.StatusNotFound {
+		t.Errorf("reading an entry that doesn't exist got %d", status)
+	}
+
+	// a copy that is no longer fresh gets revalidated
+	time.Sleep(400 * time.Millisecond)
+	if value, _, how := cachedGet(t, edge.url, "a/1"); value != "one" || how != "revalidated" {
+		t.Errorf("the read after the freshness period got %q, %s", value, how)
+	}
+
+	// the changes upstream replace the copies
+	entry, err := uc.Get("a/1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := uc.Put("a/1", &client.Entry{Data: []byte("two"), Version: entry.Version}); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(t, "the copy to be replaced", func() bool {
+		value, _, how := cachedGet(t, edge.url, "a/1")
+		return value == "two" && how == "hit"
+	})
+
+	// the writes and the other reads go to the upstream
+	req, err := http.NewRequest(http.MethodPut, edge.url+"/kv/b/1", bytes.NewReader([]byte("written")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/octet-stream")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusCreated {
+		t.Fatalf("writing through the cache got %s", res.Status)
+	}
+	if entry, err := uc.Get("b/1", 0); err != nil || string(entry.Data) != "written" {
+		t.Errorf("the upstream has %+v, %v", entry, err)
+	}
+	ec := edge.client(t)
+	if keys, err := ec.FindKeys(""); err != nil || len(keys) != 2 {
+		t.Errorf("listing the keys through the cache got %v, %v", keys, err)
+	}
+
+	// without the upstream the copies are served stale
+	upstream.stop()
+	time.Sleep(400 * time.Millisecond)
+	res, err = http.Get(edge.url + "/kv/a/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if string(data) != "two" || res.Header.Get("X-Cache") != "stale" || res.Header.Get("Warning") == "" {
+		t.Errorf("the read without the upstream got %q with %v", data, res.Header)
+	}
+	if _, status, _ := cachedGet(t, edge.url, "never/read"); status != http.StatusBadGateway {
+		t.Errorf("reading an entry that isn't cached without the upstream got %d", status)
+	}
+	if err := ec.Put("c/1", &client.Entry{Data: []byte("lost")}); err == nil {
+		t.Error("writing without the upstream succeeded")
+	}
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/swag"
+)
+
+const clusterConfig = `cluster:
+  heartbeat_interval: 50ms
+  election_timeout: 300ms
+`
+
+func newMember(t *testing.T, id, config string, args ...string) *kvstored {
+	peer := fmt.Sprintf("127.0.0.1:%d", freePort(t))
+	return newKvstored(t, config, append([]string{"--node-id", id, "--peer-address", peer}, args...)...)
+}
+
+// leaderOf waits until the member knows a leader and returns its url
+func leaderOf(t *testing.T, c *client.KvStore) string {
+	var leader string
+	waitFor(t, "a leader", func() bool {
+		status, err := c.ClusterStatus()
+		if err != nil {
+			return false
+		}
+		leader = status.LeaderURL
+		return leader != ""
+	})
+	return leader
+}
+
+func staleGet(url, key string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, url+"/kv/"+key, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Consistency", "stale")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	data, err := ioutil.ReadAll(res.Body)
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s: %s", res.Status, data)
+	}
+	return string(data), err
+}
+
+func TestCluster(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	n1 := newMember(t, "n1", clusterConfig, "--bootstrap")
+	n1.start(t, bin)
+	c1 := n1.client(t)
+	if leader := leaderOf(t, c1); leader != n1.url {
+		t.Fatalf("the bootstrapped member follows %s", leader)
+	}
+
+	n2 := newMember(t, "n2", clusterConfig, "--join", n1.url)
+	n2.start(t, bin)
+	n3 := newMember(t, "n3", clusterConfig+"  redirect: true\n", "--join", n1.url)
+	n3.start(t, bin)
+	waitFor(t, "3 members", func() bool {
+		status, err := c1.ClusterStatus()
+		return err == nil && len(status.Members) == 3
+	})
+
+	// a follower forwards the writes and the linearizable reads to the leader
+	c2 := n2.client(t)
+	if err := c2.Put("a", &client.Entry{Data: []byte("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if entry, err := c2.Get("a", 0); err != nil || string(entry.Data) != "1" {
+		t.Fatalf("the linearizable read after the write returned %+v, %v", entry, err)
+	}
+	waitFor(t, "a stale read of a on n3", func() bool {
+		value, err := staleGet(n3.url, "a")
+		return err == nil && value == "1"
+	})
+
+	// a follower that redirects sends the writes to the leader
+	redirects := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	req, _ := http.NewRequest(http.MethodPut, n3.url+"/kv/b", bytes.NewReader([]byte("2")))
+	req.Header.Set("Content-Type", "application/octet-stream")
+	res, err := redirects.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusTemporaryRedirect || !strings.HasPrefix(res.Header.Get("Location"), n1.url+"/kv/b") {
+		t.Errorf("the write was answered with %s to %q", res.Status, res.Header.Get("Location"))
+	}
+
+	// the writes that can't be replayed are not available
+	res, err = http.Post(n1.url+"/sessions", "application/json", strings.NewReader(`{"ttl":10}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusNotImplemented {
+		t.Errorf("creating a session in cluster mode resulted in %s", res.Status)
+	}
+
+	// the others elect a new leader when the leader goes away
+	n1.stop()
+	waitFor(t, "a new leader", func() bool {
+		status, err := c2.ClusterStatus()
+		return err == nil && status.LeaderURL != "" && status.LeaderURL != n1.url
+	})
+	waitFor(t, "a write without n1", func() bool {
+		return c2.Put("c", &client.Entry{Data: []byte("3")}) == nil
+	})
+	waitFor(t, "c on n3", func() bool {
+		value, err := staleGet(n3.url, "c")
+		return err == nil && value == "3"
+	})
+
+	status, err := n3.client(t).ClusterStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swag.Uint64Value(status.AppliedIndex) == 0 || len(status.Members) != 3 {
+		t.Errorf("n3 reports %+v", status)
+	}
+}
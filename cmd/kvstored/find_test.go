@@ -0,0 +1,118 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/swag"
+)
+
+func TestFindEntries(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	k := newKvstored(t, "")
+	k.start(t, bin)
+	c := k.client(t)
+	for key, value := range map[string]string{
+		"users/ann":   `{"name":"ann","age":30,"city":"paris","address":{"zip":"75001","street":"rue x"}}`,
+		"users/bob":   `{"name":"bob","age":17,"city":"paris"}`,
+		"users/cid":   `{"name":"cid","age":45,"city":"rome","admin":true}`,
+		"users/raw":   `not a document`,
+		"others/dave": `{"name":"dave","age":30,"city":"paris"}`,
+	} {
+		if err := c.Put(key, &client.Entry{Data: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		prefix, filter, fields string
+		want                   map[string]string
+	}{
+		{"users/", "exists /name", "", map[string]string{
+			"users/ann": `{"address":{"street":"rue x","zip":"75001"},"age":30,"city":"paris","name":"ann"}`,
+			"users/bob": `{"age":17,"city":"paris","name":"bob"}`,
+			"users/cid": `{"admin":true,"age":45,"city":"rome","name":"cid"}`,
+		}},
+		{"users/", `/city = "paris" and /age >= 18`, "", map[string]string{
+			"users/ann": `{"address":{"street":"rue x","zip":"75001"},"age":30,"city":"paris","name":"ann"}`,
+		}},
+		{"", `/age = 30`, "/name", map[string]string{
+			"others/dave": `{"name":"dave"}`,
+			"users/ann":   `{"name":"ann"}`,
+		}},
+		{"users/", `exists /admin or /age < 18`, "/name,/address/zip", map[string]string{
+			"users/bob": `{"name":"bob"}`,
+			"users/cid": `{"name":"cid"}`,
+		}},
+		{"users/", "", "/address/zip", map[string]string{
+			"users/ann": `{"address":{"zip":"75001"}}`,
+			"users/bob": `{}`,
+			"users/cid": `{}`,
+		}},
+		{"users/", `/city in ("london")`, "", map[string]string{}},
+	}
+	for _, tt := range tests {
+		entries, err := c.FindEntries(tt.prefix, tt.filter, tt.fields)
+		if err != nil {
+			t.Errorf("%q %q %q: %v", tt.prefix, tt.filter, tt.fields, err)
+			continue
+		}
+		got := make(map[string]string, len(entries))
+		for _, e := range entries {
+			value, err := json.Marshal(e.Value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got[swag.StringValue(e.Key)] = string(value)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%q %q %q: got %v, want %v", tt.prefix, tt.filter, tt.fields, got, tt.want)
+			continue
+		}
+		for key, value := range tt.want {
+			if got[key] != value {
+				t.Errorf("%q %q %q: got %s for %s, want %s", tt.prefix, tt.filter, tt.fields, got[key], key, value)
+			}
+		}
+	}
+
+	// the entries come in key order up to the limit
+	res, err := http.Get(k.url + "/kv?prefix=users/&fields=/name&limit=2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, _ := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	var limited []struct{ Key string }
+	if err := json.Unmarshal(data, &limited); err != nil {
+		t.Fatalf("%s: %v", data, err)
+	}
+	if len(limited) != 2 || limited[0].Key != "users/ann" || limited[1].Key != "users/bob" {
+		t.Errorf("the limited listing got %s", data)
+	}
+
+	// malformed filters and fields are bad requests
+	for _, query := range []url.Values{
+		{"filter": {`/age >`}},
+		{"filter": {`/age = 1 and`}},
+		{"fields": {`name`}},
+		{"fields": {`/name`}, "delimiter": {"/"}},
+	} {
+		res, err := http.Get(k.url + "/kv?" + query.Encode())
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("%v got %s", query, res.Status)
+		}
+	}
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"fmt"
+	"net"
 	"os"
+	"strconv"
+	"time"
 
 	app "github.com/casualjim/go-app"
 	"github.com/casualjim/middlewares"
@@ -12,6 +16,8 @@ import (
 
 	"github.com/go-openapi/kvstore"
 	"github.com/go-openapi/kvstore/api/handlers"
+	"github.com/go-openapi/kvstore/api/middleware"
+	"github.com/go-openapi/kvstore/cluster"
 	"github.com/go-openapi/kvstore/gen/restapi"
 	"github.com/go-openapi/kvstore/gen/restapi/operations"
 )
@@ -26,6 +32,39 @@ func main() {
 	log := app.Logger()
 	cfg := app.Config()
 	cfg.SetDefault("store.path", "./db/data.db")
+	cfg.SetDefault("store.session_check_interval", time.Second)
+	cfg.SetDefault("store.sequence_block_size", 1000)
+	// the entries below these prefixes are in the search index, there is no search without them
+	cfg.SetDefault("store.search_prefixes", []string{})
+	// the changes log keeps the changes for this long, 0 keeps them forever
+	cfg.SetDefault("store.changes_retention", 7*24*time.Hour)
+	// the changes log keeps at most this many changes, 0 doesn't limit it
+	cfg.SetDefault("store.changes_max", 0)
+	// the tombstones of the deletes are kept this long, a peer that merges a delete later can bring the entry back
+	cfg.SetDefault("store.tombstones_retention", 7*24*time.Hour)
+	// the id of the store is the origin of its writes, without one the store makes one up and keeps it
+	cfg.SetDefault("store.node_id", "")
+	// a follower checks the changelog of the primary this often when it applied all the changes
+	cfg.SetDefault("replication.poll_interval", 250*time.Millisecond)
+	// a cache serves its copy of an entry for this long before it revalidates it with the upstream
+	cfg.SetDefault("cache.freshness", 30*time.Second)
+	// a cache checks the changelog of the upstream this often when it applied all the changes
+	cfg.SetDefault("cache.poll_interval", time.Second)
+	// a cache serves its stale copy when revalidating it takes longer than this
+	cfg.SetDefault("cache.timeout", 5*time.Second)
+	// a cache follows the changes of this many entries, the ones read least recently get revalidated on their next read
+	cfg.SetDefault("cache.max_entries", 100000)
+	// the raft log of a member of a cluster is kept below this path
+	cfg.SetDefault("cluster.path", "./db/raft")
+	cfg.SetDefault("cluster.heartbeat_interval", 100*time.Millisecond)
+	cfg.SetDefault("cluster.election_timeout", time.Second)
+	// the log gets compacted into the store every time this many entries are applied
+	cfg.SetDefault("cluster.snapshot_threshold", 10000)
+	// followers redirect the writes to the leader instead of forwarding them
+	cfg.SetDefault("cluster.redirect", false)
+	// the store repairs the entries that differ from these peers every interval, last writer wins
+	cfg.SetDefault("antientropy.peers", []string{})
+	cfg.SetDefault("antientropy.interval", time.Minute)
 
 	rt, err := kvstore.NewRuntime(app)
 	if err != nil {
@@ -47,6 +86,26 @@ func main() {
 	parser.ShortDescription = `K/V store`
 	parser.LongDescription = `K/V store is a simple single node store for retrieving key/value information`
 
+	var replicationOpts struct {
+		Follow   string   `long:"follow" description:"the url of a kvstored to follow, this store applies its changes and rejects writes" env:"KVSTORE_FOLLOW"`
+		Peers    []string `long:"peer" description:"the url of a kvstored that takes writes too, the peers merge each other's changes, last writer wins (can be repeated)" env:"KVSTORE_PEERS" env-delim:","`
+		Upstream string   `long:"upstream" description:"the url of a kvstored to cache, this store serves the entries it read from it and forwards everything else to it" env:"KVSTORE_UPSTREAM"`
+	}
+	if _, err := parser.AddGroup("Replication Options", "", &replicationOpts); err != nil {
+		log.Fatalln(err)
+	}
+
+	var clusterOpts struct {
+		NodeID       string `long:"node-id" description:"the id of this member of a raft cluster, this runs the store in cluster mode" env:"KVSTORE_NODE_ID"`
+		PeerAddress  string `long:"peer-address" description:"the host:port to serve the raft requests of the other members at" env:"KVSTORE_PEER_ADDRESS"`
+		AdvertiseURL string `long:"advertise-url" description:"the url of the api the other members send the writes to when this member leads, defaults to the listen address" env:"KVSTORE_ADVERTISE_URL"`
+		Bootstrap    bool   `long:"bootstrap" description:"start a new cluster with this member as its only member"`
+		Join         string `long:"join" description:"the url of a member of the cluster to add this member to" env:"KVSTORE_JOIN"`
+	}
+	if _, err := parser.AddGroup("Cluster Options", "", &clusterOpts); err != nil {
+		log.Fatalln(err)
+	}
+
 	server.ConfigureFlags()
 	for _, optsGroup := range api.CommandLineOptionsGroups {
 		_, err := parser.AddGroup(optsGroup.ShortDescription, optsGroup.LongDescription, optsGroup.Options)
@@ -65,19 +124,138 @@ func main() {
 		os.Exit(code)
 	}
 
+	if replicationOpts.Follow != "" && clusterOpts.NodeID != "" {
+		log.Fatalln("a member of a cluster can't follow a primary")
+	}
+	if len(replicationOpts.Peers) > 0 && (replicationOpts.Follow != "" || clusterOpts.NodeID != "") {
+		log.Fatalln("a peer can't follow a primary or be a member of a cluster")
+	}
+	if replicationOpts.Upstream != "" && (replicationOpts.Follow != "" || len(replicationOpts.Peers) > 0 || clusterOpts.NodeID != "") {
+		log.Fatalln("a cache can't follow a primary, be a peer or be a member of a cluster")
+	}
+	if replicationOpts.Follow != "" {
+		if err := rt.Follow(replicationOpts.Follow); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if len(replicationOpts.Peers) > 0 {
+		if err := rt.PeerWith(replicationOpts.Peers); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if replicationOpts.Upstream != "" {
+		if err := rt.CacheFrom(replicationOpts.Upstream); err != nil {
+			log.Fatalln(err)
+		}
+	}
+	if peers := cfg.GetStringSlice("antientropy.peers"); len(peers) > 0 {
+		rt.RepairFrom(peers)
+	}
+
+	api.ChangelogReadChangelogHandler = handlers.NewReadChangelog(rt)
+	api.ClusterAddClusterMemberHandler = handlers.NewAddClusterMember(rt)
+	api.ClusterGetClusterStatusHandler = handlers.NewGetClusterStatus(rt)
+	api.ClusterRemoveClusterMemberHandler = handlers.NewRemoveClusterMember(rt)
+	api.ElectionsCampaignHandler = handlers.NewCampaign(rt)
+	api.ElectionsGetLeaderHandler = handlers.NewGetLeader(rt)
+	api.ElectionsRenewLeadershipHandler = handlers.NewRenewLeadership(rt)
+	api.ElectionsResignHandler = handlers.NewResign(rt)
+	api.HashesDeleteFieldHandler = handlers.NewDeleteField(rt)
+	api.HashesGetFieldHandler = handlers.NewGetField(rt)
+	api.HashesGetHashHandler = handlers.NewGetHash(rt)
+	api.HashesSetFieldHandler = handlers.NewSetField(rt)
+	api.IndexesCreateIndexHandler = handlers.NewCreateIndex(rt)
+	api.IndexesDropIndexHandler = handlers.NewDropIndex(rt)
+	api.IndexesListIndexesHandler = handlers.NewListIndexes(rt)
+	api.IndexesQueryIndexHandler = handlers.NewQueryIndex(rt)
+	api.KvAppendEntryHandler = handlers.NewAppendEntry(rt)
+	api.KvCopyEntryHandler = handlers.NewCopyEntry(rt)
 	api.KvDeleteEntryHandler = handlers.NewDeleteEntry(rt)
+	api.KvDeleteKeysHandler = handlers.NewDeleteKeys(rt)
 	api.KvFindKeysHandler = handlers.NewFindKeys(rt)
 	api.KvGetEntryHandler = handlers.NewGetEntry(rt)
+	api.KvGetStatsHandler = handlers.NewGetStats(rt)
+	api.KvIncrEntryHandler = handlers.NewIncrEntry(rt)
+	api.KvListChangesHandler = handlers.NewListChanges(rt)
+	api.KvMoveEntryHandler = handlers.NewMoveEntry(rt)
+	api.KvPatchEntryHandler = handlers.NewPatchEntry(rt)
 	api.KvPutEntryHandler = handlers.NewPutEntry(rt)
+	api.KvSetLabelsHandler = handlers.NewSetLabels(rt)
+	api.LocksAcquireLockHandler = handlers.NewAcquireLock(rt)
+	api.LocksGetLockHandler = handlers.NewGetLock(rt)
+	api.LocksReleaseLockHandler = handlers.NewReleaseLock(rt)
+	api.LocksRenewLockHandler = handlers.NewRenewLock(rt)
+	api.MerkleGetMerkleLevelHandler = handlers.NewGetMerkleLevel(rt)
+	api.MerkleListMerkleKeysHandler = handlers.NewListMerkleKeys(rt)
+	api.MerkleRepairFromHandler = handlers.NewRepairFrom(rt)
+	api.QueuesAckMessageHandler = handlers.NewAckMessage(rt)
+	api.QueuesDequeueMessageHandler = handlers.NewDequeueMessage(rt)
+	api.QueuesEnqueueMessageHandler = handlers.NewEnqueueMessage(rt)
+	api.QueuesGetQueueHandler = handlers.NewGetQueue(rt)
+	api.QueuesNackMessageHandler = handlers.NewNackMessage(rt)
+	api.QueuesPeekMessageHandler = handlers.NewPeekMessage(rt)
+	api.ReplicationGetReplicationStatusHandler = handlers.NewGetReplicationStatus(rt)
+	api.ReplicationGetSnapshotHandler = handlers.NewGetSnapshot(rt)
+	api.SearchRebuildSearchHandler = handlers.NewRebuildSearch(rt)
+	api.SearchSearchHandler = handlers.NewSearch(rt)
+	api.SemaphoresAcquireSlotHandler = handlers.NewAcquireSlot(rt)
+	api.SemaphoresGetSemaphoreHandler = handlers.NewGetSemaphore(rt)
+	api.SemaphoresReleaseSlotHandler = handlers.NewReleaseSlot(rt)
+	api.SemaphoresRenewSlotHandler = handlers.NewRenewSlot(rt)
+	api.SequencesNextIdsHandler = handlers.NewNextIds(rt)
+	api.SessionsCreateSessionHandler = handlers.NewCreateSession(rt)
+	api.SessionsDestroySessionHandler = handlers.NewDestroySession(rt)
+	api.SessionsGetSessionHandler = handlers.NewGetSession(rt)
+	api.SessionsListSessionsHandler = handlers.NewListSessions(rt)
+	api.SessionsRenewSessionHandler = handlers.NewRenewSession(rt)
+	api.ZsetsAddMembersHandler = handlers.NewAddMembers(rt)
+	api.ZsetsGetRankHandler = handlers.NewGetRank(rt)
+	api.ZsetsGetZsetHandler = handlers.NewGetZset(rt)
+	api.ZsetsIncrScoreHandler = handlers.NewIncrScore(rt)
+	api.ZsetsRangeByRankHandler = handlers.NewRangeByRank(rt)
+	api.ZsetsRangeByScoreHandler = handlers.NewRangeByScore(rt)
+	api.ZsetsRemoveMembersHandler = handlers.NewRemoveMembers(rt)
+
+	suffixes := middleware.KeySuffixes(swaggerSpec, "/kv/")
+	inner := middleware.NewHierarchicalKeys("/kv/", suffixes)(api.Serve(nil))
 
-	handler := alice.New(
+	var self cluster.Member
+	if clusterOpts.NodeID != "" {
+		// the listen address is known once it listens, the port can be a random one
+		if err := server.Listen(); err != nil {
+			log.Fatalln(err)
+		}
+		self = cluster.Member{ID: clusterOpts.NodeID, PeerAddress: clusterOpts.PeerAddress, APIURL: clusterOpts.AdvertiseURL}
+		if self.APIURL == "" {
+			self.APIURL = fmt.Sprintf("http://%s", net.JoinHostPort(server.Host, strconv.Itoa(server.Port)))
+		}
+		// the members replay the committed writes against the api below the cluster middleware
+		if err := rt.StartCluster(self, clusterOpts.Bootstrap, middleware.NewClusterApply(inner)); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	chain := alice.New(
 		middlewares.NewRecoveryMW(app.Info().Name, log),
 		middlewares.NewAuditMW(app.Info(), log),
 		middlewares.NewProfiler,
 		middlewares.NewHealthChecksMW(app.Info().BasePath),
-	).Then(api.Serve(nil))
+	)
+	if follower := rt.Follower(); follower != nil {
+		chain = chain.Append(middleware.NewFollower(follower))
+	}
+	if node := rt.Cluster(); node != nil {
+		chain = chain.Append(middleware.NewCluster(node, cfg.GetBool("cluster.redirect")))
+	}
+	if cache := rt.Cache(); cache != nil {
+		chain = chain.Append(middleware.NewCache(cache, "/kv/", suffixes, middleware.ReservedKeys(swaggerSpec, "/kv/")))
+	}
 
-	server.SetHandler(handler)
+	server.SetHandler(chain.Then(inner))
+
+	if clusterOpts.Join != "" {
+		go rt.JoinCluster(clusterOpts.Join, self)
+	}
 
 	if err := server.Serve(); err != nil {
 		log.Fatalln(err)
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/swag"
+)
+
+// newPeers creates kvstored processes that are all peers of each other
+func newPeers(t *testing.T, ids ...string) []*kvstored {
+	peers := make([]*kvstored, len(ids))
+	for i, id := range ids {
+		peers[i] = newKvstored(t, fmt.Sprintf("store:\n  node_id: %s\nreplication:\n  poll_interval: 50ms\n", id))
+	}
+	for _, p := range peers {
+		for _, other := range peers {
+			if other != p {
+				p.args = append(p.args, "--peer", other.url)
+			}
+		}
+	}
+	return peers
+}
+
+// waitForValue waits until every peer has the value at key, an empty value waits until none of them has the key
+func waitForValue(t *testing.T, clients []*client.KvStore, key, value string) {
+	waitFor(t, fmt.Sprintf("%s to be %q everywhere", key, value), func() bool {
+		for _, c := range clients {
+			entry, err := c.Get(key, 0)
+			if value == "" {
+				if err != client.ErrNotFound {
+					return false
+				}
+				continue
+			}
+			if err != nil || string(entry.Data) != value {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// lastWrite returns the time and the origin of the write of the entry at key
+func lastWrite(t *testing.T, c *client.KvStore, key string) (int64, string) {
+	keys, err := c.MerkleKeys([]int{persist.MerkleBucket(key)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range keys {
+		if swag.StringValue(k.Key) == key {
+			return swag.Int64Value(k.LastUpdated), k.Origin
+		}
+	}
+	t.Fatalf("%s is not in its bucket", key)
+	return 0, ""
+}
+
+func TestPeers(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	peers := newPeers(t, "n1", "n2", "n3")
+	clients := make([]*client.KvStore, len(peers))
+	for i, p := range peers {
+		p.start(t, bin)
+		clients[i] = p.client(t)
+	}
+
+	// every peer takes writes
+	for i, c := range clients {
+		key := fmt.Sprintf("own/%d", i)
+		if err := c.Put(key, &client.Entry{Data: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range clients {
+		key := fmt.Sprintf("own/%d", i)
+		waitForValue(t, clients, key, key)
+	}
+
+	// the peers update the same entry at the same time, they all end up with the last write
+	if err := clients[0].Put("shared", &client.Entry{Data: []byte("first")}); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, clients, "shared", "first")
+	version := make([]uint64, len(clients))
+	for i, c := range clients {
+		entry, err := c.Get("shared", 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		version[i] = entry.Version
+	}
+	for i, c := range clients {
+		if err := c.Put("shared", &client.Entry{Data: []byte(fmt.Sprintf("from n%d", i+1)), Version: version[i]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var winner string
+	waitFor(t, "shared to converge", func() bool {
+		winner = ""
+		for _, c := range clients {
+			entry, err := c.Get("shared", 0)
+			if err != nil || (winner != "" && string(entry.Data) != winner) {
+				return false
+			}
+			winner = string(entry.Data)
+		}
+		return true
+	})
+	if _, origin := lastWrite(t, clients[0], "shared"); winner != "from "+origin {
+		t.Errorf("the peers kept %q, which was not written on %s", winner, origin)
+	}
+
+	// a delete wins over the older writes a peer merges after it
+	if err := clients[0].Put("doomed", &client.Entry{Data: []byte("alive")}); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, clients, "doomed", "alive")
+	peers[1].stop()
+	entry, err := clients[0].Get("doomed", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := clients[0].Put("doomed", &client.Entry{Data: []byte("updated"), Version: entry.Version}); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, []*client.KvStore{clients[2]}, "doomed", "updated")
+	if err := clients[2].Delete("doomed"); err != nil {
+		t.Fatal(err)
+	}
+	waitForValue(t, []*client.KvStore{clients[0]}, "doomed", "")
+	peers[1].start(t, bin)
+	waitForValue(t, clients, "doomed", "")
+
+	// the peers have the same entries
+	waitFor(t, "the merkle roots to match", func() bool {
+		var root uint64
+		for i, c := range clients {
+			level, err := c.MerkleLevel(0, nil)
+			if err != nil || len(level.Nodes) != 1 {
+				return false
+			}
+			if i > 0 && swag.Uint64Value(level.Nodes[0].Hash) != root {
+				return false
+			}
+			root = swag.Uint64Value(level.Nodes[0].Hash)
+		}
+		return true
+	})
+
+	status, err := clients[1].ReplicationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swag.StringValue(status.Role) != "peer" || status.NodeID != "n2" || len(status.Peers) != 2 {
+		t.Errorf("the peer reports %+v", status)
+	}
+}
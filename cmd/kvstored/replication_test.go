@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/swag"
+)
+
+// kvstored is a kvstored process that runs in its own directory
+type kvstored struct {
+	dir  string
+	url  string
+	port int
+	args []string
+	cmd  *exec.Cmd
+}
+
+func buildKvstored(t *testing.T) string {
+	bin := filepath.Join(t.TempDir(), "kvstored")
+	out, err := exec.Command("go", "build", "-o", bin, ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("building kvstored failed: %v\n%s", err, out)
+	}
+	return bin
+}
+
+func freePort(t *testing.T) int {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func newKvstored(t *testing.T, config string, args ...string) *kvstored {
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(filepath.Join(dir, "config.yml"), []byte(config), 0600); err != nil {
+		t.Fatal(err)
+	}
+	port := freePort(t)
+	return &kvstored{
+		dir:  dir,
+		url:  fmt.Sprintf("http://127.0.0.1:%d", port),
+		port: port,
+		args: args,
+	}
+}
+
+func (k *kvstored) start(t *testing.T, bin string) {
+	args := append([]string{"--host", "127.0.0.1", "--port", fmt.Sprint(k.port)}, k.args...)
+	k.cmd = exec.Command(bin, args...)
+	k.cmd.Dir = k.dir
+	log, err := os.Create(filepath.Join(k.dir, "log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	k.cmd.Stdout, k.cmd.Stderr = log, log
+	if err := k.cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(k.stop)
+
+	waitFor(t, k.url+" to start", func() bool {
+		res, err := http.Get(k.url + "/health")
+		if err != nil {
+			return false
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusOK
+	})
+}
+
+func (k *kvstored) stop() {
+	if k.cmd == nil {
+		return
+	}
+	_ = k.cmd.Process.Kill()
+	_ = k.cmd.Wait()
+	k.cmd = nil
+}
+
+func (k *kvstored) client(t *testing.T) *client.KvStore {
+	c, err := client.New(k.url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	deadline := time.Now().Add(10 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func waitForKeys(t *testing.T, c *client.KvStore, expected []string) {
+	var keys []string
+	waitFor(t, fmt.Sprintf("the keys %v", expected), func() bool {
+		var err error
+		keys, err = c.FindKeys("")
+		return err == nil && reflect.DeepEqual(keys, expected)
+	})
+}
+
+func TestReplication(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	primary := newKvstored(t, "")
+	primary.start(t, bin)
+	pc := primary.client(t)
+	for _, key := range []string{"a/1", "a/2", "b/1"} {
+		entry := &client.Entry{Data: []byte("value of " + key), Labels: map[string]string{"tier": "gold"}}
+		if err := pc.Put(key, entry); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	follower := newKvstored(t, "replication:\n  poll_interval: 50ms\n", "--follow", primary.url)
+	follower.start(t, bin)
+	fc := follower.client(t)
+
+	// the entries that existed before come with the snapshot
+	waitForKeys(t, fc, []string{"a/1", "a/2", "b/1"})
+	entry, err := fc.Get("a/2", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected, err := pc.Get("a/2", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(entry.Data) != "value of a/2" || entry.Version != expected.Version || entry.Labels["tier"] != "gold" {
+		t.Errorf("the follower has %+v instead of %+v", entry, expected)
+	}
+
+	// the changes after that come with the changelog
+	if err := pc.Put("c/1", &client.Entry{Data: []byte("new")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := pc.Delete("a/1"); err != nil {
+		t.Fatal(err)
+	}
+	waitForKeys(t, fc, []string{"a/2", "b/1", "c/1"})
+	if keys, err := fc.SelectKeys("", "tier=gold"); err != nil || !reflect.DeepEqual(keys, []string{"a/2", "b/1"}) {
+		t.Errorf("the labels on the follower select %v, %v", keys, err)
+	}
+
+	res, err := http.Get(follower.url + "/kv/c%2F1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res.Body.Close()
+	if res.Header.Get("X-Staleness") == "" {
+		t.Error("the reads of the follower don't say how stale they are")
+	}
+
+	if err := fc.Put("d/1", &client.Entry{Data: []byte("rejected")}); err == nil {
+		t.Error("the follower accepted a write")
+	}
+
+	// the data that is not in the changelog is served by the primary
+	for _, path := range []string{"/hashes/h", "/zsets/z/_range", "/queues/q/_peek", "/locks/l", "/indexes"} {
+		res, err := http.Get(follower.url + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusNotImplemented || res.Header.Get("X-Primary") != primary.url {
+			t.Errorf("reading %s on the follower got %s", path, res.Status)
+		}
+	}
+
+	status, err := fc.ReplicationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	primaryStatus, err := pc.ReplicationStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if swag.StringValue(status.Role) != "follower" || status.Primary != primary.url || status.Lag != 0 ||
+		swag.Uint64Value(status.Sequence) != swag.Uint64Value(primaryStatus.Sequence) {
+		t.Errorf("the follower reports %+v while the primary is at %d", status, swag.Uint64Value(primaryStatus.Sequence))
+	}
+}
+
+func TestReplicationAfterPrune(t *testing.T) {
+	if testing.Short() {
+		t.Skip("starts kvstored processes")
+	}
+	bin := buildKvstored(t)
+
+	primary := newKvstored(t, "store:\n  changes_max: 2\n")
+	primary.start(t, bin)
+	pc := primary.client(t)
+	if err := pc.Put("a", &client.Entry{Data: []byte("a")}); err != nil {
+		t.Fatal(err)
+	}
+
+	follower := newKvstored(t, "replication:\n  poll_interval: 50ms\n", "--follow", primary.url)
+	follower.start(t, bin)
+	fc := follower.client(t)
+	waitForKeys(t, fc, []string{"a"})
+
+	// the primary prunes the changes the follower missed when it starts again
+	follower.stop()
+	for _, key := range []string{"b", "c", "d", "e"} {
+		if err := pc.Put(key, &client.Entry{Data: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := pc.Delete("a"); err != nil {
+		t.Fatal(err)
+	}
+	primary.stop()
+	primary.start(t, bin)
+	if _, err := pc.Changelog(2, 0); err != client.ErrChangesPruned {
+		t.Fatalf("the changes the follower needs weren't pruned: %v", err)
+	}
+
+	follower.start(t, bin)
+	waitForKeys(t, fc, []string{"b", "c", "d", "e"})
+}
@@ -4,3 +4,4 @@ package kvstore
 //go:generate swagger generate client -A kvstore -t gen -f ./swagger/swagger.yml
 //go:generate swagger generate server --exclude-main -A kvstore -t gen -f ./swagger/swagger.yml
 //go:generate msgp -file ./persist/types.go
+//go:generate msgp -file ./cluster/types.go
@@ -0,0 +1,418 @@
+// Package filter evaluates filter expressions and projections over JSON documents.
+//
+// A filter expression compares the values that JSON pointers point at with JSON literals:
+//
+//	/city = "paris" and (/age >= 18 or exists /admin) and not /role in ("guest", "banned")
+//
+// The comparisons are =, !=, <, <=, > and >=, numbers compare as numbers and strings compare by bytes.
+// A comparison with a value that doesn't exist or has a different type never matches, use not to negate one.
+package filter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// InvalidFilterError is returned when the filter expression or the fields are malformed
+type InvalidFilterError struct {
+	Reason string
+}
+
+func (e *InvalidFilterError) Error() string {
+	return "invalid filter: " + e.Reason
+}
+
+func invalidFilter(format string, args ...interface{}) error {
+	return &InvalidFilterError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// Decode decodes a JSON document, the numbers are kept as they were written
+func Decode(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var result interface{}
+	if err := dec.Decode(&result); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, errors.New("unexpected data after the JSON value")
+	}
+	return result, nil
+}
+
+// Filter is a parsed filter expression
+type Filter struct {
+	root node
+}
+
+// Parse a filter expression
+func Parse(expr string) (*Filter, error) {
+	p := &parser{lex: lexer{input: expr}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, invalidFilter("unexpected %q at offset %d", p.tok.text, p.tok.pos)
+	}
+	return &Filter{root: root}, nil
+}
+
+// Match reports if the decoded document matches the filter
+func (f *Filter) Match(doc interface{}) bool {
+	return f.root.match(doc)
+}
+
+type node interface {
+	match(doc interface{}) bool
+}
+
+type andNode struct {
+	left, right node
+}
+
+func (n *andNode) match(doc interface{}) bool {
+	return n.left.match(doc) && n.right.match(doc)
+}
+
+type orNode struct {
+	left, right node
+}
+
+func (n *orNode) match(doc interface{}) bool {
+	return n.left.match(doc) || n.right.match(doc)
+}
+
+type notNode struct {
+	expr node
+}
+
+func (n *notNode) match(doc interface{}) bool {
+	return !n.expr.match(doc)
+}
+
+type existsNode struct {
+	pointer jsonpointer.Pointer
+}
+
+func (n *existsNode) match(doc interface{}) bool {
+	_, ok := Get(doc, n.pointer)
+	return ok
+}
+
+type compareNode struct {
+	pointer jsonpointer.Pointer
+	op      string
+	literal interface{}
+}
+
+func (n *compareNode) match(doc interface{}) bool {
+	value, ok := Get(doc, n.pointer)
+	if !ok {
+		return false
+	}
+	cmp, ok := compare(value, n.literal)
+	if !ok {
+		return false
+	}
+	switch n.op {
+	case "=":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+type inNode struct {
+	pointer  jsonpointer.Pointer
+	literals []interface{}
+}
+
+func (n *inNode) match(doc interface{}) bool {
+	value, ok := Get(doc, n.pointer)
+	if !ok {
+		return false
+	}
+	for _, literal := range n.literals {
+		if cmp, ok := compare(value, literal); ok && cmp == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Get returns the value the pointer points at in the decoded document. Unlike the Get of the pointer
+// this finds the members that are null.
+func Get(doc interface{}, ptr jsonpointer.Pointer) (interface{}, bool) {
+	for _, token := range ptr.DecodedTokens() {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			child, ok := node[token]
+			if !ok {
+				return nil, false
+			}
+			doc = child
+		case []interface{}:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, false
+			}
+			doc = node[idx]
+		default:
+			return nil, false
+		}
+	}
+	return doc, true
+}
+
+// compare orders a value from a document against a literal, ok is false when they can't be compared.
+// Booleans and null only compare as equal or not equal.
+func compare(value, literal interface{}) (int, bool) {
+	switch l := literal.(type) {
+	case json.Number:
+		v, ok := value.(json.Number)
+		if !ok {
+			return 0, false
+		}
+		a, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		b, _ := l.Float64()
+		switch {
+		case a < b:
+			return -1, true
+		case a > b:
+			return 1, true
+		}
+		return 0, true
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return 0, false
+		}
+		return strings.Compare(v, l), true
+	case bool:
+		v, ok := value.(bool)
+		if !ok {
+			return 0, false
+		}
+		if v == l {
+			return 0, true
+		}
+		return 1, true
+	case nil:
+		if value == nil {
+			return 0, true
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+type parser struct {
+	lex lexer
+	tok token
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) isKeyword(word string) bool {
+	return p.tok.kind == tokenWord && p.tok.text == word
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("or") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("and") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	switch {
+	case p.isKeyword("not"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &notNode{expr: expr}, nil
+
+	case p.isKeyword("exists"):
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		ptr, err := p.parsePointer()
+		if err != nil {
+			return nil, err
+		}
+		return &existsNode{pointer: ptr}, nil
+
+	case p.tok.kind == tokenLParen:
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return expr, nil
+	}
+
+	ptr, err := p.parsePointer()
+	if err != nil {
+		return nil, err
+	}
+	if p.isKeyword("in") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		literals, err := p.parseList()
+		if err != nil {
+			return nil, err
+		}
+		return &inNode{pointer: ptr, literals: literals}, nil
+	}
+	if p.tok.kind != tokenOperator {
+		return nil, p.unexpected("a comparison")
+	}
+	op := p.tok.text
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	literal, err := p.parseLiteral()
+	if err != nil {
+		return nil, err
+	}
+	return &compareNode{pointer: ptr, op: op, literal: literal}, nil
+}
+
+func (p *parser) parsePointer() (jsonpointer.Pointer, error) {
+	if p.tok.kind != tokenPointer {
+		return jsonpointer.Pointer{}, p.unexpected("a JSON pointer")
+	}
+	ptr, err := jsonpointer.New(p.tok.text)
+	if err != nil {
+		return jsonpointer.Pointer{}, invalidFilter("%v at offset %d", err, p.tok.pos)
+	}
+	return ptr, p.advance()
+}
+
+func (p *parser) parseList() ([]interface{}, error) {
+	if err := p.expect(tokenLParen, "("); err != nil {
+		return nil, err
+	}
+	var literals []interface{}
+	for {
+		literal, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		literals = append(literals, literal)
+		if p.tok.kind != tokenComma {
+			break
+		}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+	return literals, p.expect(tokenRParen, ")")
+}
+
+func (p *parser) parseLiteral() (interface{}, error) {
+	var literal interface{}
+	switch {
+	case p.tok.kind == tokenString:
+		var s string
+		if err := json.Unmarshal([]byte(p.tok.text), &s); err != nil {
+			return nil, invalidFilter("malformed string at offset %d", p.tok.pos)
+		}
+		literal = s
+	case p.tok.kind == tokenNumber:
+		if _, err := strconv.ParseFloat(p.tok.text, 64); err != nil {
+			return nil, invalidFilter("malformed number at offset %d", p.tok.pos)
+		}
+		literal = json.Number(p.tok.text)
+	case p.isKeyword("true"):
+		literal = true
+	case p.isKeyword("false"):
+		literal = false
+	case p.isKeyword("null"):
+		literal = nil
+	default:
+		return nil, p.unexpected("a string, number, true, false or null")
+	}
+	return literal, p.advance()
+}
+
+func (p *parser) expect(kind tokenKind, text string) error {
+	if p.tok.kind != kind {
+		return p.unexpected(strconv.Quote(text))
+	}
+	return p.advance()
+}
+
+func (p *parser) unexpected(expected string) error {
+	if p.tok.kind == tokenEOF {
+		return invalidFilter("expected %s at the end", expected)
+	}
+	return invalidFilter("expected %s at offset %d, got %q", expected, p.tok.pos, p.tok.text)
+}
@@ -0,0 +1,193 @@
+package filter
+
+import "testing"
+
+const person = `{
+	"name": "ann",
+	"age": 30,
+	"score": 1.5e2,
+	"admin": false,
+	"manager": null,
+	"city": "paris",
+	"tags": ["a", "b"],
+	"address": {"zip": "75001", "a/b": 1, "m~n": 2},
+	"quote": "say \"hi\""
+}`
+
+func TestMatch(t *testing.T) {
+	doc, err := Decode([]byte(person))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		// comparisons
+		{`/name = "ann"`, true},
+		{`/name != "ann"`, false},
+		{`/name < "bob"`, true},
+		{`/name >= "bob"`, false},
+		{`/age = 30`, true},
+		{`/age = 30.0`, true},
+		{`/age = 3e1`, true},
+		{`/age > 29.5`, true},
+		{`/age <= -1`, false},
+		{`/score = 150`, true},
+		{`/admin = false`, true},
+		{`/admin != true`, true},
+		{`/manager = null`, true},
+		{`/tags/1 = "b"`, true},
+		{`/address/zip = "75001"`, true},
+
+		// quoting
+		{`/quote = "say \"hi\""`, true},
+		{`/name = "ann"`, true},
+		{`/city = "par"`, false},
+
+		// escaped pointers
+		{`/address/a~1b = 1`, true},
+		{`/address/m~0n = 2`, true},
+		{`/address/a/b = 1`, false},
+
+		// type mismatches never match, whatever the operator
+		{`/age = "30"`, false},
+		{`/age != "30"`, false},
+		{`/name > 1`, false},
+		{`/admin = 0`, false},
+		{`/admin < true`, false},
+		{`/manager != 1`, false},
+		{`/tags = "a"`, false},
+		{`/address != null`, false},
+
+		// missing values never match
+		{`/missing = null`, false},
+		{`/missing != 1`, false},
+		{`not /missing = 1`, true},
+
+		// exists and in
+		{`exists /manager`, true},
+		{`exists /missing`, false},
+		{`exists /tags/1`, true},
+		{`exists /tags/2`, false},
+		{`/city in ("london", "paris")`, true},
+		{`/city in ("london")`, false},
+		{`/age in (1, "30", 30)`, true},
+		{`/admin in (true, null)`, false},
+
+		// precedence, and binds tighter than or and not binds tighter than both
+		{`/age = 1 or /age = 30 and /city = "paris"`, true},
+		{`/age = 30 or /age = 1 and /city = "rome"`, true},
+		{`(/age = 30 or /age = 1) and /city = "rome"`, false},
+		{`not /age = 30 or /city = "paris"`, true},
+		{`not (/age = 30 or /city = "paris")`, false},
+		{`not /age = 30 and /city = "paris"`, false},
+		{`not not /age = 30`, true},
+		{`/age = 30 and /city = "paris" and not exists /missing`, true},
+		{`/age = 1 or /age = 2 or /age = 30`, true},
+
+		// whitespace is optional between tokens
+		{`(/age=30)and(/city!="rome")`, true},
+		{"\t/age\n>=\r30 ", true},
+	}
+	for _, tt := range tests {
+		f, err := Parse(tt.expr)
+		if err != nil {
+			t.Errorf("%s: %v", tt.expr, err)
+			continue
+		}
+		if got := f.Match(doc); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestMatchDocuments(t *testing.T) {
+	f, err := Parse(`/ = 1 or /0 = "x"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for doc, want := range map[string]bool{
+		`1`:       false,
+		`{"":1}`:  true,
+		`["x"]`:   true,
+		`"x"`:     false,
+		`[]`:      false,
+		`{"0":2}`: false,
+	} {
+		value, err := Decode([]byte(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := f.Match(value); got != want {
+			t.Errorf("%s: got %v, want %v", doc, got, want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`   `,
+		`/a`,
+		`/a =`,
+		`/a == 1`,
+		`/a ! 1`,
+		`/a = 'x'`,
+		`/a = "x`,
+		`/a = "\q"`,
+		`/a = 1.2.3`,
+		`/a = -`,
+		`/a = nil`,
+		`/a = /b`,
+		`a = 1`,
+		`/a = 1 and`,
+		`/a = 1 or or /b = 2`,
+		`/a = 1 /b = 2`,
+		`(/a = 1`,
+		`/a = 1)`,
+		`()`,
+		`not`,
+		`exists`,
+		`exists a`,
+		`/a in 1`,
+		`/a in ()`,
+		`/a in (1,)`,
+		`/a in (1 2)`,
+		`/a in ("x"`,
+		`/a = 1 AND /b = 2`,
+		`/a = TRUE`,
+		`/a = 1 # comment`,
+	}
+	for _, expr := range tests {
+		f, err := Parse(expr)
+		if err == nil {
+			t.Errorf("%q: parsed as %+v", expr, f.root)
+			continue
+		}
+		if _, ok := err.(*InvalidFilterError); !ok {
+			t.Errorf("%q: got %v, want an invalid filter", expr, err)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	if _, err := Decode([]byte(`{"a":1} {}`)); err == nil {
+		t.Error("a document with trailing data was decoded")
+	}
+	if _, err := Decode([]byte(`not json`)); err == nil {
+		t.Error("a document that isn't JSON was decoded")
+	}
+	doc, err := Decode([]byte(`{"a":12345678901234567890}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := Parse(`/a = 12345678901234567890`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.Match(doc) {
+		t.Error("a large integer didn't match itself")
+	}
+}
@@ -0,0 +1,99 @@
+package filter
+
+import "strings"
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenPointer
+	tokenString
+	tokenNumber
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// pointerStop are the characters that end a JSON pointer in a filter expression
+const pointerStop = " \t\r\n()=!<>,"
+
+type lexer struct {
+	input string
+	pos   int
+}
+
+func (l *lexer) next() (token, error) {
+	for l.pos < len(l.input) && strings.IndexByte(" \t\r\n", l.input[l.pos]) >= 0 {
+		l.pos++
+	}
+	start := l.pos
+	if start == len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	c := l.input[start]
+	switch {
+	case c == '(':
+		l.pos++
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case c == ',':
+		l.pos++
+		return token{kind: tokenComma, text: ",", pos: start}, nil
+
+	case c == '=' || c == '!' || c == '<' || c == '>':
+		l.pos++
+		if l.pos < len(l.input) && l.input[l.pos] == '=' {
+			l.pos++
+		}
+		op := l.input[start:l.pos]
+		if op == "!" || op == "==" {
+			return token{}, invalidFilter("unknown operator %q at offset %d", op, start)
+		}
+		return token{kind: tokenOperator, text: op, pos: start}, nil
+
+	case c == '/':
+		for l.pos < len(l.input) && strings.IndexByte(pointerStop, l.input[l.pos]) < 0 {
+			l.pos++
+		}
+		return token{kind: tokenPointer, text: l.input[start:l.pos], pos: start}, nil
+
+	case c == '"':
+		l.pos++
+		for l.pos < len(l.input) && l.input[l.pos] != '"' {
+			if l.input[l.pos] == '\\' {
+				l.pos++
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.input) {
+			return token{}, invalidFilter("unterminated string at offset %d", start)
+		}
+		l.pos++
+		return token{kind: tokenString, text: l.input[start:l.pos], pos: start}, nil
+
+	case c == '-' || (c >= '0' && c <= '9'):
+		l.pos++
+		for l.pos < len(l.input) && strings.IndexByte("0123456789.eE+-", l.input[l.pos]) >= 0 {
+			l.pos++
+		}
+		return token{kind: tokenNumber, text: l.input[start:l.pos], pos: start}, nil
+
+	case c >= 'a' && c <= 'z':
+		for l.pos < len(l.input) && l.input[l.pos] >= 'a' && l.input[l.pos] <= 'z' {
+			l.pos++
+		}
+		return token{kind: tokenWord, text: l.input[start:l.pos], pos: start}, nil
+	}
+	return token{}, invalidFilter("unexpected %q at offset %d", c, start)
+}
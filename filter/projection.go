@@ -0,0 +1,54 @@
+package filter
+
+import (
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+)
+
+// Projection picks the fields to keep from a JSON document
+type Projection struct {
+	fields []jsonpointer.Pointer
+}
+
+// ParseFields parses a comma separated list of JSON pointers
+func ParseFields(fields string) (*Projection, error) {
+	proj := new(Projection)
+	for _, field := range strings.Split(fields, ",") {
+		ptr, err := jsonpointer.New(strings.TrimSpace(field))
+		if err != nil {
+			return nil, invalidFilter("field %q: %v", field, err)
+		}
+		proj.fields = append(proj.fields, ptr)
+	}
+	return proj, nil
+}
+
+// Apply copies the values at the fields into a new document at the same place, the objects and arrays
+// that lead up to a field become objects. The fields that don't exist in the document are left out.
+func (p *Projection) Apply(doc interface{}) interface{} {
+	result := make(map[string]interface{})
+	for _, ptr := range p.fields {
+		value, ok := Get(doc, ptr)
+		if !ok {
+			continue
+		}
+		tokens := ptr.DecodedTokens()
+		if len(tokens) == 0 {
+			// the pointer to the whole document
+			return doc
+		}
+
+		target := result
+		for _, tok := range tokens[:len(tokens)-1] {
+			next, ok := target[tok].(map[string]interface{})
+			if !ok {
+				next = make(map[string]interface{})
+				target[tok] = next
+			}
+			target = next
+		}
+		target[tokens[len(tokens)-1]] = value
+	}
+	return result
+}
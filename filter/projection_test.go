@@ -0,0 +1,59 @@
+package filter
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestProjection(t *testing.T) {
+	tests := []struct {
+		fields, doc, want string
+	}{
+		{`/a`, `{"a":1,"b":2}`, `{"a":1}`},
+		{`/a,/b`, `{"a":1,"b":2,"c":3}`, `{"a":1,"b":2}`},
+		{` /a , /c `, `{"a":1,"b":2,"c":3}`, `{"a":1,"c":3}`},
+		{`/a/b`, `{"a":{"b":1,"c":2},"d":3}`, `{"a":{"b":1}}`},
+		{`/a/b,/a/c`, `{"a":{"b":1,"c":2,"d":3}}`, `{"a":{"b":1,"c":2}}`},
+		{`/a`, `{"a":{"b":[1,{"c":2}]}}`, `{"a":{"b":[1,{"c":2}]}}`},
+		{`/a/1`, `{"a":["x","y"]}`, `{"a":{"1":"y"}}`},
+		{`/a~1b,/c~0d`, `{"a/b":1,"c~d":2,"e":3}`, `{"a/b":1,"c~d":2}`},
+		{`/missing`, `{"a":1}`, `{}`},
+		{`/a,/missing/b`, `{"a":1}`, `{"a":1}`},
+		{`/a/b`, `{"a":1}`, `{}`},
+		{`/a,/b/c`, `{"a":null,"b":{"c":null}}`, `{"a":null,"b":{"c":null}}`},
+		{`/a`, `[1,2]`, `{}`},
+		{`/0`, `[1,2]`, `{"0":1}`},
+		{`/a/b,/a`, `{"a":{"b":1,"c":2}}`, `{"a":{"b":1,"c":2}}`},
+		{``, `{"a":1}`, `{"a":1}`},
+		{`/a,`, `[1]`, `[1]`},
+		{`/a,`, `{"a":1}`, `{"a":1}`},
+	}
+	for _, tt := range tests {
+		proj, err := ParseFields(tt.fields)
+		if err != nil {
+			t.Errorf("%q: %v", tt.fields, err)
+			continue
+		}
+		doc, err := Decode([]byte(tt.doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := json.Marshal(proj.Apply(doc))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(got) != tt.want {
+			t.Errorf("%q of %s: got %s, want %s", tt.fields, tt.doc, got, tt.want)
+		}
+	}
+}
+
+func TestParseFieldsErrors(t *testing.T) {
+	for _, fields := range []string{`a`, `/a,b`, `/a,~`} {
+		if _, err := ParseFields(fields); err == nil {
+			t.Errorf("%q: parsed", fields)
+		} else if _, ok := err.(*InvalidFilterError); !ok {
+			t.Errorf("%q: got %v, want an invalid filter", fields, err)
+		}
+	}
+}
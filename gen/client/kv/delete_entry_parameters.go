@@ -68,7 +68,7 @@ type DeleteEntryParams struct {
 	*/
 	XRequestID *string
 	/*Key
-	  The key for a given entry
+	  The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key
 
 	*/
 	Key string
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -67,8 +68,38 @@ type FindKeysParams struct {
 
 	*/
 	XRequestID *string
+	/*Delimiter
+	  groups the keys below the prefix by the first occurrence of the delimiter
+
+	*/
+	Delimiter *string
+	/*Fields
+	  lists the entries that hold a JSON document instead of the keys, a comma separated list of JSON pointers, the values only hold these fields at the same place
+
+	*/
+	Fields *string
+	/*Filter
+	  lists the entries that hold a JSON document and match this expression instead of the keys, it compares the values JSON pointers point at with JSON literals using =, !=, <, <=, >, >=, in (...) and exists, combined with and, or, not and parentheses. For example /city = "paris" and (/age >= 18 or exists /admin). The filter is evaluated while iterating over the entries, the entries that aren't JSON documents are skipped
+
+	*/
+	Filter *string
+	/*Limit
+	  the maximum number of entries to list when a filter or fields are given
+
+	*/
+	Limit *int64
+	/*ModifiedSince
+	  only lists the keys of the entries that were written at or after this revision or RFC 3339 time
+
+	*/
+	ModifiedSince *string
 	/*Prefix*/
 	Prefix *string
+	/*Selector
+	  only lists the keys of the entries with labels that match the selector, a comma separated list of name, !name, name=value, name!=value, name in (values) and name notin (values) requirements. For example env=prod,tier!=cache
+
+	*/
+	Selector *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -119,6 +150,61 @@ func (o *FindKeysParams) SetXRequestID(xRequestID *string) {
 	o.XRequestID = xRequestID
 }
 
+// WithDelimiter adds the delimiter to the find keys params
+func (o *FindKeysParams) WithDelimiter(delimiter *string) *FindKeysParams {
+	o.SetDelimiter(delimiter)
+	return o
+}
+
+// SetDelimiter adds the delimiter to the find keys params
+func (o *FindKeysParams) SetDelimiter(delimiter *string) {
+	o.Delimiter = delimiter
+}
+
+// WithFields adds the fields to the find keys params
+func (o *FindKeysParams) WithFields(fields *string) *FindKeysParams {
+	o.SetFields(fields)
+	return o
+}
+
+// SetFields adds the fields to the find keys params
+func (o *FindKeysParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
+// WithFilter adds the filter to the find keys params
+func (o *FindKeysParams) WithFilter(filter *string) *FindKeysParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the find keys params
+func (o *FindKeysParams) SetFilter(filter *string) {
+	o.Filter = filter
+}
+
+// WithLimit adds the limit to the find keys params
+func (o *FindKeysParams) WithLimit(limit *int64) *FindKeysParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the find keys params
+func (o *FindKeysParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithModifiedSince adds the modifiedSince to the find keys params
+func (o *FindKeysParams) WithModifiedSince(modifiedSince *string) *FindKeysParams {
+	o.SetModifiedSince(modifiedSince)
+	return o
+}
+
+// SetModifiedSince adds the modifiedSince to the find keys params
+func (o *FindKeysParams) SetModifiedSince(modifiedSince *string) {
+	o.ModifiedSince = modifiedSince
+}
+
 // WithPrefix adds the prefix to the find keys params
 func (o *FindKeysParams) WithPrefix(prefix *string) *FindKeysParams {
 	o.SetPrefix(prefix)
@@ -130,6 +216,17 @@ func (o *FindKeysParams) SetPrefix(prefix *string) {
 	o.Prefix = prefix
 }
 
+// WithSelector adds the selector to the find keys params
+func (o *FindKeysParams) WithSelector(selector *string) *FindKeysParams {
+	o.SetSelector(selector)
+	return o
+}
+
+// SetSelector adds the selector to the find keys params
+func (o *FindKeysParams) SetSelector(selector *string) {
+	o.Selector = selector
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *FindKeysParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -147,6 +244,86 @@ func (o *FindKeysParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 
 	}
 
+	if o.Delimiter != nil {
+
+		// query param delimiter
+		var qrDelimiter string
+		if o.Delimiter != nil {
+			qrDelimiter = *o.Delimiter
+		}
+		qDelimiter := qrDelimiter
+		if qDelimiter != "" {
+			if err := r.SetQueryParam("delimiter", qDelimiter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Filter != nil {
+
+		// query param filter
+		var qrFilter string
+		if o.Filter != nil {
+			qrFilter = *o.Filter
+		}
+		qFilter := qrFilter
+		if qFilter != "" {
+			if err := r.SetQueryParam("filter", qFilter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.ModifiedSince != nil {
+
+		// query param modifiedSince
+		var qrModifiedSince string
+		if o.ModifiedSince != nil {
+			qrModifiedSince = *o.ModifiedSince
+		}
+		qModifiedSince := qrModifiedSince
+		if qModifiedSince != "" {
+			if err := r.SetQueryParam("modifiedSince", qModifiedSince); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if o.Prefix != nil {
 
 		// query param prefix
@@ -163,6 +340,22 @@ func (o *FindKeysParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 
 	}
 
+	if o.Selector != nil {
+
+		// query param selector
+		var qrSelector string
+		if o.Selector != nil {
+			qrSelector = *o.Selector
+		}
+		qSelector := qrSelector
+		if qSelector != "" {
+			if err := r.SetQueryParam("selector", qSelector); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
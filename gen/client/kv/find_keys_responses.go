@@ -32,6 +32,13 @@ func (o *FindKeysReader) ReadResponse(response runtime.ClientResponse, consumer
 		}
 		return result, nil
 
+	case 400:
+		result := NewFindKeysBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewFindKeysDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -51,14 +58,14 @@ func NewFindKeysOK() *FindKeysOK {
 
 /*FindKeysOK handles this case with default header values.
 
-list the keys known to this datastore
+list the keys known to this datastore, or the entries in key order with their keys and values when a filter or fields are given
 */
 type FindKeysOK struct {
 	/*The request id this is a response to
 	 */
 	XRequestID string
 
-	Payload []string
+	Payload []interface{}
 }
 
 func (o *FindKeysOK) Error() string {
@@ -78,6 +85,42 @@ func (o *FindKeysOK) readResponse(response runtime.ClientResponse, consumer runt
 	return nil
 }
 
+// NewFindKeysBadRequest creates a FindKeysBadRequest with default headers values
+func NewFindKeysBadRequest() *FindKeysBadRequest {
+	return &FindKeysBadRequest{}
+}
+
+/*FindKeysBadRequest handles this case with default header values.
+
+the selector, the time, the filter or the fields are malformed, or a delimiter is given together with a filter or fields
+*/
+type FindKeysBadRequest struct {
+	/*The request id this is a response to
+	 */
+	XRequestID string
+
+	Payload *models.Error
+}
+
+func (o *FindKeysBadRequest) Error() string {
+	return fmt.Sprintf("[GET /kv][%d] findKeysBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *FindKeysBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response header X-Request-Id
+	o.XRequestID = response.GetHeader("X-Request-Id")
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewFindKeysDefault creates a FindKeysDefault with default headers values
 func NewFindKeysDefault(code int) *FindKeysDefault {
 	return &FindKeysDefault{
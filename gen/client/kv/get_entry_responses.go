@@ -9,7 +9,9 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 
@@ -77,6 +79,12 @@ type GetEntryOK struct {
 	/*The time this entry was last modified
 	 */
 	LastModified string
+	/*The labels of this entry as a comma separated list of name=value pairs
+	 */
+	XLabels string
+	/*The time this entry was last modified in unix nanoseconds, Last-Modified only has minutes
+	 */
+	XLastUpdated int64
 	/*The request id this is a response to
 	 */
 	XRequestID string
@@ -96,6 +104,16 @@ func (o *GetEntryOK) readResponse(response runtime.ClientResponse, consumer runt
 	// response header Last-Modified
 	o.LastModified = response.GetHeader("Last-Modified")
 
+	// response header X-Labels
+	o.XLabels = response.GetHeader("X-Labels")
+
+	// response header X-Last-Updated
+	xLastUpdated, err := swag.ConvertInt64(response.GetHeader("X-Last-Updated"))
+	if err != nil {
+		return errors.InvalidType("X-Last-Updated", "header", "int64", response.GetHeader("X-Last-Updated"))
+	}
+	o.XLastUpdated = xLastUpdated
+
 	// response header X-Request-Id
 	o.XRequestID = response.GetHeader("X-Request-Id")
 
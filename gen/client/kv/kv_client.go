@@ -26,6 +26,68 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+AppendEntry atomically appends the body to the value of the entry, the entry is created when it doesn't exist yet. When the value grows beyond the max size the oldest bytes are trimmed, or the oldest records when a delimiter is given.
+*/
+func (a *Client) AppendEntry(params *AppendEntryParams) (*AppendEntryNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAppendEntryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "appendEntry",
+		Method:             "POST",
+		PathPattern:        "/kv/{key}/_append",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/octet-stream"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AppendEntryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AppendEntryNoContent), nil
+
+}
+
+/*
+CopyEntry copies the entry to the destination key in a single atomic write
+*/
+func (a *Client) CopyEntry(params *CopyEntryParams) (*CopyEntryOK, *CopyEntryCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCopyEntryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "copyEntry",
+		Method:             "POST",
+		PathPattern:        "/kv/{key}/_copy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CopyEntryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *CopyEntryOK:
+		return value, nil, nil
+	case *CopyEntryCreated:
+		return nil, value, nil
+	}
+	return nil, nil, nil
+
+}
+
 /*
 DeleteEntry delete entry API
 */
@@ -55,7 +117,35 @@ func (a *Client) DeleteEntry(params *DeleteEntryParams) (*DeleteEntryNoContent,
 }
 
 /*
-FindKeys lists all the keys
+DeleteKeys deletes all the keys that start with the given prefix
+*/
+func (a *Client) DeleteKeys(params *DeleteKeysParams) (*DeleteKeysOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteKeysParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "deleteKeys",
+		Method:             "DELETE",
+		PathPattern:        "/kv",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteKeysReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteKeysOK), nil
+
+}
+
+/*
+FindKeys lists all the keys, when a delimiter is given the keys that contain the delimiter after the prefix are rolled up into a single common prefix that ends with the delimiter, like a directory listing
 */
 func (a *Client) FindKeys(params *FindKeysParams) (*FindKeysOK, error) {
 	// TODO: Validate the params before sending
@@ -110,6 +200,152 @@ func (a *Client) GetEntry(params *GetEntryParams, writer io.Writer) (*GetEntryOK
 
 }
 
+/*
+GetStats aggregates the number of keys and the sizes of the values that start with the given prefix
+*/
+func (a *Client) GetStats(params *GetStatsParams) (*GetStatsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetStatsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getStats",
+		Method:             "GET",
+		PathPattern:        "/kv/_stats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetStatsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetStatsOK), nil
+
+}
+
+/*
+IncrEntry atomically adds the delta to the counter stored in the entry, when the entry doesn't exist yet the counter starts at the initial value. The counter is stored as a base 10 int64.
+*/
+func (a *Client) IncrEntry(params *IncrEntryParams) (*IncrEntryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewIncrEntryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "incrEntry",
+		Method:             "POST",
+		PathPattern:        "/kv/{key}/_incr",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &IncrEntryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*IncrEntryOK), nil
+
+}
+
+/*
+ListChanges lists the writes and deletes of the entries in the order they happened. The revision of a change is the time it happened in unix nanoseconds, made unique. The changes older than the retention get pruned.
+*/
+func (a *Client) ListChanges(params *ListChangesParams) (*ListChangesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListChangesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listChanges",
+		Method:             "GET",
+		PathPattern:        "/kv/_changes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListChangesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListChangesOK), nil
+
+}
+
+/*
+MoveEntry moves the entry to the destination key, the copy and the delete happen in a single atomic write
+*/
+func (a *Client) MoveEntry(params *MoveEntryParams) (*MoveEntryOK, *MoveEntryCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewMoveEntryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "moveEntry",
+		Method:             "POST",
+		PathPattern:        "/kv/{key}/_move",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &MoveEntryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *MoveEntryOK:
+		return value, nil, nil
+	case *MoveEntryCreated:
+		return nil, value, nil
+	}
+	return nil, nil, nil
+
+}
+
+/*
+PatchEntry applies a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902) to the JSON document in the entry, the content type of the request selects the kind of patch
+*/
+func (a *Client) PatchEntry(params *PatchEntryParams) (*PatchEntryNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchEntryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "patchEntry",
+		Method:             "PATCH",
+		PathPattern:        "/kv/{key}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/merge-patch+json", "application/json-patch+json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchEntryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PatchEntryNoContent), nil
+
+}
+
 /*
 PutEntry put entry API
 */
@@ -144,6 +380,34 @@ func (a *Client) PutEntry(params *PutEntryParams) (*PutEntryCreated, *PutEntryNo
 
 }
 
+/*
+SetLabels replaces the labels of the entry, the value stays the same and the version changes
+*/
+func (a *Client) SetLabels(params *SetLabelsParams) (*SetLabelsNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetLabelsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "setLabels",
+		Method:             "PUT",
+		PathPattern:        "/kv/{key}/_labels",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetLabelsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SetLabelsNoContent), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -76,10 +76,20 @@ type PutEntryParams struct {
 	/*Body*/
 	Body io.ReadCloser
 	/*Key
-	  The key for a given entry
+	  The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key
 
 	*/
 	Key string
+	/*Labels
+	  replaces the labels of the entry with this comma separated list of name=value pairs, updates without labels keep the labels of the entry
+
+	*/
+	Labels *string
+	/*Session
+	  binds the entry to this session, the entry is removed or released when the session expires or gets destroyed. Entries that are bound to a session keep that binding when they are updated without one.
+
+	*/
+	Session *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -163,6 +173,28 @@ func (o *PutEntryParams) SetKey(key string) {
 	o.Key = key
 }
 
+// WithLabels adds the labels to the put entry params
+func (o *PutEntryParams) WithLabels(labels *string) *PutEntryParams {
+	o.SetLabels(labels)
+	return o
+}
+
+// SetLabels adds the labels to the put entry params
+func (o *PutEntryParams) SetLabels(labels *string) {
+	o.Labels = labels
+}
+
+// WithSession adds the session to the put entry params
+func (o *PutEntryParams) WithSession(session *string) *PutEntryParams {
+	o.SetSession(session)
+	return o
+}
+
+// SetSession adds the session to the put entry params
+func (o *PutEntryParams) SetSession(session *string) {
+	o.Session = session
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *PutEntryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -200,6 +232,38 @@ func (o *PutEntryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regi
 		return err
 	}
 
+	if o.Labels != nil {
+
+		// query param labels
+		var qrLabels string
+		if o.Labels != nil {
+			qrLabels = *o.Labels
+		}
+		qLabels := qrLabels
+		if qLabels != "" {
+			if err := r.SetQueryParam("labels", qLabels); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Session != nil {
+
+		// query param session
+		var qrSession string
+		if o.Session != nil {
+			qrSession = *o.Session
+		}
+		qSession := qrSession
+		if qSession != "" {
+			if err := r.SetQueryParam("session", qSession); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -193,7 +193,7 @@ func NewPutEntryConflict() *PutEntryConflict {
 
 /*PutEntryConflict handles this case with default header values.
 
-there is a version mismatch for the entry
+there is a version mismatch for the entry or the entry is bound to another session
 */
 type PutEntryConflict struct {
 	/*The request id this is a response to
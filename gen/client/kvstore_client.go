@@ -11,7 +11,21 @@ import (
 
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/kvstore/gen/client/changelog"
+	"github.com/go-openapi/kvstore/gen/client/cluster"
+	"github.com/go-openapi/kvstore/gen/client/elections"
+	"github.com/go-openapi/kvstore/gen/client/hashes"
+	"github.com/go-openapi/kvstore/gen/client/indexes"
 	"github.com/go-openapi/kvstore/gen/client/kv"
+	"github.com/go-openapi/kvstore/gen/client/locks"
+	"github.com/go-openapi/kvstore/gen/client/merkle"
+	"github.com/go-openapi/kvstore/gen/client/queues"
+	"github.com/go-openapi/kvstore/gen/client/replication"
+	"github.com/go-openapi/kvstore/gen/client/search"
+	"github.com/go-openapi/kvstore/gen/client/semaphores"
+	"github.com/go-openapi/kvstore/gen/client/sequences"
+	"github.com/go-openapi/kvstore/gen/client/sessions"
+	"github.com/go-openapi/kvstore/gen/client/zsets"
 )
 
 // Default kvstore HTTP client.
@@ -57,8 +71,36 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Kvstore {
 	cli := new(Kvstore)
 	cli.Transport = transport
 
+	cli.Changelog = changelog.New(transport, formats)
+
+	cli.Cluster = cluster.New(transport, formats)
+
+	cli.Elections = elections.New(transport, formats)
+
+	cli.Hashes = hashes.New(transport, formats)
+
+	cli.Indexes = indexes.New(transport, formats)
+
 	cli.Kv = kv.New(transport, formats)
 
+	cli.Locks = locks.New(transport, formats)
+
+	cli.Merkle = merkle.New(transport, formats)
+
+	cli.Queues = queues.New(transport, formats)
+
+	cli.Replication = replication.New(transport, formats)
+
+	cli.Search = search.New(transport, formats)
+
+	cli.Semaphores = semaphores.New(transport, formats)
+
+	cli.Sequences = sequences.New(transport, formats)
+
+	cli.Sessions = sessions.New(transport, formats)
+
+	cli.Zsets = zsets.New(transport, formats)
+
 	return cli
 }
 
@@ -103,8 +145,36 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // Kvstore is a client for kvstore
 type Kvstore struct {
+	Changelog *changelog.Client
+
+	Cluster *cluster.Client
+
+	Elections *elections.Client
+
+	Hashes *hashes.Client
+
+	Indexes *indexes.Client
+
 	Kv *kv.Client
 
+	Locks *locks.Client
+
+	Merkle *merkle.Client
+
+	Queues *queues.Client
+
+	Replication *replication.Client
+
+	Search *search.Client
+
+	Semaphores *semaphores.Client
+
+	Sequences *sequences.Client
+
+	Sessions *sessions.Client
+
+	Zsets *zsets.Client
+
 	Transport runtime.ClientTransport
 }
 
@@ -112,6 +182,34 @@ type Kvstore struct {
 func (c *Kvstore) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 
+	c.Changelog.SetTransport(transport)
+
+	c.Cluster.SetTransport(transport)
+
+	c.Elections.SetTransport(transport)
+
+	c.Hashes.SetTransport(transport)
+
+	c.Indexes.SetTransport(transport)
+
 	c.Kv.SetTransport(transport)
 
+	c.Locks.SetTransport(transport)
+
+	c.Merkle.SetTransport(transport)
+
+	c.Queues.SetTransport(transport)
+
+	c.Replication.SetTransport(transport)
+
+	c.Search.SetTransport(transport)
+
+	c.Semaphores.SetTransport(transport)
+
+	c.Sequences.SetTransport(transport)
+
+	c.Sessions.SetTransport(transport)
+
+	c.Zsets.SetTransport(transport)
+
 }
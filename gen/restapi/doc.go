@@ -15,6 +15,8 @@ K/V store is a simple single node store for retrieving key/value information
 
     Consumes:
     - application/json
+    - application/json-patch+json
+    - application/merge-patch+json
     - application/octet-stream
 
     Produces:
@@ -42,28 +42,50 @@ func init() {
     "version": "0.0.1"
   },
   "paths": {
-    "/kv": {
+    "/changelog": {
       "get": {
-        "description": "lists all the keys",
+        "description": "reads the changelog, the writes and deletes of the entries with the entry after each write numbered by a sequence without gaps. A consumer resumes from the next sequence of the last page it read, when the changes it needs were pruned because they are older than the retention this fails instead of skipping them. Only the entries are in the changelog, the changes to hashes, sorted sets, queues, sequences, locks, semaphores and sessions are not.",
         "tags": [
-          "kv"
+          "changelog"
         ],
-        "operationId": "findKeys",
+        "operationId": "readChangelog",
         "parameters": [
           {
-            "type": "string",
-            "name": "prefix",
+            "minimum": 1,
+            "type": "integer",
+            "format": "uint64",
+            "description": "the sequence of the first change to return, without it the changelog is read from the oldest change that is kept",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1000,
+            "description": "the maximum number of changes to return",
+            "name": "limit",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "list the keys known to this datastore",
+            "description": "the changes starting at from",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
+              "$ref": "#/definitions/changelog"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
               }
+            }
+          },
+          "410": {
+            "description": "the changes starting at from were pruned, the consumer needs to read the entries again",
+            "schema": {
+              "$ref": "#/definitions/error"
             },
             "headers": {
               "X-Request-Id": {
@@ -83,122 +105,98 @@ func init() {
         }
       ]
     },
-    "/kv/{key}": {
+    "/cluster": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+        "description": "reports the raft state of this member of the cluster, the leader it knows about and the members of the cluster",
         "tags": [
-          "kv"
-        ],
-        "operationId": "getEntry",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "If-None-Match",
-            "in": "header"
-          }
+          "cluster"
         ],
+        "operationId": "getClusterStatus",
         "responses": {
           "200": {
-            "description": "entry was found",
+            "description": "the cluster status of this member",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/clusterStatus"
             },
             "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "The version of this entry"
-              },
-              "Last-Modified": {
-                "type": "string",
-                "description": "The time this entry was last modified"
-              },
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
-          },
-          "304": {
-            "description": "entry was found but not modified",
-            "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "The version of this entry"
-              },
-              "Last-Modified": {
-                "type": "string",
-                "description": "The time this entry was last modified"
-              },
               "X-Request-Id": {
                 "type": "string",
                 "description": "The request id this is a response to"
               }
             }
           },
-          "404": {
-            "$ref": "#/responses/errorNotFound"
-          },
           "default": {
             "$ref": "#/responses/errorResponse"
           }
         }
       },
-      "put": {
-        "consumes": [
-          "application/octet-stream"
-        ],
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/cluster/members": {
+      "post": {
+        "description": "adds a member to the cluster, the member needs to be started without bootstrapping a cluster of its own. The members get added one at a time and only the leader adds them.",
         "tags": [
-          "kv"
+          "cluster"
         ],
-        "operationId": "putEntry",
+        "operationId": "addClusterMember",
         "parameters": [
-          {
-            "pattern": "[0-9]*",
-            "type": "string",
-            "description": "when this is an update to an entry, then this field needs to be present",
-            "name": "If-Match",
-            "in": "header"
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "string",
-              "format": "binary",
-              "maxLength": 536870912
+              "$ref": "#/definitions/clusterMember"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "entry was created",
+          "204": {
+            "description": "the member was added",
             "headers": {
-              "Etag": {
-                "type": "string",
-                "description": "The version of this entry"
-              },
-              "Location": {
+              "X-Request-Id": {
                 "type": "string",
-                "format": "uri",
-                "description": "the location to get the newly created entry"
-              },
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "a member with this id exists or the previous membership change is not committed yet",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
               "X-Request-Id": {
                 "type": "string",
                 "description": "The request id this is a response to"
               }
             }
           },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/cluster/members/{id}": {
+      "delete": {
+        "description": "removes a member from the cluster, removing the leader makes the others elect a new leader once the removal is committed",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "removeClusterMember",
+        "responses": {
           "204": {
-            "description": "entry was updated",
+            "description": "the member was removed",
             "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "The version of this entry"
-              },
               "X-Request-Id": {
                 "type": "string",
                 "description": "The request id this is a response to"
@@ -209,7 +207,7 @@ func init() {
             "$ref": "#/responses/errorNotFound"
           },
           "409": {
-            "description": "there is a version mismatch for the entry",
+            "description": "the previous membership change is not committed yet",
             "schema": {
               "$ref": "#/definitions/error"
             },
@@ -220,10 +218,112 @@ func init() {
               }
             }
           },
-          "410": {
-            "description": "The entry is deleted",
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "The id of the member",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/elections/{name}": {
+      "get": {
+        "description": "reports the current leader",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "getLeader",
+        "responses": {
+          "200": {
+            "description": "the current leader",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the leadership, the leader stays the leader for another ttl. In cluster mode the elections are not available and this responds with 501.",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "renewLeadership",
+        "parameters": [
+          {
+            "$ref": "#/parameters/lockToken"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "campaigns to become the leader for the ttl, every time the leadership changes the fencing token increases. The leadership is a lease on the clock of the member that serves it, so in cluster mode this responds with 501.",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "campaign",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the candidate that wants to become the leader",
+            "name": "candidate",
+            "in": "query",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/lockTTL"
+          },
+          {
+            "$ref": "#/parameters/lockWait"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the candidate became the leader",
+            "schema": {
+              "$ref": "#/definitions/lock"
             },
             "headers": {
               "X-Request-Id": {
@@ -232,19 +332,28 @@ func init() {
               }
             }
           },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
           "default": {
             "$ref": "#/responses/errorResponse"
           }
         }
       },
       "delete": {
+        "description": "resigns the leadership so another candidate can become the leader. In cluster mode the elections are not available and this responds with 501.",
         "tags": [
-          "kv"
+          "elections"
+        ],
+        "operationId": "resign",
+        "parameters": [
+          {
+            "$ref": "#/parameters/lockToken"
+          }
         ],
-        "operationId": "deleteEntry",
         "responses": {
           "204": {
-            "description": "the delete was successful",
+            "description": "the leader resigned",
             "headers": {
               "X-Request-Id": {
                 "type": "string",
@@ -252,6 +361,9 @@ func init() {
               }
             }
           },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
           "default": {
             "$ref": "#/responses/errorResponse"
           }
@@ -262,131 +374,22 @@ func init() {
           "$ref": "#/parameters/requestId"
         },
         {
-          "$ref": "#/parameters/entryKey"
+          "$ref": "#/parameters/lockName"
         }
       ]
-    }
-  },
-  "definitions": {
-    "error": {
-      "description": "the error model is a model for all the error responses coming from kvstore\n",
-      "type": "object",
-      "required": [
-        "message",
-        "code"
-      ],
-      "properties": {
-        "cause": {
-          "$ref": "#/definitions/error"
-        },
-        "code": {
-          "description": "The error code",
-          "type": "integer",
-          "format": "int64"
-        },
-        "helpUrl": {
-          "description": "link to help page explaining the error in more detail",
-          "type": "string",
-          "format": "uri"
-        },
-        "message": {
-          "description": "The error message",
-          "type": "string"
-        }
-      }
-    }
-  },
-  "parameters": {
-    "entryKey": {
-      "minLength": 1,
-      "type": "string",
-      "description": "The key for a given entry",
-      "name": "key",
-      "in": "path",
-      "required": true
-    },
-    "requestId": {
-      "minLength": 1,
-      "type": "string",
-      "description": "A unique UUID for the request",
-      "name": "X-Request-Id",
-      "in": "header"
-    }
-  },
-  "responses": {
-    "errorNotFound": {
-      "description": "The entry was not found",
-      "schema": {
-        "$ref": "#/definitions/error"
-      },
-      "headers": {
-        "X-Request-Id": {
-          "type": "string",
-          "description": "The request id this is a response to"
-        }
-      }
-    },
-    "errorResponse": {
-      "description": "Error",
-      "schema": {
-        "$ref": "#/definitions/error"
-      },
-      "headers": {
-        "X-Request-Id": {
-          "type": "string",
-          "description": "The request id this is a response to"
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "K/V store is a simple single node store for retrieving key/value information",
-    "title": "K/V store",
-    "contact": {
-      "name": "Ivan Porto Carrero",
-      "email": "ivan@flanders.co.nz"
-    },
-    "license": {
-      "name": "MIT",
-      "url": "https://github.com/go-openapi/kvstore/blob/master/LICENSE"
     },
-    "version": "0.0.1"
-  },
-  "paths": {
-    "/kv": {
+    "/hashes/{key}": {
       "get": {
-        "description": "lists all the keys",
+        "description": "gets all the fields of the hash",
         "tags": [
-          "kv"
-        ],
-        "operationId": "findKeys",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          }
+          "hashes"
         ],
+        "operationId": "getHash",
         "responses": {
           "200": {
-            "description": "list the keys known to this datastore",
+            "description": "the fields of the hash",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/hash"
             },
             "headers": {
               "X-Request-Id": {
@@ -395,49 +398,36 @@ func init() {
               }
             }
           },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
           "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            },
-            "headers": {
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
+            "$ref": "#/responses/errorResponse"
           }
         }
       },
       "parameters": [
         {
-          "minLength": 1,
-          "type": "string",
-          "description": "A unique UUID for the request",
-          "name": "X-Request-Id",
-          "in": "header"
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/hashKey"
         }
       ]
     },
-    "/kv/{key}": {
+    "/hashes/{key}/{field}": {
       "get": {
+        "description": "gets the value of a field of the hash",
         "produces": [
           "application/octet-stream"
         ],
         "tags": [
-          "kv"
-        ],
-        "operationId": "getEntry",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "If-None-Match",
-            "in": "header"
-          }
+          "hashes"
         ],
+        "operationId": "getField",
         "responses": {
           "200": {
-            "description": "entry was found",
+            "description": "the value of the field",
             "schema": {
               "type": "string",
               "format": "binary"
@@ -445,28 +435,11 @@ func init() {
             "headers": {
               "ETag": {
                 "type": "string",
-                "description": "The version of this entry"
-              },
-              "Last-Modified": {
-                "type": "string",
-                "description": "The time this entry was last modified"
-              },
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
-          },
-          "304": {
-            "description": "entry was found but not modified",
-            "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "The version of this entry"
+                "description": "The version of the field"
               },
-              "Last-Modified": {
+              "X-Hash-Version": {
                 "type": "string",
-                "description": "The time this entry was last modified"
+                "description": "The version of the hash, this changes every time a field changes"
               },
               "X-Request-Id": {
                 "type": "string",
@@ -475,46 +448,25 @@ func init() {
             }
           },
           "404": {
-            "description": "The entry was not found",
-            "schema": {
-              "$ref": "#/definitions/error"
-            },
-            "headers": {
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
+            "$ref": "#/responses/errorNotFound"
           },
           "default": {
-            "description": "Error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            },
-            "headers": {
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
+            "$ref": "#/responses/errorResponse"
           }
         }
       },
       "put": {
+        "description": "sets the value of a field of the hash, the other fields of the hash are left alone so writers that change different fields don't conflict",
         "consumes": [
           "application/octet-stream"
         ],
         "tags": [
-          "kv"
+          "hashes"
         ],
-        "operationId": "putEntry",
+        "operationId": "setField",
         "parameters": [
           {
-            "pattern": "[0-9]*",
-            "type": "string",
-            "description": "when this is an update to an entry, then this field needs to be present",
-            "name": "If-Match",
-            "in": "header"
+            "$ref": "#/parameters/fieldVersion"
           },
           {
             "name": "body",
@@ -528,30 +480,16 @@ func init() {
           }
         ],
         "responses": {
-          "201": {
-            "description": "entry was created",
-            "headers": {
-              "Etag": {
-                "type": "string",
-                "description": "The version of this entry"
-              },
-              "Location": {
-                "type": "string",
-                "format": "uri",
-                "description": "the location to get the newly created entry"
-              },
-              "X-Request-Id": {
-                "type": "string",
-                "description": "The request id this is a response to"
-              }
-            }
-          },
           "204": {
-            "description": "entry was updated",
+            "description": "the field was set",
             "headers": {
               "ETag": {
                 "type": "string",
-                "description": "The version of this entry"
+                "description": "The version of the field"
+              },
+              "X-Hash-Version": {
+                "type": "string",
+                "description": "The version of the hash, this changes every time a field changes"
               },
               "X-Request-Id": {
                 "type": "string",
@@ -559,8 +497,8 @@ func init() {
               }
             }
           },
-          "404": {
-            "description": "The entry was not found",
+          "409": {
+            "description": "there is a version mismatch for the field",
             "schema": {
               "$ref": "#/definitions/error"
             },
@@ -571,20 +509,38 @@ func init() {
               }
             }
           },
-          "409": {
-            "description": "there is a version mismatch for the entry",
-            "schema": {
-              "$ref": "#/definitions/error"
-            },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "removes a field from the hash",
+        "tags": [
+          "hashes"
+        ],
+        "operationId": "deleteField",
+        "parameters": [
+          {
+            "$ref": "#/parameters/fieldVersion"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the field was removed",
             "headers": {
+              "X-Hash-Version": {
+                "type": "string",
+                "description": "The version of the hash, this changes every time a field changes"
+              },
               "X-Request-Id": {
                 "type": "string",
                 "description": "The request id this is a response to"
               }
             }
           },
-          "410": {
-            "description": "The entry is deleted",
+          "409": {
+            "description": "there is a version mismatch for the field",
             "schema": {
               "$ref": "#/definitions/error"
             },
@@ -596,9 +552,37 @@ func init() {
             }
           },
           "default": {
-            "description": "Error",
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/hashKey"
+        },
+        {
+          "$ref": "#/parameters/hashField"
+        }
+      ]
+    },
+    "/indexes": {
+      "get": {
+        "description": "lists the index definitions",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "listIndexes",
+        "responses": {
+          "200": {
+            "description": "the index definitions ordered by name",
             "schema": {
-              "$ref": "#/definitions/error"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/index"
+              }
             },
             "headers": {
               "X-Request-Id": {
@@ -606,17 +590,40 @@ func init() {
                 "description": "The request id this is a response to"
               }
             }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
           }
         }
       },
-      "delete": {
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/indexes/{name}": {
+      "get": {
+        "description": "finds the keys of the entries where the pointer of the index points at the value. Strings, numbers, booleans and null are indexed as their text.",
         "tags": [
-          "kv"
+          "indexes"
+        ],
+        "operationId": "queryIndex",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The value to look for",
+            "name": "value",
+            "in": "query",
+            "required": true
+          }
         ],
-        "operationId": "deleteEntry",
         "responses": {
-          "204": {
-            "description": "the delete was successful",
+          "200": {
+            "description": "the keys of the matching entries, while the index isn't ready these aren't all of them yet",
+            "schema": {
+              "$ref": "#/definitions/indexMatches"
+            },
             "headers": {
               "X-Request-Id": {
                 "type": "string",
@@ -624,8 +631,290 @@ func init() {
               }
             }
           },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
           "default": {
-            "description": "Error",
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "creates an index over the values a JSON pointer points at in the entries that start with the prefix. Writes maintain the index right away, the entries that already exist get indexed in the background.",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "createIndex",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/indexDefinition"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the index was created",
+            "schema": {
+              "$ref": "#/definitions/index"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "an index with this name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "removes the index",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "dropIndex",
+        "responses": {
+          "204": {
+            "description": "the index was removed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/indexName"
+        }
+      ]
+    },
+    "/kv": {
+      "get": {
+        "description": "lists all the keys, when a delimiter is given the keys that contain the delimiter after the prefix are rolled up into a single common prefix that ends with the delimiter, like a directory listing",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "findKeys",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "groups the keys below the prefix by the first occurrence of the delimiter",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "only lists the keys of the entries with labels that match the selector, a comma separated list of name, !name, name=value, name!=value, name in (values) and name notin (values) requirements. For example env=prod,tier!=cache",
+            "name": "selector",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "only lists the keys of the entries that were written at or after this revision or RFC 3339 time",
+            "name": "modifiedSince",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the entries that hold a JSON document and match this expression instead of the keys, it compares the values JSON pointers point at with JSON literals using =, !=, \u003c, \u003c=, \u003e, \u003e=, in (...) and exists, combined with and, or, not and parentheses. For example /city = \"paris\" and (/age \u003e= 18 or exists /admin). The filter is evaluated while iterating over the entries, the entries that aren't JSON documents are skipped",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the entries that hold a JSON document instead of the keys, a comma separated list of JSON pointers, the values only hold these fields at the same place",
+            "name": "fields",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "the maximum number of entries to list when a filter or fields are given",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list the keys known to this datastore, or the entries in key order with their keys and values when a filter or fields are given",
+            "schema": {
+              "type": "array",
+              "items": {
+                "description": "a key, or an entry when a filter or fields are given"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "the selector, the time, the filter or the fields are malformed, or a delimiter is given together with a filter or fields",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "deletes all the keys that start with the given prefix",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "deleteKeys",
+        "parameters": [
+          {
+            "minLength": 1,
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "must be true, guards against deleting a whole subtree by accident",
+            "name": "confirm",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the keys with the prefix were deleted",
+            "schema": {
+              "$ref": "#/definitions/deleteResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/kv/_changes": {
+      "get": {
+        "description": "lists the writes and deletes of the entries in the order they happened. The revision of a change is the time it happened in unix nanoseconds, made unique. The changes older than the retention get pruned.",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "listChanges",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the changes after this revision or RFC 3339 time, use the next revision of the previous page to get the next page. Without it the list starts at the oldest change that is kept.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1000,
+            "description": "the maximum number of changes to return",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the changes after since",
+            "schema": {
+              "$ref": "#/definitions/changeList"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "since is not a revision or a time",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "the changes after since were pruned, the entries need to be read again",
             "schema": {
               "$ref": "#/definitions/error"
             },
@@ -635,72 +924,9190 @@ func init() {
                 "description": "The request id this is a response to"
               }
             }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/kv/_stats": {
+      "get": {
+        "description": "aggregates the number of keys and the sizes of the values that start with the given prefix",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "getStats",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the statistics for the keys with the prefix",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
           }
         }
-      },
-      "parameters": [
-        {
-          "minLength": 1,
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/kv/{key}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "getEntry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "entry was found",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "The time this entry was last modified"
+              },
+              "X-Labels": {
+                "type": "string",
+                "description": "The labels of this entry as a comma separated list of name=value pairs"
+              },
+              "X-Last-Updated": {
+                "type": "integer",
+                "format": "int64",
+                "description": "The time this entry was last modified in unix nanoseconds, Last-Modified only has minutes"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "304": {
+            "description": "entry was found but not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "The time this entry was last modified"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "putEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when this is an update to an entry, then this field needs to be present",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "binds the entry to this session, the entry is removed or released when the session expires or gets destroyed. Entries that are bound to a session keep that binding when they are updated without one.",
+            "name": "session",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "replaces the labels of the entry with this comma separated list of name=value pairs, updates without labels keep the labels of the entry",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "entry was created",
+            "headers": {
+              "Etag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the newly created entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "entry was updated",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry or the entry is bound to another session",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "kv"
+        ],
+        "operationId": "deleteEntry",
+        "responses": {
+          "204": {
+            "description": "the delete was successful",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "patch": {
+        "description": "applies a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902) to the JSON document in the entry, the content type of the request selects the kind of patch",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json-patch+json"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "patchEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "entry was patched",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry, the entry doesn't hold a JSON document or the patch can't be applied to it",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/kv/{key}/_append": {
+      "post": {
+        "description": "atomically appends the body to the value of the entry, the entry is created when it doesn't exist yet. When the value grows beyond the max size the oldest bytes are trimmed, or the oldest records when a delimiter is given.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "appendEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "the maximum size of the value after appending, the oldest data is trimmed to fit",
+            "name": "maxSize",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "separates the records in the value, trimming then removes whole records. When the newest record doesn't fit in the max size on its own the value is trimmed by bytes.",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the body was appended to the entry",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/kv/{key}/_copy": {
+      "post": {
+        "description": "copies the entry to the destination key in a single atomic write",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "copyEntry",
+        "parameters": [
+          {
+            "$ref": "#/parameters/destination"
+          },
+          {
+            "$ref": "#/parameters/sourceVersion"
+          },
+          {
+            "$ref": "#/parameters/destinationVersion"
+          },
+          {
+            "$ref": "#/parameters/prefixTransfer"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the entries below the prefix were copied",
+            "schema": {
+              "$ref": "#/definitions/transferResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "201": {
+            "description": "the entry was copied",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the destination entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the destination entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "description": "there is a version mismatch for the source or the destination entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The destination entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/kv/{key}/_incr": {
+      "post": {
+        "description": "atomically adds the delta to the counter stored in the entry, when the entry doesn't exist yet the counter starts at the initial value. The counter is stored as a base 10 int64.",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "incrEntry",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "the amount to add to the counter, use a negative value to decrement",
+            "name": "delta",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "the value the counter starts at when the entry doesn't exist yet",
+            "name": "initial",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the counter was updated",
+            "schema": {
+              "$ref": "#/definitions/counter"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "the entry doesn't hold a counter or the counter would overflow",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/kv/{key}/_labels": {
+      "put": {
+        "description": "replaces the labels of the entry, the value stays the same and the version changes",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "setLabels",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/labelSet"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the labels were replaced",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "a label name or value is invalid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/kv/{key}/_move": {
+      "post": {
+        "description": "moves the entry to the destination key, the copy and the delete happen in a single atomic write",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "moveEntry",
+        "parameters": [
+          {
+            "$ref": "#/parameters/destination"
+          },
+          {
+            "$ref": "#/parameters/sourceVersion"
+          },
+          {
+            "$ref": "#/parameters/destinationVersion"
+          },
+          {
+            "$ref": "#/parameters/prefixTransfer"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the entries below the prefix were moved",
+            "schema": {
+              "$ref": "#/definitions/transferResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "201": {
+            "description": "the entry was moved",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the destination entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the destination entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "description": "there is a version mismatch for the source or the destination entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The destination entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/entryKey"
+        }
+      ]
+    },
+    "/locks/{name}": {
+      "get": {
+        "description": "reports the current holder of the lock",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "getLock",
+        "responses": {
+          "200": {
+            "description": "the current holder of the lock",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the lock, the lock is held for another ttl. In cluster mode the locks are not available and this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "renewLock",
+        "parameters": [
+          {
+            "$ref": "#/parameters/lockToken"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "acquires the lock for the ttl, every time the lock changes hands the fencing token increases. The lease depends on the clock of the member that serves it, so in cluster mode this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "acquireLock",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the one acquiring the lock",
+            "name": "holder",
+            "in": "query",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/lockTTL"
+          },
+          {
+            "$ref": "#/parameters/lockWait"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lock was acquired",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "releases the lock so someone else can acquire it. In cluster mode the locks are not available and this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "releaseLock",
+        "parameters": [
+          {
+            "$ref": "#/parameters/lockToken"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the lock was released",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/lockHeld"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/lockName"
+        }
+      ]
+    },
+    "/merkle": {
+      "get": {
+        "description": "reads nodes of the merkle tree over the entries. The keys hash into the buckets at the bottom level, the hash of a bucket sums a hash of the key and the version of every entry in it. Every other node hashes its children, the children of node i are the nodes i*fanout up to (i+1)*fanout of the next level. Two stores that have the same root have the same entries.",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "getMerkleLevel",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The level to read, 0 is the root and depth is the level of the buckets",
+            "name": "level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the nodes of the level to read, all the nodes when this is empty",
+            "name": "nodes",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the nodes of the level",
+            "schema": {
+              "$ref": "#/definitions/merkleLevel"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/merkle/_keys": {
+      "get": {
+        "description": "lists the keys in the buckets with their versions and the time they were last updated",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "listMerkleKeys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "A comma separated list of the buckets",
+            "name": "buckets",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the keys in the buckets sorted by key",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/merkleKey"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/merkle/_repair": {
+      "post": {
+        "description": "compares the merkle tree of this store with the one of the peer and copies the entries of the buckets that differ when the peer updated them later. The entries that only this store has stay, repairing the peer from this store as well makes both stores have the same entries.",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "repairFrom",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/repairRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the repair finished",
+            "schema": {
+              "$ref": "#/definitions/repairResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/queues/{name}": {
+      "get": {
+        "description": "reports the length of the queue",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "getQueue",
+        "responses": {
+          "200": {
+            "description": "the length of the queue",
+            "schema": {
+              "$ref": "#/definitions/queue"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "adds a message to the end of the queue. The messages get their ids and visibility from the member that serves them, so in cluster mode this responds with 501.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "enqueueMessage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the message was added to the queue",
+            "headers": {
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/queueName"
+        }
+      ]
+    },
+    "/queues/{name}/_dequeue": {
+      "post": {
+        "description": "takes the first visible message from the queue, the message stays invisible for the visibility timeout. When it isn't acked in that time the message becomes visible again. In cluster mode the queues are not available and this responds with 501.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "dequeueMessage",
+        "parameters": [
+          {
+            "maximum": 43200,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 30,
+            "description": "The number of seconds the message stays invisible",
+            "name": "visibility",
+            "in": "query"
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for a message when the queue is empty",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the message was dequeued",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Deliveries": {
+                "type": "string",
+                "description": "The number of times the message was dequeued"
+              },
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Receipt": {
+                "type": "string",
+                "description": "The receipt to ack or nack the message with"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "the queue has no visible messages",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/queueName"
+        }
+      ]
+    },
+    "/queues/{name}/_peek": {
+      "get": {
+        "description": "gets the first visible message without dequeuing it",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "peekMessage",
+        "responses": {
+          "200": {
+            "description": "the first visible message",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Deliveries": {
+                "type": "string",
+                "description": "The number of times the message was dequeued"
+              },
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "the queue has no visible messages",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/queueName"
+        }
+      ]
+    },
+    "/queues/{name}/{id}/_ack": {
+      "post": {
+        "description": "removes the dequeued message from the queue. In cluster mode the queues are not available and this responds with 501.",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "ackMessage",
+        "parameters": [
+          {
+            "$ref": "#/parameters/receipt"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the message was removed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "$ref": "#/responses/receiptMismatch"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/queueName"
+        },
+        {
+          "$ref": "#/parameters/messageId"
+        }
+      ]
+    },
+    "/queues/{name}/{id}/_nack": {
+      "post": {
+        "description": "puts the dequeued message back, it becomes visible again after the delay. In cluster mode the queues are not available and this responds with 501.",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "nackMessage",
+        "parameters": [
+          {
+            "$ref": "#/parameters/receipt"
+          },
+          {
+            "maximum": 43200,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds before the message becomes visible again",
+            "name": "delay",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the message was put back",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "409": {
+            "$ref": "#/responses/receiptMismatch"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/queueName"
+        },
+        {
+          "$ref": "#/parameters/messageId"
+        }
+      ]
+    },
+    "/replication": {
+      "get": {
+        "description": "reports if this store is a primary or a follower, for a follower this includes how far it is behind the primary it follows",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "getReplicationStatus",
+        "responses": {
+          "200": {
+            "description": "the replication status of this store",
+            "schema": {
+              "$ref": "#/definitions/replicationStatus"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/replication/_snapshot": {
+      "get": {
+        "description": "streams all the entries as msgpack, this starts with the sequence of the last change in the changelog followed by an entry as a put change for every entry. A follower restores it and then reads the changelog from the next sequence.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "replication"
+        ],
+        "operationId": "getSnapshot",
+        "responses": {
+          "200": {
+            "description": "the snapshot of the entries",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/search": {
+      "get": {
+        "description": "finds the entries that contain any of the words in the query, only the entries below the configured search prefixes are searchable. The entries with the rarest words and the most occurrences come first.",
+        "tags": [
+          "search"
+        ],
+        "operationId": "search",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to look for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "only finds the entries with a key that starts with the prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "the maximum number of entries to return",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the matching entries with the best match first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/searchResult"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/searchDisabled"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/search/_rebuild": {
+      "post": {
+        "description": "replaces the search index with one built from the entries below the search prefixes, this is needed after changing the search prefixes. Writes wait until this is done.",
+        "tags": [
+          "search"
+        ],
+        "operationId": "rebuildSearch",
+        "responses": {
+          "200": {
+            "description": "the search index was rebuilt",
+            "schema": {
+              "$ref": "#/definitions/searchRebuild"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/searchDisabled"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/semaphores/{name}": {
+      "get": {
+        "description": "reports the holders of the slots of the semaphore",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "getSemaphore",
+        "responses": {
+          "200": {
+            "description": "the semaphore with its current holders",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the slot, the slot is held for another ttl. In cluster mode the semaphores are not available and this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "renewSlot",
+        "parameters": [
+          {
+            "$ref": "#/parameters/semaphoreHolder"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/semaphoreFull"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "acquires a slot of the semaphore for the ttl, at most limit holders hold a slot at the same time. When the holder already holds a slot this renews it. The leases depend on the clock of the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "acquireSlot",
+        "parameters": [
+          {
+            "$ref": "#/parameters/semaphoreHolder"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of slots of the semaphore, it is taken when none of the slots are held and has to match the limit of the semaphore while they are",
+            "name": "limit",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 86400,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of seconds the slot is held without being renewed",
+            "name": "ttl",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for a slot to become available",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the slot was acquired",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/semaphoreFull"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "releases the slot so a waiting holder can acquire it. In cluster mode the semaphores are not available and this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "releaseSlot",
+        "parameters": [
+          {
+            "$ref": "#/parameters/semaphoreHolder"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the slot was released",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "$ref": "#/responses/semaphoreFull"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/semaphoreName"
+        }
+      ]
+    },
+    "/sequences/{name}/_next": {
+      "post": {
+        "description": "hands out the next ids of the sequence, the ids increase and are never handed out twice, not even after a restart. A sequence starts at 1. The blocks of ids are reserved by the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "sequences"
+        ],
+        "operationId": "nextIds",
+        "parameters": [
+          {
+            "maximum": 1000000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "The number of ids to hand out",
+            "name": "count",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the ids from first up to and including last are handed out",
+            "schema": {
+              "$ref": "#/definitions/idRange"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "the sequence would overflow",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sequence",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions": {
+      "get": {
+        "description": "lists the sessions that are alive",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "the sessions that are alive",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/session"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "creates a session, the session stays alive as long as it gets renewed within its ttl. Sessions get random ids and expire by the clock of the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "createSession",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/sessionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the session was created",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        }
+      ]
+    },
+    "/sessions/{id}": {
+      "get": {
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "getSession",
+        "responses": {
+          "200": {
+            "description": "the session was found",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "delete": {
+        "description": "destroys the session, the entries bound to it get removed or released. In cluster mode the sessions are not available and this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "destroySession",
+        "responses": {
+          "204": {
+            "description": "the session was destroyed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/sessionId"
+        }
+      ]
+    },
+    "/sessions/{id}/renew": {
+      "put": {
+        "description": "renews the session, it expires when it isn't renewed again within its ttl. In cluster mode the sessions are not available and this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "renewSession",
+        "responses": {
+          "200": {
+            "description": "the session was renewed",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/sessionId"
+        }
+      ]
+    },
+    "/zsets/{name}": {
+      "get": {
+        "description": "reports the number of members of the sorted set",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "getZset",
+        "responses": {
+          "200": {
+            "description": "the sorted set",
+            "schema": {
+              "$ref": "#/definitions/zset"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "post": {
+        "description": "adds the members to the sorted set, the members that are already in the set get the new score",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "addMembers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/zsetMembers"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members were added",
+            "schema": {
+              "$ref": "#/definitions/zsetCount"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/invalidScore"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    },
+    "/zsets/{name}/_incr": {
+      "post": {
+        "description": "adds the delta to the score of the member, a member that isn't in the set yet starts at 0",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "incrScore",
+        "parameters": [
+          {
+            "$ref": "#/parameters/zsetMember"
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The amount to add to the score",
+            "name": "delta",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the score was incremented",
+            "schema": {
+              "$ref": "#/definitions/zsetMember"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "$ref": "#/responses/invalidScore"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    },
+    "/zsets/{name}/_range": {
+      "get": {
+        "description": "lists the members from the start rank up to and including the stop rank, negative ranks count from the end so -1 is the last member",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "rangeByRank",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The rank of the first member",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": -1,
+            "description": "The rank of the last member",
+            "name": "stop",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/zsetReverse"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members with their rank",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/zsetMember"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    },
+    "/zsets/{name}/_rangebyscore": {
+      "get": {
+        "description": "lists the members with a score between min and max, both included, from the lowest to the highest score",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "rangeByScore",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The lowest score to include, without it the range starts at the first member",
+            "name": "min",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The highest score to include, without it the range ends at the last member",
+            "name": "max",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of members in the range to skip",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The max number of members to list",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members in the range",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/zsetMember"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    },
+    "/zsets/{name}/_rank": {
+      "get": {
+        "description": "gets the score and the rank of the member, the rank of the member with the lowest score is 0",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "getRank",
+        "parameters": [
+          {
+            "$ref": "#/parameters/zsetMember"
+          },
+          {
+            "$ref": "#/parameters/zsetReverse"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the member with its rank",
+            "schema": {
+              "$ref": "#/definitions/zsetMember"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "$ref": "#/responses/errorNotFound"
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    },
+    "/zsets/{name}/_remove": {
+      "post": {
+        "description": "removes the members from the sorted set",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "removeMembers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/zsetRemoval"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members were removed",
+            "schema": {
+              "$ref": "#/definitions/zsetCount"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/errorResponse"
+          }
+        }
+      },
+      "parameters": [
+        {
+          "$ref": "#/parameters/requestId"
+        },
+        {
+          "$ref": "#/parameters/zsetName"
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "change": {
+      "type": "object",
+      "required": [
+        "revision",
+        "key",
+        "version",
+        "op"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "op": {
+          "description": "The kind of change",
+          "type": "string",
+          "enum": [
+            "put",
+            "delete"
+          ]
+        },
+        "revision": {
+          "description": "The revision of the change, the time it happened in unix nanoseconds made unique",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "The version of the entry after the change, 0 when it was deleted",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "changeList": {
+      "type": "object",
+      "required": [
+        "changes",
+        "next"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/change"
+          }
+        },
+        "next": {
+          "description": "The revision to continue from to get the changes after these",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "changelog": {
+      "type": "object",
+      "required": [
+        "changes",
+        "first",
+        "next"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/changelogEntry"
+          }
+        },
+        "first": {
+          "description": "The sequence of the oldest change that is kept",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "next": {
+          "description": "The sequence to continue from to get the changes after these",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "changelogEntry": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "revision",
+        "key",
+        "version",
+        "op"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "labels": {
+          "description": "The labels of the entry after a put",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "lastUpdated": {
+          "description": "The hybrid logical clock time of the put or the delete in unix nanoseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "op": {
+          "description": "The kind of change",
+          "type": "string",
+          "enum": [
+            "put",
+            "delete"
+          ]
+        },
+        "origin": {
+          "description": "The id of the store the put or the delete was made on",
+          "type": "string"
+        },
+        "revision": {
+          "description": "The revision of the change, the time it happened in unix nanoseconds made unique",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sequence": {
+          "description": "The number of the change in the changelog",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "value": {
+          "description": "The value of the entry after a put",
+          "type": "string",
+          "format": "byte"
+        },
+        "version": {
+          "description": "The version of the entry after the change, 0 when it was deleted",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "clusterMember": {
+      "type": "object",
+      "required": [
+        "id",
+        "peerAddress",
+        "apiUrl"
+      ],
+      "properties": {
+        "apiUrl": {
+          "description": "The url of the api of the member, the writes get sent there when it leads",
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "description": "The id of the member",
+          "type": "string",
+          "minLength": 1
+        },
+        "peerAddress": {
+          "description": "The host:port the member serves the raft requests of the other members at",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "clusterStatus": {
+      "type": "object",
+      "required": [
+        "id",
+        "state",
+        "term",
+        "commitIndex",
+        "appliedIndex",
+        "members"
+      ],
+      "properties": {
+        "appliedIndex": {
+          "description": "The index of the last entry this member applied to its store",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "commitIndex": {
+          "description": "The index of the last entry of the raft log this member knows is committed",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "id": {
+          "description": "The id of this member",
+          "type": "string"
+        },
+        "leader": {
+          "description": "The id of the leader this member knows about",
+          "type": "string"
+        },
+        "leaderUrl": {
+          "description": "The url of the api of the leader",
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/clusterMember"
+          }
+        },
+        "state": {
+          "description": "The raft state of this member",
+          "type": "string",
+          "enum": [
+            "follower",
+            "candidate",
+            "leader"
+          ]
+        },
+        "term": {
+          "description": "The current term",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "counter": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "description": "The value of the counter after the update",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "deleteResult": {
+      "type": "object",
+      "required": [
+        "deleted"
+      ],
+      "properties": {
+        "deleted": {
+          "description": "The number of entries that were removed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "entry": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "value": {
+          "description": "The JSON document of the entry, or the fields that were asked for"
+        }
+      }
+    },
+    "error": {
+      "description": "the error model is a model for all the error responses coming from kvstore\n",
+      "type": "object",
+      "required": [
+        "message",
+        "code"
+      ],
+      "properties": {
+        "cause": {
+          "$ref": "#/definitions/error"
+        },
+        "code": {
+          "description": "The error code",
+          "type": "integer",
+          "format": "int64"
+        },
+        "helpUrl": {
+          "description": "link to help page explaining the error in more detail",
+          "type": "string",
+          "format": "uri"
+        },
+        "message": {
+          "description": "The error message",
+          "type": "string"
+        }
+      }
+    },
+    "hash": {
+      "type": "object",
+      "required": [
+        "key",
+        "version",
+        "fields"
+      ],
+      "properties": {
+        "fields": {
+          "description": "The values of the fields by name",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "key": {
+          "description": "The key of the hash",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the hash, this changes every time a field changes",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "idRange": {
+      "type": "object",
+      "required": [
+        "first",
+        "last"
+      ],
+      "properties": {
+        "first": {
+          "description": "The first id that was handed out",
+          "type": "integer",
+          "format": "int64"
+        },
+        "last": {
+          "description": "The last id that was handed out",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "index": {
+      "type": "object",
+      "required": [
+        "name",
+        "prefix",
+        "pointer",
+        "ready"
+      ],
+      "properties": {
+        "name": {
+          "description": "The name of the index",
+          "type": "string"
+        },
+        "pointer": {
+          "description": "The JSON pointer to the indexed value in the entries",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "The index covers the entries whose key starts with this prefix",
+          "type": "string"
+        },
+        "ready": {
+          "description": "False while the entries that existed before the index was created are being indexed",
+          "type": "boolean"
+        }
+      }
+    },
+    "indexDefinition": {
+      "type": "object",
+      "required": [
+        "prefix",
+        "pointer"
+      ],
+      "properties": {
+        "pointer": {
+          "description": "The JSON pointer to the indexed value in the entries",
+          "type": "string",
+          "pattern": "^(/.*)?$"
+        },
+        "prefix": {
+          "description": "The index covers the entries whose key starts with this prefix",
+          "type": "string"
+        }
+      }
+    },
+    "indexMatches": {
+      "type": "object",
+      "required": [
+        "ready",
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "description": "The keys of the matching entries",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ready": {
+          "description": "False while the entries that existed before the index was created are being indexed",
+          "type": "boolean"
+        }
+      }
+    },
+    "labelSet": {
+      "type": "object",
+      "required": [
+        "labels"
+      ],
+      "properties": {
+        "labels": {
+          "description": "The labels by name, names are 1 to 253 and values up to 63 letters, digits, '-', '_', '.' or '/'",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "lock": {
+      "type": "object",
+      "required": [
+        "name",
+        "holder",
+        "token",
+        "ttl",
+        "expiresAt"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "The time the lock is released unless it gets renewed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "holder": {
+          "description": "The holder of the lock, for an election this is the leader",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the lock",
+          "type": "string"
+        },
+        "token": {
+          "description": "The fencing token, this increases every time the lock changes hands",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ttl": {
+          "description": "The number of seconds the lock is held without being renewed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "merkleKey": {
+      "type": "object",
+      "required": [
+        "key",
+        "version",
+        "lastUpdated"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "lastUpdated": {
+          "description": "The time of the last update of the entry in unix nanoseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "origin": {
+          "description": "The id of the store the entry was written on",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the entry",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "merkleLevel": {
+      "type": "object",
+      "required": [
+        "depth",
+        "fanout",
+        "level",
+        "nodes"
+      ],
+      "properties": {
+        "depth": {
+          "description": "The level of the buckets",
+          "type": "integer",
+          "format": "int64"
+        },
+        "fanout": {
+          "description": "The number of children of a node",
+          "type": "integer",
+          "format": "int64"
+        },
+        "level": {
+          "description": "The level of the nodes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/merkleNode"
+          }
+        }
+      }
+    },
+    "merkleNode": {
+      "type": "object",
+      "required": [
+        "index",
+        "hash"
+      ],
+      "properties": {
+        "hash": {
+          "description": "The hash of the node",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "index": {
+          "description": "The index of the node in its level",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "peerStatus": {
+      "type": "object",
+      "required": [
+        "url",
+        "sequence",
+        "state"
+      ],
+      "properties": {
+        "lag": {
+          "description": "The number of changes of the peer that still need to be merged",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastError": {
+          "description": "The error of the last attempt to read from the peer when it failed",
+          "type": "string"
+        },
+        "peerSequence": {
+          "description": "The sequence of the next change of the peer the last time its changelog was read",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "sequence": {
+          "description": "The sequence of the next change of the peer to merge",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "staleness": {
+          "description": "The time in milliseconds since all the changes of the peer were merged",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "What the store is doing with the changes of the peer",
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "streaming"
+          ]
+        },
+        "url": {
+          "description": "The url of the peer",
+          "type": "string"
+        }
+      }
+    },
+    "queue": {
+      "type": "object",
+      "required": [
+        "name",
+        "length",
+        "visible",
+        "inFlight"
+      ],
+      "properties": {
+        "inFlight": {
+          "description": "The number of messages that were dequeued but not acked yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "length": {
+          "description": "The number of messages in the queue",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the queue",
+          "type": "string"
+        },
+        "visible": {
+          "description": "The number of messages that can be dequeued",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "repairRequest": {
+      "type": "object",
+      "required": [
+        "peer"
+      ],
+      "properties": {
+        "peer": {
+          "description": "The url of the kvstored to repair from",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "repairResult": {
+      "type": "object",
+      "required": [
+        "buckets",
+        "copied",
+        "kept"
+      ],
+      "properties": {
+        "buckets": {
+          "description": "The number of buckets that differed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied": {
+          "description": "The number of entries that were copied from the peer",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kept": {
+          "description": "The number of entries that differed but were updated later in this store",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "replicationStatus": {
+      "type": "object",
+      "required": [
+        "role",
+        "sequence"
+      ],
+      "properties": {
+        "lag": {
+          "description": "The number of changes of the primary the follower still needs to apply",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastError": {
+          "description": "The error of the last attempt to read from the primary when it failed",
+          "type": "string"
+        },
+        "nodeId": {
+          "description": "The id of this store, it is the origin of the writes made on it",
+          "type": "string"
+        },
+        "peers": {
+          "description": "How far a peer is behind each of its peers",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerStatus"
+          }
+        },
+        "primary": {
+          "description": "The url of the primary this store follows",
+          "type": "string"
+        },
+        "primarySequence": {
+          "description": "The sequence of the next change of the primary the last time the follower read its changelog",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "role": {
+          "description": "The role of this store, a peer takes writes and merges the changes of its peers",
+          "type": "string",
+          "enum": [
+            "primary",
+            "follower",
+            "peer"
+          ]
+        },
+        "sequence": {
+          "description": "The sequence of the next change, for a follower this is the next change of the primary it applies",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "staleness": {
+          "description": "The time in milliseconds since the follower last applied all the changes of the primary, the reads of the follower can miss the writes of this period",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "What the follower is doing",
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "streaming"
+          ]
+        }
+      }
+    },
+    "searchRebuild": {
+      "type": "object",
+      "required": [
+        "indexed"
+      ],
+      "properties": {
+        "indexed": {
+          "description": "The number of entries in the search index",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "searchResult": {
+      "type": "object",
+      "required": [
+        "key",
+        "score",
+        "snippet"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "score": {
+          "description": "How well the entry matches, higher is better",
+          "type": "number",
+          "format": "double"
+        },
+        "snippet": {
+          "description": "The text around the first word that was found",
+          "type": "string"
+        }
+      }
+    },
+    "semaphore": {
+      "type": "object",
+      "required": [
+        "name",
+        "limit",
+        "holders"
+      ],
+      "properties": {
+        "holders": {
+          "description": "The holders of the slots",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/slot"
+          }
+        },
+        "limit": {
+          "description": "The number of slots of the semaphore",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the semaphore",
+          "type": "string"
+        }
+      }
+    },
+    "session": {
+      "type": "object",
+      "required": [
+        "id",
+        "ttl",
+        "behavior",
+        "expiresAt"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "What happens to the entries bound to the session when it ends",
+          "type": "string",
+          "enum": [
+            "delete",
+            "release"
+          ]
+        },
+        "expiresAt": {
+          "description": "The time the session expires unless it gets renewed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "The id of the session",
+          "type": "string"
+        },
+        "name": {
+          "description": "A name to recognize the session by",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the session stays alive without being renewed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "sessionRequest": {
+      "type": "object",
+      "required": [
+        "ttl"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "What happens to the entries bound to the session when it ends, this defaults to delete",
+          "type": "string",
+          "enum": [
+            "delete",
+            "release"
+          ]
+        },
+        "name": {
+          "description": "A name to recognize the session by",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the session stays alive without being renewed",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 86400,
+          "minimum": 1
+        }
+      }
+    },
+    "slot": {
+      "type": "object",
+      "required": [
+        "holder",
+        "ttl",
+        "expiresAt"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "The time the slot is released unless it gets renewed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "holder": {
+          "description": "The holder of the slot",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the slot is held without being renewed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "stats": {
+      "type": "object",
+      "required": [
+        "keys",
+        "totalBytes",
+        "diskBytes"
+      ],
+      "properties": {
+        "avgSize": {
+          "description": "The average size of the values",
+          "type": "number",
+          "format": "double"
+        },
+        "diskBytes": {
+          "description": "The approximate number of bytes the entries take up on disk",
+          "type": "integer",
+          "format": "int64"
+        },
+        "keys": {
+          "description": "The number of keys with the prefix",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxSize": {
+          "description": "The size of the largest value",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minSize": {
+          "description": "The size of the smallest value",
+          "type": "integer",
+          "format": "int64"
+        },
+        "newest": {
+          "description": "The newest time one of the entries was last updated",
+          "type": "string",
+          "format": "date-time"
+        },
+        "oldest": {
+          "description": "The oldest time one of the entries was last updated",
+          "type": "string",
+          "format": "date-time"
+        },
+        "totalBytes": {
+          "description": "The sum of the sizes of the values",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "transferResult": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of entries that were copied or moved",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "zset": {
+      "type": "object",
+      "required": [
+        "name",
+        "card"
+      ],
+      "properties": {
+        "card": {
+          "description": "The number of members of the sorted set",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the sorted set",
+          "type": "string"
+        }
+      }
+    },
+    "zsetCount": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of members that were added or removed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "zsetMember": {
+      "type": "object",
+      "required": [
+        "member",
+        "score"
+      ],
+      "properties": {
+        "member": {
+          "description": "The member of the sorted set",
+          "type": "string",
+          "minLength": 1
+        },
+        "rank": {
+          "description": "The position of the member in the sorted set, starting at 0",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "score": {
+          "description": "The score the members are ordered by",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "zsetMembers": {
+      "type": "object",
+      "required": [
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "description": "The members with their score",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zsetMember"
+          }
+        }
+      }
+    },
+    "zsetRemoval": {
+      "type": "object",
+      "required": [
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "description": "The members to remove",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    }
+  },
+  "parameters": {
+    "destination": {
+      "minLength": 1,
+      "pattern": "^[^\\x00]",
+      "type": "string",
+      "description": "The key to copy or move the entry to, this is a key prefix when prefix is true",
+      "name": "destination",
+      "in": "query",
+      "required": true
+    },
+    "destinationVersion": {
+      "pattern": "^[0-9]+$",
+      "type": "string",
+      "description": "when present the destination entry needs to have this version, 0 requires the destination to not exist",
+      "name": "destinationVersion",
+      "in": "query"
+    },
+    "entryKey": {
+      "minLength": 1,
+      "pattern": "^[^\\x00]",
+      "type": "string",
+      "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+      "name": "key",
+      "in": "path",
+      "required": true
+    },
+    "fieldVersion": {
+      "pattern": "[0-9]*",
+      "type": "string",
+      "description": "when present the field needs to have this version, a version of 0 requires the field to not exist",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "hashField": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The name of the field",
+      "name": "field",
+      "in": "path",
+      "required": true
+    },
+    "hashKey": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The key of the hash",
+      "name": "key",
+      "in": "path",
+      "required": true
+    },
+    "indexName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the index",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "lockName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the lock",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "lockTTL": {
+      "maximum": 86400,
+      "minimum": 1,
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of seconds the lock is held without being renewed",
+      "name": "ttl",
+      "in": "query",
+      "required": true
+    },
+    "lockToken": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The fencing token that was returned when the lock was acquired",
+      "name": "token",
+      "in": "query",
+      "required": true
+    },
+    "lockWait": {
+      "maximum": 300,
+      "minimum": 0,
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The number of seconds to wait for the lock to become available",
+      "name": "wait",
+      "in": "query"
+    },
+    "messageId": {
+      "minimum": 1,
+      "type": "integer",
+      "format": "int64",
+      "description": "The id of the message",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "prefixTransfer": {
+      "type": "boolean",
+      "default": false,
+      "description": "treat the key and the destination as prefixes and transfer all the entries below them",
+      "name": "prefix",
+      "in": "query"
+    },
+    "queueName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the queue",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "receipt": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The receipt that was returned when the message was dequeued",
+      "name": "receipt",
+      "in": "query",
+      "required": true
+    },
+    "requestId": {
+      "minLength": 1,
+      "type": "string",
+      "description": "A unique UUID for the request",
+      "name": "X-Request-Id",
+      "in": "header"
+    },
+    "semaphoreHolder": {
+      "minLength": 1,
+      "type": "string",
+      "description": "identifies the one holding a slot of the semaphore",
+      "name": "holder",
+      "in": "query",
+      "required": true
+    },
+    "semaphoreName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the semaphore",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "sessionId": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The id of the session",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "sourceVersion": {
+      "pattern": "[0-9]*",
+      "type": "string",
+      "description": "when present the source entry needs to have this version",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "zsetMember": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The member of the sorted set",
+      "name": "member",
+      "in": "query",
+      "required": true
+    },
+    "zsetName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the sorted set",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "zsetReverse": {
+      "type": "boolean",
+      "default": false,
+      "description": "when true the members are ordered from the highest to the lowest score",
+      "name": "reverse",
+      "in": "query"
+    }
+  },
+  "responses": {
+    "errorNotFound": {
+      "description": "The entry was not found",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "errorResponse": {
+      "description": "Error",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "invalidScore": {
+      "description": "The score is not a finite number",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "lockHeld": {
+      "description": "The lock is held by someone else or the token is no longer valid",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "receiptMismatch": {
+      "description": "The message was dequeued again after the receipt was handed out",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "searchDisabled": {
+      "description": "search is not enabled, there are no search prefixes configured",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "semaphoreFull": {
+      "description": "All the slots of the semaphore are taken, they are held with another limit or the holder no longer holds a slot",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "K/V store is a simple single node store for retrieving key/value information",
+    "title": "K/V store",
+    "contact": {
+      "name": "Ivan Porto Carrero",
+      "email": "ivan@flanders.co.nz"
+    },
+    "license": {
+      "name": "MIT",
+      "url": "https://github.com/go-openapi/kvstore/blob/master/LICENSE"
+    },
+    "version": "0.0.1"
+  },
+  "paths": {
+    "/changelog": {
+      "get": {
+        "description": "reads the changelog, the writes and deletes of the entries with the entry after each write numbered by a sequence without gaps. A consumer resumes from the next sequence of the last page it read, when the changes it needs were pruned because they are older than the retention this fails instead of skipping them. Only the entries are in the changelog, the changes to hashes, sorted sets, queues, sequences, locks, semaphores and sessions are not.",
+        "tags": [
+          "changelog"
+        ],
+        "operationId": "readChangelog",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "uint64",
+            "description": "the sequence of the first change to return, without it the changelog is read from the oldest change that is kept",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1000,
+            "description": "the maximum number of changes to return",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the changes starting at from",
+            "schema": {
+              "$ref": "#/definitions/changelog"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "the changes starting at from were pruned, the consumer needs to read the entries again",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/cluster": {
+      "get": {
+        "description": "reports the raft state of this member of the cluster, the leader it knows about and the members of the cluster",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "getClusterStatus",
+        "responses": {
+          "200": {
+            "description": "the cluster status of this member",
+            "schema": {
+              "$ref": "#/definitions/clusterStatus"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/cluster/members": {
+      "post": {
+        "description": "adds a member to the cluster, the member needs to be started without bootstrapping a cluster of its own. The members get added one at a time and only the leader adds them.",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "addClusterMember",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/clusterMember"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the member was added",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "a member with this id exists or the previous membership change is not committed yet",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/cluster/members/{id}": {
+      "delete": {
+        "description": "removes a member from the cluster, removing the leader makes the others elect a new leader once the removal is committed",
+        "tags": [
+          "cluster"
+        ],
+        "operationId": "removeClusterMember",
+        "responses": {
+          "204": {
+            "description": "the member was removed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "the previous membership change is not committed yet",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "The id of the member",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/elections/{name}": {
+      "get": {
+        "description": "reports the current leader",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "getLeader",
+        "responses": {
+          "200": {
+            "description": "the current leader",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the leadership, the leader stays the leader for another ttl. In cluster mode the elections are not available and this responds with 501.",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "renewLeadership",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The fencing token that was returned when the lock was acquired",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "campaigns to become the leader for the ttl, every time the leadership changes the fencing token increases. The leadership is a lease on the clock of the member that serves it, so in cluster mode this responds with 501.",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "campaign",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the candidate that wants to become the leader",
+            "name": "candidate",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 86400,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of seconds the lock is held without being renewed",
+            "name": "ttl",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for the lock to become available",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the candidate became the leader",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "resigns the leadership so another candidate can become the leader. In cluster mode the elections are not available and this responds with 501.",
+        "tags": [
+          "elections"
+        ],
+        "operationId": "resign",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The fencing token that was returned when the lock was acquired",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the leader resigned",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the lock",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/hashes/{key}": {
+      "get": {
+        "description": "gets all the fields of the hash",
+        "tags": [
+          "hashes"
+        ],
+        "operationId": "getHash",
+        "responses": {
+          "200": {
+            "description": "the fields of the hash",
+            "schema": {
+              "$ref": "#/definitions/hash"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The key of the hash",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/hashes/{key}/{field}": {
+      "get": {
+        "description": "gets the value of a field of the hash",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "hashes"
+        ],
+        "operationId": "getField",
+        "responses": {
+          "200": {
+            "description": "the value of the field",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the field"
+              },
+              "X-Hash-Version": {
+                "type": "string",
+                "description": "The version of the hash, this changes every time a field changes"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "sets the value of a field of the hash, the other fields of the hash are left alone so writers that change different fields don't conflict",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "hashes"
+        ],
+        "operationId": "setField",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the field needs to have this version, a version of 0 requires the field to not exist",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the field was set",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the field"
+              },
+              "X-Hash-Version": {
+                "type": "string",
+                "description": "The version of the hash, this changes every time a field changes"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the field",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "removes a field from the hash",
+        "tags": [
+          "hashes"
+        ],
+        "operationId": "deleteField",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the field needs to have this version, a version of 0 requires the field to not exist",
+            "name": "If-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the field was removed",
+            "headers": {
+              "X-Hash-Version": {
+                "type": "string",
+                "description": "The version of the hash, this changes every time a field changes"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the field",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The key of the hash",
+          "name": "key",
+          "in": "path",
+          "required": true
+        },
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "The name of the field",
+          "name": "field",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/indexes": {
+      "get": {
+        "description": "lists the index definitions",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "listIndexes",
+        "responses": {
+          "200": {
+            "description": "the index definitions ordered by name",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/index"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/indexes/{name}": {
+      "get": {
+        "description": "finds the keys of the entries where the pointer of the index points at the value. Strings, numbers, booleans and null are indexed as their text.",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "queryIndex",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The value to look for",
+            "name": "value",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the keys of the matching entries, while the index isn't ready these aren't all of them yet",
+            "schema": {
+              "$ref": "#/definitions/indexMatches"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "creates an index over the values a JSON pointer points at in the entries that start with the prefix. Writes maintain the index right away, the entries that already exist get indexed in the background.",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "createIndex",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/indexDefinition"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the index was created",
+            "schema": {
+              "$ref": "#/definitions/index"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "an index with this name already exists",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "removes the index",
+        "tags": [
+          "indexes"
+        ],
+        "operationId": "dropIndex",
+        "responses": {
+          "204": {
+            "description": "the index was removed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the index",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv": {
+      "get": {
+        "description": "lists all the keys, when a delimiter is given the keys that contain the delimiter after the prefix are rolled up into a single common prefix that ends with the delimiter, like a directory listing",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "findKeys",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "groups the keys below the prefix by the first occurrence of the delimiter",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "only lists the keys of the entries with labels that match the selector, a comma separated list of name, !name, name=value, name!=value, name in (values) and name notin (values) requirements. For example env=prod,tier!=cache",
+            "name": "selector",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "only lists the keys of the entries that were written at or after this revision or RFC 3339 time",
+            "name": "modifiedSince",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the entries that hold a JSON document and match this expression instead of the keys, it compares the values JSON pointers point at with JSON literals using =, !=, \u003c, \u003c=, \u003e, \u003e=, in (...) and exists, combined with and, or, not and parentheses. For example /city = \"paris\" and (/age \u003e= 18 or exists /admin). The filter is evaluated while iterating over the entries, the entries that aren't JSON documents are skipped",
+            "name": "filter",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the entries that hold a JSON document instead of the keys, a comma separated list of JSON pointers, the values only hold these fields at the same place",
+            "name": "fields",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "the maximum number of entries to list when a filter or fields are given",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list the keys known to this datastore, or the entries in key order with their keys and values when a filter or fields are given",
+            "schema": {
+              "type": "array",
+              "items": {
+                "description": "a key, or an entry when a filter or fields are given"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "the selector, the time, the filter or the fields are malformed, or a delimiter is given together with a filter or fields",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "deletes all the keys that start with the given prefix",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "deleteKeys",
+        "parameters": [
+          {
+            "minLength": 1,
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "must be true, guards against deleting a whole subtree by accident",
+            "name": "confirm",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the keys with the prefix were deleted",
+            "schema": {
+              "$ref": "#/definitions/deleteResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/kv/_changes": {
+      "get": {
+        "description": "lists the writes and deletes of the entries in the order they happened. The revision of a change is the time it happened in unix nanoseconds, made unique. The changes older than the retention get pruned.",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "listChanges",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "lists the changes after this revision or RFC 3339 time, use the next revision of the previous page to get the next page. Without it the list starts at the oldest change that is kept.",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1000,
+            "description": "the maximum number of changes to return",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the changes after since",
+            "schema": {
+              "$ref": "#/definitions/changeList"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "since is not a revision or a time",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "the changes after since were pruned, the entries need to be read again",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/kv/_stats": {
+      "get": {
+        "description": "aggregates the number of keys and the sizes of the values that start with the given prefix",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "getStats",
+        "parameters": [
+          {
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the statistics for the keys with the prefix",
+            "schema": {
+              "$ref": "#/definitions/stats"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/kv/{key}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "getEntry",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "If-None-Match",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "entry was found",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "The time this entry was last modified"
+              },
+              "X-Labels": {
+                "type": "string",
+                "description": "The labels of this entry as a comma separated list of name=value pairs"
+              },
+              "X-Last-Updated": {
+                "type": "integer",
+                "format": "int64",
+                "description": "The time this entry was last modified in unix nanoseconds, Last-Modified only has minutes"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "304": {
+            "description": "entry was found but not modified",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Last-Modified": {
+                "type": "string",
+                "description": "The time this entry was last modified"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "putEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when this is an update to an entry, then this field needs to be present",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "binds the entry to this session, the entry is removed or released when the session expires or gets destroyed. Entries that are bound to a session keep that binding when they are updated without one.",
+            "name": "session",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "replaces the labels of the entry with this comma separated list of name=value pairs, updates without labels keep the labels of the entry",
+            "name": "labels",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "entry was created",
+            "headers": {
+              "Etag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the newly created entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "entry was updated",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry or the entry is bound to another session",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "kv"
+        ],
+        "operationId": "deleteEntry",
+        "responses": {
+          "204": {
+            "description": "the delete was successful",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "applies a JSON merge patch (RFC 7386) or a JSON patch (RFC 6902) to the JSON document in the entry, the content type of the request selects the kind of patch",
+        "consumes": [
+          "application/merge-patch+json",
+          "application/json-patch+json"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "patchEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "entry was patched",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry, the entry doesn't hold a JSON document or the patch can't be applied to it",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv/{key}/_append": {
+      "post": {
+        "description": "atomically appends the body to the value of the entry, the entry is created when it doesn't exist yet. When the value grows beyond the max size the oldest bytes are trimmed, or the oldest records when a delimiter is given.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "kv"
+        ],
+        "operationId": "appendEntry",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "the maximum size of the value after appending, the oldest data is trimmed to fit",
+            "name": "maxSize",
+            "in": "query"
+          },
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "separates the records in the value, trimming then removes whole records. When the newest record doesn't fit in the max size on its own the value is trimmed by bytes.",
+            "name": "delimiter",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the body was appended to the entry",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv/{key}/_copy": {
+      "post": {
+        "description": "copies the entry to the destination key in a single atomic write",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "copyEntry",
+        "parameters": [
+          {
+            "minLength": 1,
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "description": "The key to copy or move the entry to, this is a key prefix when prefix is true",
+            "name": "destination",
+            "in": "query",
+            "required": true
+          },
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the source entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "pattern": "^[0-9]+$",
+            "type": "string",
+            "description": "when present the destination entry needs to have this version, 0 requires the destination to not exist",
+            "name": "destinationVersion",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "treat the key and the destination as prefixes and transfer all the entries below them",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the entries below the prefix were copied",
+            "schema": {
+              "$ref": "#/definitions/transferResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "201": {
+            "description": "the entry was copied",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the destination entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the destination entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the source or the destination entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The destination entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv/{key}/_incr": {
+      "post": {
+        "description": "atomically adds the delta to the counter stored in the entry, when the entry doesn't exist yet the counter starts at the initial value. The counter is stored as a base 10 int64.",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "incrEntry",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "the amount to add to the counter, use a negative value to decrement",
+            "name": "delta",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "the value the counter starts at when the entry doesn't exist yet",
+            "name": "initial",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the counter was updated",
+            "schema": {
+              "$ref": "#/definitions/counter"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "the entry doesn't hold a counter or the counter would overflow",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv/{key}/_labels": {
+      "put": {
+        "description": "replaces the labels of the entry, the value stays the same and the version changes",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "setLabels",
+        "parameters": [
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/labelSet"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the labels were replaced",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of this entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "a label name or value is invalid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/kv/{key}/_move": {
+      "post": {
+        "description": "moves the entry to the destination key, the copy and the delete happen in a single atomic write",
+        "tags": [
+          "kv"
+        ],
+        "operationId": "moveEntry",
+        "parameters": [
+          {
+            "minLength": 1,
+            "pattern": "^[^\\x00]",
+            "type": "string",
+            "description": "The key to copy or move the entry to, this is a key prefix when prefix is true",
+            "name": "destination",
+            "in": "query",
+            "required": true
+          },
+          {
+            "pattern": "[0-9]*",
+            "type": "string",
+            "description": "when present the source entry needs to have this version",
+            "name": "If-Match",
+            "in": "header"
+          },
+          {
+            "pattern": "^[0-9]+$",
+            "type": "string",
+            "description": "when present the destination entry needs to have this version, 0 requires the destination to not exist",
+            "name": "destinationVersion",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "treat the key and the destination as prefixes and transfer all the entries below them",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the entries below the prefix were moved",
+            "schema": {
+              "$ref": "#/definitions/transferResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "201": {
+            "description": "the entry was moved",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "The version of the destination entry"
+              },
+              "Location": {
+                "type": "string",
+                "format": "uri",
+                "description": "the location to get the destination entry"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "there is a version mismatch for the source or the destination entry",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "410": {
+            "description": "The destination entry is deleted",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^\\x00]",
+          "type": "string",
+          "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+          "name": "key",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/locks/{name}": {
+      "get": {
+        "description": "reports the current holder of the lock",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "getLock",
+        "responses": {
+          "200": {
+            "description": "the current holder of the lock",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the lock, the lock is held for another ttl. In cluster mode the locks are not available and this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "renewLock",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The fencing token that was returned when the lock was acquired",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "acquires the lock for the ttl, every time the lock changes hands the fencing token increases. The lease depends on the clock of the member that serves it, so in cluster mode this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "acquireLock",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the one acquiring the lock",
+            "name": "holder",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 86400,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of seconds the lock is held without being renewed",
+            "name": "ttl",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for the lock to become available",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lock was acquired",
+            "schema": {
+              "$ref": "#/definitions/lock"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "releases the lock so someone else can acquire it. In cluster mode the locks are not available and this responds with 501.",
+        "tags": [
+          "locks"
+        ],
+        "operationId": "releaseLock",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "The fencing token that was returned when the lock was acquired",
+            "name": "token",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the lock was released",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The lock is held by someone else or the token is no longer valid",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the lock",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/merkle": {
+      "get": {
+        "description": "reads nodes of the merkle tree over the entries. The keys hash into the buckets at the bottom level, the hash of a bucket sums a hash of the key and the version of every entry in it. Every other node hashes its children, the children of node i are the nodes i*fanout up to (i+1)*fanout of the next level. Two stores that have the same root have the same entries.",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "getMerkleLevel",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The level to read, 0 is the root and depth is the level of the buckets",
+            "name": "level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "A comma separated list of the nodes of the level to read, all the nodes when this is empty",
+            "name": "nodes",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the nodes of the level",
+            "schema": {
+              "$ref": "#/definitions/merkleLevel"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/merkle/_keys": {
+      "get": {
+        "description": "lists the keys in the buckets with their versions and the time they were last updated",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "listMerkleKeys",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "A comma separated list of the buckets",
+            "name": "buckets",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the keys in the buckets sorted by key",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/merkleKey"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/merkle/_repair": {
+      "post": {
+        "description": "compares the merkle tree of this store with the one of the peer and copies the entries of the buckets that differ when the peer updated them later. The entries that only this store has stay, repairing the peer from this store as well makes both stores have the same entries.",
+        "tags": [
+          "merkle"
+        ],
+        "operationId": "repairFrom",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/repairRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the repair finished",
+            "schema": {
+              "$ref": "#/definitions/repairResult"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/queues/{name}": {
+      "get": {
+        "description": "reports the length of the queue",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "getQueue",
+        "responses": {
+          "200": {
+            "description": "the length of the queue",
+            "schema": {
+              "$ref": "#/definitions/queue"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "adds a message to the end of the queue. The messages get their ids and visibility from the member that serves them, so in cluster mode this responds with 501.",
+        "consumes": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "enqueueMessage",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string",
+              "format": "binary",
+              "maxLength": 536870912
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the message was added to the queue",
+            "headers": {
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the queue",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/queues/{name}/_dequeue": {
+      "post": {
+        "description": "takes the first visible message from the queue, the message stays invisible for the visibility timeout. When it isn't acked in that time the message becomes visible again. In cluster mode the queues are not available and this responds with 501.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "dequeueMessage",
+        "parameters": [
+          {
+            "maximum": 43200,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 30,
+            "description": "The number of seconds the message stays invisible",
+            "name": "visibility",
+            "in": "query"
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for a message when the queue is empty",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the message was dequeued",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Deliveries": {
+                "type": "string",
+                "description": "The number of times the message was dequeued"
+              },
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Receipt": {
+                "type": "string",
+                "description": "The receipt to ack or nack the message with"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "the queue has no visible messages",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the queue",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/queues/{name}/_peek": {
+      "get": {
+        "description": "gets the first visible message without dequeuing it",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "queues"
+        ],
+        "operationId": "peekMessage",
+        "responses": {
+          "200": {
+            "description": "the first visible message",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Deliveries": {
+                "type": "string",
+                "description": "The number of times the message was dequeued"
+              },
+              "X-Message-Id": {
+                "type": "string",
+                "description": "The id of the message"
+              },
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "204": {
+            "description": "the queue has no visible messages",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the queue",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/queues/{name}/{id}/_ack": {
+      "post": {
+        "description": "removes the dequeued message from the queue. In cluster mode the queues are not available and this responds with 501.",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "ackMessage",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The receipt that was returned when the message was dequeued",
+            "name": "receipt",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the message was removed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The message was dequeued again after the receipt was handed out",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the queue",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "minimum": 1,
+          "type": "integer",
+          "format": "int64",
+          "description": "The id of the message",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/queues/{name}/{id}/_nack": {
+      "post": {
+        "description": "puts the dequeued message back, it becomes visible again after the delay. In cluster mode the queues are not available and this responds with 501.",
+        "tags": [
+          "queues"
+        ],
+        "operationId": "nackMessage",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The receipt that was returned when the message was dequeued",
+            "name": "receipt",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 43200,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds before the message becomes visible again",
+            "name": "delay",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the message was put back",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "The message was dequeued again after the receipt was handed out",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the queue",
+          "name": "name",
+          "in": "path",
+          "required": true
+        },
+        {
+          "minimum": 1,
+          "type": "integer",
+          "format": "int64",
+          "description": "The id of the message",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/replication": {
+      "get": {
+        "description": "reports if this store is a primary or a follower, for a follower this includes how far it is behind the primary it follows",
+        "tags": [
+          "replication"
+        ],
+        "operationId": "getReplicationStatus",
+        "responses": {
+          "200": {
+            "description": "the replication status of this store",
+            "schema": {
+              "$ref": "#/definitions/replicationStatus"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/replication/_snapshot": {
+      "get": {
+        "description": "streams all the entries as msgpack, this starts with the sequence of the last change in the changelog followed by an entry as a put change for every entry. A follower restores it and then reads the changelog from the next sequence.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "replication"
+        ],
+        "operationId": "getSnapshot",
+        "responses": {
+          "200": {
+            "description": "the snapshot of the entries",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/search": {
+      "get": {
+        "description": "finds the entries that contain any of the words in the query, only the entries below the configured search prefixes are searchable. The entries with the rarest words and the most occurrences come first.",
+        "tags": [
+          "search"
+        ],
+        "operationId": "search",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The words to look for",
+            "name": "q",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "only finds the entries with a key that starts with the prefix",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "the maximum number of entries to return",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the matching entries with the best match first",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/searchResult"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "search is not enabled, there are no search prefixes configured",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/search/_rebuild": {
+      "post": {
+        "description": "replaces the search index with one built from the entries below the search prefixes, this is needed after changing the search prefixes. Writes wait until this is done.",
+        "tags": [
+          "search"
+        ],
+        "operationId": "rebuildSearch",
+        "responses": {
+          "200": {
+            "description": "the search index was rebuilt",
+            "schema": {
+              "$ref": "#/definitions/searchRebuild"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "search is not enabled, there are no search prefixes configured",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/semaphores/{name}": {
+      "get": {
+        "description": "reports the holders of the slots of the semaphore",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "getSemaphore",
+        "responses": {
+          "200": {
+            "description": "the semaphore with its current holders",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "renews the lease on the slot, the slot is held for another ttl. In cluster mode the semaphores are not available and this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "renewSlot",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the one holding a slot of the semaphore",
+            "name": "holder",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the lease was renewed",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "All the slots of the semaphore are taken, they are held with another limit or the holder no longer holds a slot",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "acquires a slot of the semaphore for the ttl, at most limit holders hold a slot at the same time. When the holder already holds a slot this renews it. The leases depend on the clock of the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "acquireSlot",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the one holding a slot of the semaphore",
+            "name": "holder",
+            "in": "query",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of slots of the semaphore, it is taken when none of the slots are held and has to match the limit of the semaphore while they are",
+            "name": "limit",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 86400,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "The number of seconds the slot is held without being renewed",
+            "name": "ttl",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 300,
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of seconds to wait for a slot to become available",
+            "name": "wait",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the slot was acquired",
+            "schema": {
+              "$ref": "#/definitions/semaphore"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "All the slots of the semaphore are taken, they are held with another limit or the holder no longer holds a slot",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "releases the slot so a waiting holder can acquire it. In cluster mode the semaphores are not available and this responds with 501.",
+        "tags": [
+          "semaphores"
+        ],
+        "operationId": "releaseSlot",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "identifies the one holding a slot of the semaphore",
+            "name": "holder",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "the slot was released",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "All the slots of the semaphore are taken, they are held with another limit or the holder no longer holds a slot",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the semaphore",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sequences/{name}/_next": {
+      "post": {
+        "description": "hands out the next ids of the sequence, the ids increase and are never handed out twice, not even after a restart. A sequence starts at 1. The blocks of ids are reserved by the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "sequences"
+        ],
+        "operationId": "nextIds",
+        "parameters": [
+          {
+            "maximum": 1000000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "The number of ids to hand out",
+            "name": "count",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the ids from first up to and including last are handed out",
+            "schema": {
+              "$ref": "#/definitions/idRange"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "409": {
+            "description": "the sequence would overflow",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sequence",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions": {
+      "get": {
+        "description": "lists the sessions that are alive",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "listSessions",
+        "responses": {
+          "200": {
+            "description": "the sessions that are alive",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/session"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "creates a session, the session stays alive as long as it gets renewed within its ttl. Sessions get random ids and expire by the clock of the member that serves them, so in cluster mode this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "createSession",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/sessionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "the session was created",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        }
+      ]
+    },
+    "/sessions/{id}": {
+      "get": {
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "getSession",
+        "responses": {
+          "200": {
+            "description": "the session was found",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "destroys the session, the entries bound to it get removed or released. In cluster mode the sessions are not available and this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "destroySession",
+        "responses": {
+          "204": {
+            "description": "the session was destroyed",
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "The id of the session",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/sessions/{id}/renew": {
+      "put": {
+        "description": "renews the session, it expires when it isn't renewed again within its ttl. In cluster mode the sessions are not available and this responds with 501.",
+        "tags": [
+          "sessions"
+        ],
+        "operationId": "renewSession",
+        "responses": {
+          "200": {
+            "description": "the session was renewed",
+            "schema": {
+              "$ref": "#/definitions/session"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "The id of the session",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}": {
+      "get": {
+        "description": "reports the number of members of the sorted set",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "getZset",
+        "responses": {
+          "200": {
+            "description": "the sorted set",
+            "schema": {
+              "$ref": "#/definitions/zset"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "adds the members to the sorted set, the members that are already in the set get the new score",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "addMembers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/zsetMembers"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members were added",
+            "schema": {
+              "$ref": "#/definitions/zsetCount"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "The score is not a finite number",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}/_incr": {
+      "post": {
+        "description": "adds the delta to the score of the member, a member that isn't in the set yet starts at 0",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "incrScore",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The member of the sorted set",
+            "name": "member",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The amount to add to the score",
+            "name": "delta",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the score was incremented",
+            "schema": {
+              "$ref": "#/definitions/zsetMember"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "400": {
+            "description": "The score is not a finite number",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}/_range": {
+      "get": {
+        "description": "lists the members from the start rank up to and including the stop rank, negative ranks count from the end so -1 is the last member",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "rangeByRank",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The rank of the first member",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "default": -1,
+            "description": "The rank of the last member",
+            "name": "stop",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "when true the members are ordered from the highest to the lowest score",
+            "name": "reverse",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members with their rank",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/zsetMember"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}/_rangebyscore": {
+      "get": {
+        "description": "lists the members with a score between min and max, both included, from the lowest to the highest score",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "rangeByScore",
+        "parameters": [
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The lowest score to include, without it the range starts at the first member",
+            "name": "min",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "double",
+            "description": "The highest score to include, without it the range ends at the last member",
+            "name": "max",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "default": 0,
+            "description": "The number of members in the range to skip",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "maximum": 10000,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 100,
+            "description": "The max number of members to list",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members in the range",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/zsetMember"
+              }
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}/_rank": {
+      "get": {
+        "description": "gets the score and the rank of the member, the rank of the member with the lowest score is 0",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "getRank",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "The member of the sorted set",
+            "name": "member",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "when true the members are ordered from the highest to the lowest score",
+            "name": "reverse",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the member with its rank",
+            "schema": {
+              "$ref": "#/definitions/zsetMember"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "404": {
+            "description": "The entry was not found",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/zsets/{name}/_remove": {
+      "post": {
+        "description": "removes the members from the sorted set",
+        "tags": [
+          "zsets"
+        ],
+        "operationId": "removeMembers",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/zsetRemoval"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the members were removed",
+            "schema": {
+              "$ref": "#/definitions/zsetCount"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          },
+          "default": {
+            "description": "Error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            },
+            "headers": {
+              "X-Request-Id": {
+                "type": "string",
+                "description": "The request id this is a response to"
+              }
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "minLength": 1,
+          "type": "string",
+          "description": "A unique UUID for the request",
+          "name": "X-Request-Id",
+          "in": "header"
+        },
+        {
+          "minLength": 1,
+          "pattern": "^[^/\\x00]+$",
+          "type": "string",
+          "description": "The name of the sorted set",
+          "name": "name",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "change": {
+      "type": "object",
+      "required": [
+        "revision",
+        "key",
+        "version",
+        "op"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "op": {
+          "description": "The kind of change",
+          "type": "string",
+          "enum": [
+            "put",
+            "delete"
+          ]
+        },
+        "revision": {
+          "description": "The revision of the change, the time it happened in unix nanoseconds made unique",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "description": "The version of the entry after the change, 0 when it was deleted",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "changeList": {
+      "type": "object",
+      "required": [
+        "changes",
+        "next"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/change"
+          }
+        },
+        "next": {
+          "description": "The revision to continue from to get the changes after these",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "changelog": {
+      "type": "object",
+      "required": [
+        "changes",
+        "first",
+        "next"
+      ],
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/changelogEntry"
+          }
+        },
+        "first": {
+          "description": "The sequence of the oldest change that is kept",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "next": {
+          "description": "The sequence to continue from to get the changes after these",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "changelogEntry": {
+      "type": "object",
+      "required": [
+        "sequence",
+        "revision",
+        "key",
+        "version",
+        "op"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "labels": {
+          "description": "The labels of the entry after a put",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "lastUpdated": {
+          "description": "The hybrid logical clock time of the put or the delete in unix nanoseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "op": {
+          "description": "The kind of change",
+          "type": "string",
+          "enum": [
+            "put",
+            "delete"
+          ]
+        },
+        "origin": {
+          "description": "The id of the store the put or the delete was made on",
+          "type": "string"
+        },
+        "revision": {
+          "description": "The revision of the change, the time it happened in unix nanoseconds made unique",
+          "type": "integer",
+          "format": "int64"
+        },
+        "sequence": {
+          "description": "The number of the change in the changelog",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "value": {
+          "description": "The value of the entry after a put",
+          "type": "string",
+          "format": "byte"
+        },
+        "version": {
+          "description": "The version of the entry after the change, 0 when it was deleted",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "clusterMember": {
+      "type": "object",
+      "required": [
+        "id",
+        "peerAddress",
+        "apiUrl"
+      ],
+      "properties": {
+        "apiUrl": {
+          "description": "The url of the api of the member, the writes get sent there when it leads",
+          "type": "string",
+          "minLength": 1
+        },
+        "id": {
+          "description": "The id of the member",
+          "type": "string",
+          "minLength": 1
+        },
+        "peerAddress": {
+          "description": "The host:port the member serves the raft requests of the other members at",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "clusterStatus": {
+      "type": "object",
+      "required": [
+        "id",
+        "state",
+        "term",
+        "commitIndex",
+        "appliedIndex",
+        "members"
+      ],
+      "properties": {
+        "appliedIndex": {
+          "description": "The index of the last entry this member applied to its store",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "commitIndex": {
+          "description": "The index of the last entry of the raft log this member knows is committed",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "id": {
+          "description": "The id of this member",
+          "type": "string"
+        },
+        "leader": {
+          "description": "The id of the leader this member knows about",
+          "type": "string"
+        },
+        "leaderUrl": {
+          "description": "The url of the api of the leader",
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/clusterMember"
+          }
+        },
+        "state": {
+          "description": "The raft state of this member",
+          "type": "string",
+          "enum": [
+            "follower",
+            "candidate",
+            "leader"
+          ]
+        },
+        "term": {
+          "description": "The current term",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "counter": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "description": "The value of the counter after the update",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "deleteResult": {
+      "type": "object",
+      "required": [
+        "deleted"
+      ],
+      "properties": {
+        "deleted": {
+          "description": "The number of entries that were removed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "entry": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "value": {
+          "description": "The JSON document of the entry, or the fields that were asked for"
+        }
+      }
+    },
+    "error": {
+      "description": "the error model is a model for all the error responses coming from kvstore\n",
+      "type": "object",
+      "required": [
+        "message",
+        "code"
+      ],
+      "properties": {
+        "cause": {
+          "$ref": "#/definitions/error"
+        },
+        "code": {
+          "description": "The error code",
+          "type": "integer",
+          "format": "int64"
+        },
+        "helpUrl": {
+          "description": "link to help page explaining the error in more detail",
+          "type": "string",
+          "format": "uri"
+        },
+        "message": {
+          "description": "The error message",
+          "type": "string"
+        }
+      }
+    },
+    "hash": {
+      "type": "object",
+      "required": [
+        "key",
+        "version",
+        "fields"
+      ],
+      "properties": {
+        "fields": {
+          "description": "The values of the fields by name",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "key": {
+          "description": "The key of the hash",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the hash, this changes every time a field changes",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "idRange": {
+      "type": "object",
+      "required": [
+        "first",
+        "last"
+      ],
+      "properties": {
+        "first": {
+          "description": "The first id that was handed out",
+          "type": "integer",
+          "format": "int64"
+        },
+        "last": {
+          "description": "The last id that was handed out",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "index": {
+      "type": "object",
+      "required": [
+        "name",
+        "prefix",
+        "pointer",
+        "ready"
+      ],
+      "properties": {
+        "name": {
+          "description": "The name of the index",
+          "type": "string"
+        },
+        "pointer": {
+          "description": "The JSON pointer to the indexed value in the entries",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "The index covers the entries whose key starts with this prefix",
+          "type": "string"
+        },
+        "ready": {
+          "description": "False while the entries that existed before the index was created are being indexed",
+          "type": "boolean"
+        }
+      }
+    },
+    "indexDefinition": {
+      "type": "object",
+      "required": [
+        "prefix",
+        "pointer"
+      ],
+      "properties": {
+        "pointer": {
+          "description": "The JSON pointer to the indexed value in the entries",
+          "type": "string",
+          "pattern": "^(/.*)?$"
+        },
+        "prefix": {
+          "description": "The index covers the entries whose key starts with this prefix",
+          "type": "string"
+        }
+      }
+    },
+    "indexMatches": {
+      "type": "object",
+      "required": [
+        "ready",
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "description": "The keys of the matching entries",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "ready": {
+          "description": "False while the entries that existed before the index was created are being indexed",
+          "type": "boolean"
+        }
+      }
+    },
+    "labelSet": {
+      "type": "object",
+      "required": [
+        "labels"
+      ],
+      "properties": {
+        "labels": {
+          "description": "The labels by name, names are 1 to 253 and values up to 63 letters, digits, '-', '_', '.' or '/'",
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "lock": {
+      "type": "object",
+      "required": [
+        "name",
+        "holder",
+        "token",
+        "ttl",
+        "expiresAt"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "The time the lock is released unless it gets renewed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "holder": {
+          "description": "The holder of the lock, for an election this is the leader",
+          "type": "string"
+        },
+        "name": {
+          "description": "The name of the lock",
+          "type": "string"
+        },
+        "token": {
+          "description": "The fencing token, this increases every time the lock changes hands",
+          "type": "integer",
+          "format": "int64"
+        },
+        "ttl": {
+          "description": "The number of seconds the lock is held without being renewed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "merkleKey": {
+      "type": "object",
+      "required": [
+        "key",
+        "version",
+        "lastUpdated"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "lastUpdated": {
+          "description": "The time of the last update of the entry in unix nanoseconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "origin": {
+          "description": "The id of the store the entry was written on",
+          "type": "string"
+        },
+        "version": {
+          "description": "The version of the entry",
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "merkleLevel": {
+      "type": "object",
+      "required": [
+        "depth",
+        "fanout",
+        "level",
+        "nodes"
+      ],
+      "properties": {
+        "depth": {
+          "description": "The level of the buckets",
+          "type": "integer",
+          "format": "int64"
+        },
+        "fanout": {
+          "description": "The number of children of a node",
+          "type": "integer",
+          "format": "int64"
+        },
+        "level": {
+          "description": "The level of the nodes",
+          "type": "integer",
+          "format": "int64"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/merkleNode"
+          }
+        }
+      }
+    },
+    "merkleNode": {
+      "type": "object",
+      "required": [
+        "index",
+        "hash"
+      ],
+      "properties": {
+        "hash": {
+          "description": "The hash of the node",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "index": {
+          "description": "The index of the node in its level",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "peerStatus": {
+      "type": "object",
+      "required": [
+        "url",
+        "sequence",
+        "state"
+      ],
+      "properties": {
+        "lag": {
+          "description": "The number of changes of the peer that still need to be merged",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastError": {
+          "description": "The error of the last attempt to read from the peer when it failed",
+          "type": "string"
+        },
+        "peerSequence": {
+          "description": "The sequence of the next change of the peer the last time its changelog was read",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "sequence": {
+          "description": "The sequence of the next change of the peer to merge",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "staleness": {
+          "description": "The time in milliseconds since all the changes of the peer were merged",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "What the store is doing with the changes of the peer",
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "streaming"
+          ]
+        },
+        "url": {
+          "description": "The url of the peer",
+          "type": "string"
+        }
+      }
+    },
+    "queue": {
+      "type": "object",
+      "required": [
+        "name",
+        "length",
+        "visible",
+        "inFlight"
+      ],
+      "properties": {
+        "inFlight": {
+          "description": "The number of messages that were dequeued but not acked yet",
+          "type": "integer",
+          "format": "int64"
+        },
+        "length": {
+          "description": "The number of messages in the queue",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the queue",
+          "type": "string"
+        },
+        "visible": {
+          "description": "The number of messages that can be dequeued",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "repairRequest": {
+      "type": "object",
+      "required": [
+        "peer"
+      ],
+      "properties": {
+        "peer": {
+          "description": "The url of the kvstored to repair from",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "repairResult": {
+      "type": "object",
+      "required": [
+        "buckets",
+        "copied",
+        "kept"
+      ],
+      "properties": {
+        "buckets": {
+          "description": "The number of buckets that differed",
+          "type": "integer",
+          "format": "int64"
+        },
+        "copied": {
+          "description": "The number of entries that were copied from the peer",
+          "type": "integer",
+          "format": "int64"
+        },
+        "kept": {
+          "description": "The number of entries that differed but were updated later in this store",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "replicationStatus": {
+      "type": "object",
+      "required": [
+        "role",
+        "sequence"
+      ],
+      "properties": {
+        "lag": {
+          "description": "The number of changes of the primary the follower still needs to apply",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "lastError": {
+          "description": "The error of the last attempt to read from the primary when it failed",
+          "type": "string"
+        },
+        "nodeId": {
+          "description": "The id of this store, it is the origin of the writes made on it",
+          "type": "string"
+        },
+        "peers": {
+          "description": "How far a peer is behind each of its peers",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerStatus"
+          }
+        },
+        "primary": {
+          "description": "The url of the primary this store follows",
+          "type": "string"
+        },
+        "primarySequence": {
+          "description": "The sequence of the next change of the primary the last time the follower read its changelog",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "role": {
+          "description": "The role of this store, a peer takes writes and merges the changes of its peers",
+          "type": "string",
+          "enum": [
+            "primary",
+            "follower",
+            "peer"
+          ]
+        },
+        "sequence": {
+          "description": "The sequence of the next change, for a follower this is the next change of the primary it applies",
+          "type": "integer",
+          "format": "uint64"
+        },
+        "staleness": {
+          "description": "The time in milliseconds since the follower last applied all the changes of the primary, the reads of the follower can miss the writes of this period",
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "description": "What the follower is doing",
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "streaming"
+          ]
+        }
+      }
+    },
+    "searchRebuild": {
+      "type": "object",
+      "required": [
+        "indexed"
+      ],
+      "properties": {
+        "indexed": {
+          "description": "The number of entries in the search index",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "searchResult": {
+      "type": "object",
+      "required": [
+        "key",
+        "score",
+        "snippet"
+      ],
+      "properties": {
+        "key": {
+          "description": "The key of the entry",
+          "type": "string"
+        },
+        "score": {
+          "description": "How well the entry matches, higher is better",
+          "type": "number",
+          "format": "double"
+        },
+        "snippet": {
+          "description": "The text around the first word that was found",
+          "type": "string"
+        }
+      }
+    },
+    "semaphore": {
+      "type": "object",
+      "required": [
+        "name",
+        "limit",
+        "holders"
+      ],
+      "properties": {
+        "holders": {
+          "description": "The holders of the slots",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/slot"
+          }
+        },
+        "limit": {
+          "description": "The number of slots of the semaphore",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the semaphore",
+          "type": "string"
+        }
+      }
+    },
+    "session": {
+      "type": "object",
+      "required": [
+        "id",
+        "ttl",
+        "behavior",
+        "expiresAt"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "What happens to the entries bound to the session when it ends",
+          "type": "string",
+          "enum": [
+            "delete",
+            "release"
+          ]
+        },
+        "expiresAt": {
+          "description": "The time the session expires unless it gets renewed",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "description": "The id of the session",
+          "type": "string"
+        },
+        "name": {
+          "description": "A name to recognize the session by",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the session stays alive without being renewed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "sessionRequest": {
+      "type": "object",
+      "required": [
+        "ttl"
+      ],
+      "properties": {
+        "behavior": {
+          "description": "What happens to the entries bound to the session when it ends, this defaults to delete",
           "type": "string",
-          "description": "A unique UUID for the request",
-          "name": "X-Request-Id",
-          "in": "header"
+          "enum": [
+            "delete",
+            "release"
+          ]
         },
-        {
-          "minLength": 1,
+        "name": {
+          "description": "A name to recognize the session by",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the session stays alive without being renewed",
+          "type": "integer",
+          "format": "int64",
+          "maximum": 86400,
+          "minimum": 1
+        }
+      }
+    },
+    "slot": {
+      "type": "object",
+      "required": [
+        "holder",
+        "ttl",
+        "expiresAt"
+      ],
+      "properties": {
+        "expiresAt": {
+          "description": "The time the slot is released unless it gets renewed",
           "type": "string",
-          "description": "The key for a given entry",
-          "name": "key",
-          "in": "path",
-          "required": true
+          "format": "date-time"
+        },
+        "holder": {
+          "description": "The holder of the slot",
+          "type": "string"
+        },
+        "ttl": {
+          "description": "The number of seconds the slot is held without being renewed",
+          "type": "integer",
+          "format": "int64"
         }
-      ]
-    }
-  },
-  "definitions": {
-    "error": {
-      "description": "the error model is a model for all the error responses coming from kvstore\n",
+      }
+    },
+    "stats": {
       "type": "object",
       "required": [
-        "message",
-        "code"
+        "keys",
+        "totalBytes",
+        "diskBytes"
       ],
       "properties": {
-        "cause": {
-          "$ref": "#/definitions/error"
+        "avgSize": {
+          "description": "The average size of the values",
+          "type": "number",
+          "format": "double"
         },
-        "code": {
-          "description": "The error code",
+        "diskBytes": {
+          "description": "The approximate number of bytes the entries take up on disk",
           "type": "integer",
           "format": "int64"
         },
-        "helpUrl": {
-          "description": "link to help page explaining the error in more detail",
+        "keys": {
+          "description": "The number of keys with the prefix",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxSize": {
+          "description": "The size of the largest value",
+          "type": "integer",
+          "format": "int64"
+        },
+        "minSize": {
+          "description": "The size of the smallest value",
+          "type": "integer",
+          "format": "int64"
+        },
+        "newest": {
+          "description": "The newest time one of the entries was last updated",
           "type": "string",
-          "format": "uri"
+          "format": "date-time"
         },
-        "message": {
-          "description": "The error message",
+        "oldest": {
+          "description": "The oldest time one of the entries was last updated",
+          "type": "string",
+          "format": "date-time"
+        },
+        "totalBytes": {
+          "description": "The sum of the sizes of the values",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "transferResult": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of entries that were copied or moved",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "zset": {
+      "type": "object",
+      "required": [
+        "name",
+        "card"
+      ],
+      "properties": {
+        "card": {
+          "description": "The number of members of the sorted set",
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "description": "The name of the sorted set",
           "type": "string"
         }
       }
+    },
+    "zsetCount": {
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "description": "The number of members that were added or removed",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "zsetMember": {
+      "type": "object",
+      "required": [
+        "member",
+        "score"
+      ],
+      "properties": {
+        "member": {
+          "description": "The member of the sorted set",
+          "type": "string",
+          "minLength": 1
+        },
+        "rank": {
+          "description": "The position of the member in the sorted set, starting at 0",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "score": {
+          "description": "The score the members are ordered by",
+          "type": "number",
+          "format": "double"
+        }
+      }
+    },
+    "zsetMembers": {
+      "type": "object",
+      "required": [
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "description": "The members with their score",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zsetMember"
+          }
+        }
+      }
+    },
+    "zsetRemoval": {
+      "type": "object",
+      "required": [
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "description": "The members to remove",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     }
   },
   "parameters": {
+    "destination": {
+      "minLength": 1,
+      "pattern": "^[^\\x00]",
+      "type": "string",
+      "description": "The key to copy or move the entry to, this is a key prefix when prefix is true",
+      "name": "destination",
+      "in": "query",
+      "required": true
+    },
+    "destinationVersion": {
+      "pattern": "^[0-9]+$",
+      "type": "string",
+      "description": "when present the destination entry needs to have this version, 0 requires the destination to not exist",
+      "name": "destinationVersion",
+      "in": "query"
+    },
     "entryKey": {
       "minLength": 1,
+      "pattern": "^[^\\x00]",
+      "type": "string",
+      "description": "The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key\n",
+      "name": "key",
+      "in": "path",
+      "required": true
+    },
+    "fieldVersion": {
+      "pattern": "[0-9]*",
+      "type": "string",
+      "description": "when present the field needs to have this version, a version of 0 requires the field to not exist",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "hashField": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The name of the field",
+      "name": "field",
+      "in": "path",
+      "required": true
+    },
+    "hashKey": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
       "type": "string",
-      "description": "The key for a given entry",
+      "description": "The key of the hash",
       "name": "key",
       "in": "path",
       "required": true
     },
+    "indexName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the index",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "lockName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the lock",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "lockTTL": {
+      "maximum": 86400,
+      "minimum": 1,
+      "type": "integer",
+      "format": "int64",
+      "description": "The number of seconds the lock is held without being renewed",
+      "name": "ttl",
+      "in": "query",
+      "required": true
+    },
+    "lockToken": {
+      "type": "integer",
+      "format": "int64",
+      "description": "The fencing token that was returned when the lock was acquired",
+      "name": "token",
+      "in": "query",
+      "required": true
+    },
+    "lockWait": {
+      "maximum": 300,
+      "minimum": 0,
+      "type": "integer",
+      "format": "int64",
+      "default": 0,
+      "description": "The number of seconds to wait for the lock to become available",
+      "name": "wait",
+      "in": "query"
+    },
+    "messageId": {
+      "minimum": 1,
+      "type": "integer",
+      "format": "int64",
+      "description": "The id of the message",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "prefixTransfer": {
+      "type": "boolean",
+      "default": false,
+      "description": "treat the key and the destination as prefixes and transfer all the entries below them",
+      "name": "prefix",
+      "in": "query"
+    },
+    "queueName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the queue",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "receipt": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The receipt that was returned when the message was dequeued",
+      "name": "receipt",
+      "in": "query",
+      "required": true
+    },
     "requestId": {
       "minLength": 1,
       "type": "string",
       "description": "A unique UUID for the request",
       "name": "X-Request-Id",
       "in": "header"
+    },
+    "semaphoreHolder": {
+      "minLength": 1,
+      "type": "string",
+      "description": "identifies the one holding a slot of the semaphore",
+      "name": "holder",
+      "in": "query",
+      "required": true
+    },
+    "semaphoreName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the semaphore",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "sessionId": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The id of the session",
+      "name": "id",
+      "in": "path",
+      "required": true
+    },
+    "sourceVersion": {
+      "pattern": "[0-9]*",
+      "type": "string",
+      "description": "when present the source entry needs to have this version",
+      "name": "If-Match",
+      "in": "header"
+    },
+    "zsetMember": {
+      "minLength": 1,
+      "type": "string",
+      "description": "The member of the sorted set",
+      "name": "member",
+      "in": "query",
+      "required": true
+    },
+    "zsetName": {
+      "minLength": 1,
+      "pattern": "^[^/\\x00]+$",
+      "type": "string",
+      "description": "The name of the sorted set",
+      "name": "name",
+      "in": "path",
+      "required": true
+    },
+    "zsetReverse": {
+      "type": "boolean",
+      "default": false,
+      "description": "when true the members are ordered from the highest to the lowest score",
+      "name": "reverse",
+      "in": "query"
     }
   },
   "responses": {
@@ -727,6 +10134,66 @@ func init() {
           "description": "The request id this is a response to"
         }
       }
+    },
+    "invalidScore": {
+      "description": "The score is not a finite number",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "lockHeld": {
+      "description": "The lock is held by someone else or the token is no longer valid",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "receiptMismatch": {
+      "description": "The message was dequeued again after the receipt was handed out",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "searchDisabled": {
+      "description": "search is not enabled, there are no search prefixes configured",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
+    },
+    "semaphoreFull": {
+      "description": "All the slots of the semaphore are taken, they are held with another limit or the holder no longer holds a slot",
+      "schema": {
+        "$ref": "#/definitions/error"
+      },
+      "headers": {
+        "X-Request-Id": {
+          "type": "string",
+          "description": "The request id this is a response to"
+        }
+      }
     }
   }
 }`))
@@ -36,9 +36,10 @@ type DeleteEntryParams struct {
 	  In: header
 	*/
 	XRequestID *string
-	/*The key for a given entry
+	/*The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key
 	  Required: true
 	  Min Length: 1
+	  Pattern: ^[^\x00]
 	  In: path
 	*/
 	Key string
@@ -126,5 +127,9 @@ func (o *DeleteEntryParams) validateKey(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.Pattern("key", "path", o.Key, `^[^\x00]`); err != nil {
+		return err
+	}
+
 	return nil
 }
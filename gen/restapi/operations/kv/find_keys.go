@@ -31,7 +31,7 @@ func NewFindKeys(ctx *middleware.Context, handler FindKeysHandler) *FindKeys {
 
 /*FindKeys swagger:route GET /kv kv findKeys
 
-lists all the keys
+lists all the keys, when a delimiter is given the keys that contain the delimiter after the prefix are rolled up into a single common prefix that ends with the delimiter, like a directory listing
 
 */
 type FindKeys struct {
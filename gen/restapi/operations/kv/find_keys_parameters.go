@@ -11,6 +11,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -37,10 +38,41 @@ type FindKeysParams struct {
 	  In: header
 	*/
 	XRequestID *string
+	/*groups the keys below the prefix by the first occurrence of the delimiter
+	  Min Length: 1
+	  In: query
+	*/
+	Delimiter *string
+	/*lists the entries that hold a JSON document instead of the keys, a comma separated list of JSON pointers, the values only hold these fields at the same place
+	  Min Length: 1
+	  In: query
+	*/
+	Fields *string
+	/*lists the entries that hold a JSON document and match this expression instead of the keys, it compares the values JSON pointers point at with JSON literals using =, !=, <, <=, >, >=, in (...) and exists, combined with and, or, not and parentheses. For example /city = "paris" and (/age >= 18 or exists /admin). The filter is evaluated while iterating over the entries, the entries that aren't JSON documents are skipped
+	  Min Length: 1
+	  In: query
+	*/
+	Filter *string
+	/*the maximum number of entries to list when a filter or fields are given
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*only lists the keys of the entries that were written at or after this revision or RFC 3339 time
+	  Min Length: 1
+	  In: query
+	*/
+	ModifiedSince *string
 	/*
+	  Pattern: ^[^\x00]
 	  In: query
 	*/
 	Prefix *string
+	/*only lists the keys of the entries with labels that match the selector, a comma separated list of name, !name, name=value, name!=value, name in (values) and name notin (values) requirements. For example env=prod,tier!=cache
+	  Min Length: 1
+	  In: query
+	*/
+	Selector *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -58,11 +90,41 @@ func (o *FindKeysParams) BindRequest(r *http.Request, route *middleware.MatchedR
 		res = append(res, err)
 	}
 
+	qDelimiter, qhkDelimiter, _ := qs.GetOK("delimiter")
+	if err := o.bindDelimiter(qDelimiter, qhkDelimiter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFields, qhkFields, _ := qs.GetOK("fields")
+	if err := o.bindFields(qFields, qhkFields, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qFilter, qhkFilter, _ := qs.GetOK("filter")
+	if err := o.bindFilter(qFilter, qhkFilter, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qModifiedSince, qhkModifiedSince, _ := qs.GetOK("modifiedSince")
+	if err := o.bindModifiedSince(qModifiedSince, qhkModifiedSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qPrefix, qhkPrefix, _ := qs.GetOK("prefix")
 	if err := o.bindPrefix(qPrefix, qhkPrefix, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qSelector, qhkSelector, _ := qs.GetOK("selector")
+	if err := o.bindSelector(qSelector, qhkSelector, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -101,6 +163,170 @@ func (o *FindKeysParams) validateXRequestID(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindDelimiter binds and validates parameter Delimiter from query.
+func (o *FindKeysParams) bindDelimiter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Delimiter = &raw
+
+	if err := o.validateDelimiter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDelimiter carries on validations for parameter Delimiter
+func (o *FindKeysParams) validateDelimiter(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("delimiter", "query", (*o.Delimiter), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindFields binds and validates parameter Fields from query.
+func (o *FindKeysParams) bindFields(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Fields = &raw
+
+	if err := o.validateFields(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFields carries on validations for parameter Fields
+func (o *FindKeysParams) validateFields(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("fields", "query", (*o.Fields), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindFilter binds and validates parameter Filter from query.
+func (o *FindKeysParams) bindFilter(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Filter = &raw
+
+	if err := o.validateFilter(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateFilter carries on validations for parameter Filter
+func (o *FindKeysParams) validateFilter(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("filter", "query", (*o.Filter), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *FindKeysParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *FindKeysParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", int64((*o.Limit)), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindModifiedSince binds and validates parameter ModifiedSince from query.
+func (o *FindKeysParams) bindModifiedSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.ModifiedSince = &raw
+
+	if err := o.validateModifiedSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateModifiedSince carries on validations for parameter ModifiedSince
+func (o *FindKeysParams) validateModifiedSince(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("modifiedSince", "query", (*o.ModifiedSince), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindPrefix binds and validates parameter Prefix from query.
 func (o *FindKeysParams) bindPrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -116,5 +342,51 @@ func (o *FindKeysParams) bindPrefix(rawData []string, hasKey bool, formats strfm
 
 	o.Prefix = &raw
 
+	if err := o.validatePrefix(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePrefix carries on validations for parameter Prefix
+func (o *FindKeysParams) validatePrefix(formats strfmt.Registry) error {
+
+	if err := validate.Pattern("prefix", "query", (*o.Prefix), `^[^\x00]`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSelector binds and validates parameter Selector from query.
+func (o *FindKeysParams) bindSelector(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Selector = &raw
+
+	if err := o.validateSelector(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSelector carries on validations for parameter Selector
+func (o *FindKeysParams) validateSelector(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("selector", "query", (*o.Selector), 1); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -16,7 +16,7 @@ import (
 // FindKeysOKCode is the HTTP code returned for type FindKeysOK
 const FindKeysOKCode int = 200
 
-/*FindKeysOK list the keys known to this datastore
+/*FindKeysOK list the keys known to this datastore, or the entries in key order with their keys and values when a filter or fields are given
 
 swagger:response findKeysOK
 */
@@ -29,7 +29,7 @@ type FindKeysOK struct {
 	/*
 	  In: Body
 	*/
-	Payload []string `json:"body,omitempty"`
+	Payload []interface{} `json:"body,omitempty"`
 }
 
 // NewFindKeysOK creates FindKeysOK with default headers values
@@ -50,13 +50,13 @@ func (o *FindKeysOK) SetXRequestID(xRequestID string) {
 }
 
 // WithPayload adds the payload to the find keys o k response
-func (o *FindKeysOK) WithPayload(payload []string) *FindKeysOK {
+func (o *FindKeysOK) WithPayload(payload []interface{}) *FindKeysOK {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the find keys o k response
-func (o *FindKeysOK) SetPayload(payload []string) {
+func (o *FindKeysOK) SetPayload(payload []interface{}) {
 	o.Payload = payload
 }
 
@@ -73,7 +73,7 @@ func (o *FindKeysOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
-		payload = make([]string, 0, 50)
+		payload = make([]interface{}, 0, 50)
 	}
 
 	if err := producer.Produce(rw, payload); err != nil {
@@ -82,6 +82,72 @@ func (o *FindKeysOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 
 }
 
+// FindKeysBadRequestCode is the HTTP code returned for type FindKeysBadRequest
+const FindKeysBadRequestCode int = 400
+
+/*FindKeysBadRequest the selector, the time, the filter or the fields are malformed, or a delimiter is given together with a filter or fields
+
+swagger:response findKeysBadRequest
+*/
+type FindKeysBadRequest struct {
+	/*The request id this is a response to
+
+	 */
+	XRequestID string `json:"X-Request-Id"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewFindKeysBadRequest creates FindKeysBadRequest with default headers values
+func NewFindKeysBadRequest() *FindKeysBadRequest {
+
+	return &FindKeysBadRequest{}
+}
+
+// WithXRequestID adds the xRequestId to the find keys bad request response
+func (o *FindKeysBadRequest) WithXRequestID(xRequestID string) *FindKeysBadRequest {
+	o.XRequestID = xRequestID
+	return o
+}
+
+// SetXRequestID sets the xRequestId to the find keys bad request response
+func (o *FindKeysBadRequest) SetXRequestID(xRequestID string) {
+	o.XRequestID = xRequestID
+}
+
+// WithPayload adds the payload to the find keys bad request response
+func (o *FindKeysBadRequest) WithPayload(payload *models.Error) *FindKeysBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the find keys bad request response
+func (o *FindKeysBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *FindKeysBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header X-Request-Id
+
+	xRequestID := o.XRequestID
+	if xRequestID != "" {
+		rw.Header().Set("X-Request-Id", xRequestID)
+	}
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*FindKeysDefault Error
 
 swagger:response findKeysDefault
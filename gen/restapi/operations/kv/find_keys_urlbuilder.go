@@ -9,11 +9,19 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // FindKeysURL generates an URL for the find keys operation
 type FindKeysURL struct {
-	Prefix *string
+	Delimiter     *string
+	Fields        *string
+	Filter        *string
+	Limit         *int64
+	ModifiedSince *string
+	Prefix        *string
+	Selector      *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -46,6 +54,46 @@ func (o *FindKeysURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var delimiter string
+	if o.Delimiter != nil {
+		delimiter = *o.Delimiter
+	}
+	if delimiter != "" {
+		qs.Set("delimiter", delimiter)
+	}
+
+	var fields string
+	if o.Fields != nil {
+		fields = *o.Fields
+	}
+	if fields != "" {
+		qs.Set("fields", fields)
+	}
+
+	var filter string
+	if o.Filter != nil {
+		filter = *o.Filter
+	}
+	if filter != "" {
+		qs.Set("filter", filter)
+	}
+
+	var limit string
+	if o.Limit != nil {
+		limit = swag.FormatInt64(*o.Limit)
+	}
+	if limit != "" {
+		qs.Set("limit", limit)
+	}
+
+	var modifiedSince string
+	if o.ModifiedSince != nil {
+		modifiedSince = *o.ModifiedSince
+	}
+	if modifiedSince != "" {
+		qs.Set("modifiedSince", modifiedSince)
+	}
+
 	var prefix string
 	if o.Prefix != nil {
 		prefix = *o.Prefix
@@ -54,6 +102,14 @@ func (o *FindKeysURL) Build() (*url.URL, error) {
 		qs.Set("prefix", prefix)
 	}
 
+	var selector string
+	if o.Selector != nil {
+		selector = *o.Selector
+	}
+	if selector != "" {
+		qs.Set("selector", selector)
+	}
+
 	result.RawQuery = qs.Encode()
 
 	return &result, nil
@@ -10,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	models "github.com/go-openapi/kvstore/gen/models"
 )
@@ -30,6 +31,14 @@ type GetEntryOK struct {
 
 	 */
 	LastModified string `json:"Last-Modified"`
+	/*The labels of this entry as a comma separated list of name=value pairs
+
+	 */
+	XLabels string `json:"X-Labels"`
+	/*The time this entry was last modified in unix nanoseconds, Last-Modified only has minutes
+
+	 */
+	XLastUpdated int64 `json:"X-Last-Updated"`
 	/*The request id this is a response to
 
 	 */
@@ -69,6 +78,28 @@ func (o *GetEntryOK) SetLastModified(lastModified string) {
 	o.LastModified = lastModified
 }
 
+// WithXLabels adds the xLabels to the get entry o k response
+func (o *GetEntryOK) WithXLabels(xLabels string) *GetEntryOK {
+	o.XLabels = xLabels
+	return o
+}
+
+// SetXLabels sets the xLabels to the get entry o k response
+func (o *GetEntryOK) SetXLabels(xLabels string) {
+	o.XLabels = xLabels
+}
+
+// WithXLastUpdated adds the xLastUpdated to the get entry o k response
+func (o *GetEntryOK) WithXLastUpdated(xLastUpdated int64) *GetEntryOK {
+	o.XLastUpdated = xLastUpdated
+	return o
+}
+
+// SetXLastUpdated sets the xLastUpdated to the get entry o k response
+func (o *GetEntryOK) SetXLastUpdated(xLastUpdated int64) {
+	o.XLastUpdated = xLastUpdated
+}
+
 // WithXRequestID adds the xRequestId to the get entry o k response
 func (o *GetEntryOK) WithXRequestID(xRequestID string) *GetEntryOK {
 	o.XRequestID = xRequestID
@@ -108,6 +139,20 @@ func (o *GetEntryOK) WriteResponse(rw http.ResponseWriter, producer runtime.Prod
 		rw.Header().Set("Last-Modified", lastModified)
 	}
 
+	// response header X-Labels
+
+	xLabels := o.XLabels
+	if xLabels != "" {
+		rw.Header().Set("X-Labels", xLabels)
+	}
+
+	// response header X-Last-Updated
+
+	xLastUpdated := swag.FormatInt64(o.XLastUpdated)
+	if xLastUpdated != "" {
+		rw.Header().Set("X-Last-Updated", xLastUpdated)
+	}
+
 	// response header X-Request-Id
 
 	xRequestID := o.XRequestID
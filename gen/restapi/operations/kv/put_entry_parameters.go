@@ -49,12 +49,22 @@ type PutEntryParams struct {
 	  In: body
 	*/
 	Body io.ReadCloser
-	/*The key for a given entry
+	/*The key for a given entry, this can contain slashes to create a hierarchy. When the last segment of the key is the name of an operation on an entry, like _copy, _move, _incr, _append or _labels, the slashes in the key need to be escaped as %2F, otherwise the path addresses that operation on the parent key
 	  Required: true
 	  Min Length: 1
+	  Pattern: ^[^\x00]
 	  In: path
 	*/
 	Key string
+	/*replaces the labels of the entry with this comma separated list of name=value pairs, updates without labels keep the labels of the entry
+	  In: query
+	*/
+	Labels *string
+	/*binds the entry to this session, the entry is removed or released when the session expires or gets destroyed. Entries that are bound to a session keep that binding when they are updated without one.
+	  Min Length: 1
+	  In: query
+	*/
+	Session *string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -66,6 +76,8 @@ func (o *PutEntryParams) BindRequest(r *http.Request, route *middleware.MatchedR
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	if err := o.bindIfMatch(r.Header[http.CanonicalHeaderKey("If-Match")], true, route.Formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +96,16 @@ func (o *PutEntryParams) BindRequest(r *http.Request, route *middleware.MatchedR
 		res = append(res, err)
 	}
 
+	qLabels, qhkLabels, _ := qs.GetOK("labels")
+	if err := o.bindLabels(qLabels, qhkLabels, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSession, qhkSession, _ := qs.GetOK("session")
+	if err := o.bindSession(qSession, qhkSession, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -180,5 +202,59 @@ func (o *PutEntryParams) validateKey(formats strfmt.Registry) error {
 		return err
 	}
 
+	if err := validate.Pattern("key", "path", o.Key, `^[^\x00]`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindLabels binds and validates parameter Labels from query.
+func (o *PutEntryParams) bindLabels(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Labels = &raw
+
+	return nil
+}
+
+// bindSession binds and validates parameter Session from query.
+func (o *PutEntryParams) bindSession(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.Session = &raw
+
+	if err := o.validateSession(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSession carries on validations for parameter Session
+func (o *PutEntryParams) validateSession(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("session", "query", (*o.Session), 1); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -241,7 +241,7 @@ func (o *PutEntryNotFound) WriteResponse(rw http.ResponseWriter, producer runtim
 // PutEntryConflictCode is the HTTP code returned for type PutEntryConflict
 const PutEntryConflictCode int = 409
 
-/*PutEntryConflict there is a version mismatch for the entry
+/*PutEntryConflict there is a version mismatch for the entry or the entry is bound to another session
 
 swagger:response putEntryConflict
 */
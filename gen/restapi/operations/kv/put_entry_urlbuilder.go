@@ -16,6 +16,9 @@ import (
 type PutEntryURL struct {
 	Key string
 
+	Labels  *string
+	Session *string
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -52,6 +55,26 @@ func (o *PutEntryURL) Build() (*url.URL, error) {
 	_basePath := o._basePath
 	result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var labels string
+	if o.Labels != nil {
+		labels = *o.Labels
+	}
+	if labels != "" {
+		qs.Set("labels", labels)
+	}
+
+	var session string
+	if o.Session != nil {
+		session = *o.Session
+	}
+	if session != "" {
+		qs.Set("session", session)
+	}
+
+	result.RawQuery = qs.Encode()
+
 	return &result, nil
 }
 
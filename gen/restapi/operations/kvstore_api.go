@@ -19,7 +19,21 @@ import (
 	strfmt "github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/go-openapi/kvstore/gen/restapi/operations/changelog"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/cluster"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/elections"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/hashes"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/indexes"
 	"github.com/go-openapi/kvstore/gen/restapi/operations/kv"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/locks"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/merkle"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/queues"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/replication"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/search"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/semaphores"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sequences"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/sessions"
+	"github.com/go-openapi/kvstore/gen/restapi/operations/zsets"
 )
 
 // NewKvstoreAPI creates a new Kvstore instance
@@ -41,18 +55,195 @@ func NewKvstoreAPI(spec *loads.Document) *KvstoreAPI {
 		BinConsumer:         runtime.ByteStreamConsumer(),
 		JSONProducer:        runtime.JSONProducer(),
 		BinProducer:         runtime.ByteStreamProducer(),
+		QueuesAckMessageHandler: queues.AckMessageHandlerFunc(func(params queues.AckMessageParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesAckMessage has not yet been implemented")
+		}),
+		LocksAcquireLockHandler: locks.AcquireLockHandlerFunc(func(params locks.AcquireLockParams) middleware.Responder {
+			return middleware.NotImplemented("operation LocksAcquireLock has not yet been implemented")
+		}),
+		SemaphoresAcquireSlotHandler: semaphores.AcquireSlotHandlerFunc(func(params semaphores.AcquireSlotParams) middleware.Responder {
+			return middleware.NotImplemented("operation SemaphoresAcquireSlot has not yet been implemented")
+		}),
+		ClusterAddClusterMemberHandler: cluster.AddClusterMemberHandlerFunc(func(params cluster.AddClusterMemberParams) middleware.Responder {
+			return middleware.NotImplemented("operation ClusterAddClusterMember has not yet been implemented")
+		}),
+		ZsetsAddMembersHandler: zsets.AddMembersHandlerFunc(func(params zsets.AddMembersParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsAddMembers has not yet been implemented")
+		}),
+		KvAppendEntryHandler: kv.AppendEntryHandlerFunc(func(params kv.AppendEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvAppendEntry has not yet been implemented")
+		}),
+		ElectionsCampaignHandler: elections.CampaignHandlerFunc(func(params elections.CampaignParams) middleware.Responder {
+			return middleware.NotImplemented("operation ElectionsCampaign has not yet been implemented")
+		}),
+		KvCopyEntryHandler: kv.CopyEntryHandlerFunc(func(params kv.CopyEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvCopyEntry has not yet been implemented")
+		}),
+		IndexesCreateIndexHandler: indexes.CreateIndexHandlerFunc(func(params indexes.CreateIndexParams) middleware.Responder {
+			return middleware.NotImplemented("operation IndexesCreateIndex has not yet been implemented")
+		}),
+		SessionsCreateSessionHandler: sessions.CreateSessionHandlerFunc(func(params sessions.CreateSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation SessionsCreateSession has not yet been implemented")
+		}),
 		KvDeleteEntryHandler: kv.DeleteEntryHandlerFunc(func(params kv.DeleteEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation KvDeleteEntry has not yet been implemented")
 		}),
+		HashesDeleteFieldHandler: hashes.DeleteFieldHandlerFunc(func(params hashes.DeleteFieldParams) middleware.Responder {
+			return middleware.NotImplemented("operation HashesDeleteField has not yet been implemented")
+		}),
+		KvDeleteKeysHandler: kv.DeleteKeysHandlerFunc(func(params kv.DeleteKeysParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvDeleteKeys has not yet been implemented")
+		}),
+		QueuesDequeueMessageHandler: queues.DequeueMessageHandlerFunc(func(params queues.DequeueMessageParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesDequeueMessage has not yet been implemented")
+		}),
+		SessionsDestroySessionHandler: sessions.DestroySessionHandlerFunc(func(params sessions.DestroySessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation SessionsDestroySession has not yet been implemented")
+		}),
+		IndexesDropIndexHandler: indexes.DropIndexHandlerFunc(func(params indexes.DropIndexParams) middleware.Responder {
+			return middleware.NotImplemented("operation IndexesDropIndex has not yet been implemented")
+		}),
+		QueuesEnqueueMessageHandler: queues.EnqueueMessageHandlerFunc(func(params queues.EnqueueMessageParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesEnqueueMessage has not yet been implemented")
+		}),
 		KvFindKeysHandler: kv.FindKeysHandlerFunc(func(params kv.FindKeysParams) middleware.Responder {
 			return middleware.NotImplemented("operation KvFindKeys has not yet been implemented")
 		}),
+		ClusterGetClusterStatusHandler: cluster.GetClusterStatusHandlerFunc(func(params cluster.GetClusterStatusParams) middleware.Responder {
+			return middleware.NotImplemented("operation ClusterGetClusterStatus has not yet been implemented")
+		}),
 		KvGetEntryHandler: kv.GetEntryHandlerFunc(func(params kv.GetEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation KvGetEntry has not yet been implemented")
 		}),
+		HashesGetFieldHandler: hashes.GetFieldHandlerFunc(func(params hashes.GetFieldParams) middleware.Responder {
+			return middleware.NotImplemented("operation HashesGetField has not yet been implemented")
+		}),
+		HashesGetHashHandler: hashes.GetHashHandlerFunc(func(params hashes.GetHashParams) middleware.Responder {
+			return middleware.NotImplemented("operation HashesGetHash has not yet been implemented")
+		}),
+		ElectionsGetLeaderHandler: elections.GetLeaderHandlerFunc(func(params elections.GetLeaderParams) middleware.Responder {
+			return middleware.NotImplemented("operation ElectionsGetLeader has not yet been implemented")
+		}),
+		LocksGetLockHandler: locks.GetLockHandlerFunc(func(params locks.GetLockParams) middleware.Responder {
+			return middleware.NotImplemented("operation LocksGetLock has not yet been implemented")
+		}),
+		MerkleGetMerkleLevelHandler: merkle.GetMerkleLevelHandlerFunc(func(params merkle.GetMerkleLevelParams) middleware.Responder {
+			return middleware.NotImplemented("operation MerkleGetMerkleLevel has not yet been implemented")
+		}),
+		QueuesGetQueueHandler: queues.GetQueueHandlerFunc(func(params queues.GetQueueParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesGetQueue has not yet been implemented")
+		}),
+		ZsetsGetRankHandler: zsets.GetRankHandlerFunc(func(params zsets.GetRankParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsGetRank has not yet been implemented")
+		}),
+		ReplicationGetReplicationStatusHandler: replication.GetReplicationStatusHandlerFunc(func(params replication.GetReplicationStatusParams) middleware.Responder {
+			return middleware.NotImplemented("operation ReplicationGetReplicationStatus has not yet been implemented")
+		}),
+		SemaphoresGetSemaphoreHandler: semaphores.GetSemaphoreHandlerFunc(func(params semaphores.GetSemaphoreParams) middleware.Responder {
+			return middleware.NotImplemented("operation SemaphoresGetSemaphore has not yet been implemented")
+		}),
+		SessionsGetSessionHandler: sessions.GetSessionHandlerFunc(func(params sessions.GetSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation SessionsGetSession has not yet been implemented")
+		}),
+		ReplicationGetSnapshotHandler: replication.GetSnapshotHandlerFunc(func(params replication.GetSnapshotParams) middleware.Responder {
+			return middleware.NotImplemented("operation ReplicationGetSnapshot has not yet been implemented")
+		}),
+		KvGetStatsHandler: kv.GetStatsHandlerFunc(func(params kv.GetStatsParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvGetStats has not yet been implemented")
+		}),
+		ZsetsGetZsetHandler: zsets.GetZsetHandlerFunc(func(params zsets.GetZsetParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsGetZset has not yet been implemented")
+		}),
+		KvIncrEntryHandler: kv.IncrEntryHandlerFunc(func(params kv.IncrEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvIncrEntry has not yet been implemented")
+		}),
+		ZsetsIncrScoreHandler: zsets.IncrScoreHandlerFunc(func(params zsets.IncrScoreParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsIncrScore has not yet been implemented")
+		}),
+		KvListChangesHandler: kv.ListChangesHandlerFunc(func(params kv.ListChangesParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvListChanges has not yet been implemented")
+		}),
+		IndexesListIndexesHandler: indexes.ListIndexesHandlerFunc(func(params indexes.ListIndexesParams) middleware.Responder {
+			return middleware.NotImplemented("operation IndexesListIndexes has not yet been implemented")
+		}),
+		MerkleListMerkleKeysHandler: merkle.ListMerkleKeysHandlerFunc(func(params merkle.ListMerkleKeysParams) middleware.Responder {
+			return middleware.NotImplemented("operation MerkleListMerkleKeys has not yet been implemented")
+		}),
+		SessionsListSessionsHandler: sessions.ListSessionsHandlerFunc(func(params sessions.ListSessionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation SessionsListSessions has not yet been implemented")
+		}),
+		KvMoveEntryHandler: kv.MoveEntryHandlerFunc(func(params kv.MoveEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvMoveEntry has not yet been implemented")
+		}),
+		QueuesNackMessageHandler: queues.NackMessageHandlerFunc(func(params queues.NackMessageParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesNackMessage has not yet been implemented")
+		}),
+		SequencesNextIdsHandler: sequences.NextIdsHandlerFunc(func(params sequences.NextIdsParams) middleware.Responder {
+			return middleware.NotImplemented("operation SequencesNextIds has not yet been implemented")
+		}),
+		KvPatchEntryHandler: kv.PatchEntryHandlerFunc(func(params kv.PatchEntryParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvPatchEntry has not yet been implemented")
+		}),
+		QueuesPeekMessageHandler: queues.PeekMessageHandlerFunc(func(params queues.PeekMessageParams) middleware.Responder {
+			return middleware.NotImplemented("operation QueuesPeekMessage has not yet been implemented")
+		}),
 		KvPutEntryHandler: kv.PutEntryHandlerFunc(func(params kv.PutEntryParams) middleware.Responder {
 			return middleware.NotImplemented("operation KvPutEntry has not yet been implemented")
 		}),
+		IndexesQueryIndexHandler: indexes.QueryIndexHandlerFunc(func(params indexes.QueryIndexParams) middleware.Responder {
+			return middleware.NotImplemented("operation IndexesQueryIndex has not yet been implemented")
+		}),
+		ZsetsRangeByRankHandler: zsets.RangeByRankHandlerFunc(func(params zsets.RangeByRankParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsRangeByRank has not yet been implemented")
+		}),
+		ZsetsRangeByScoreHandler: zsets.RangeByScoreHandlerFunc(func(params zsets.RangeByScoreParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsRangeByScore has not yet been implemented")
+		}),
+		ChangelogReadChangelogHandler: changelog.ReadChangelogHandlerFunc(func(params changelog.ReadChangelogParams) middleware.Responder {
+			return middleware.NotImplemented("operation ChangelogReadChangelog has not yet been implemented")
+		}),
+		SearchRebuildSearchHandler: search.RebuildSearchHandlerFunc(func(params search.RebuildSearchParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchRebuildSearch has not yet been implemented")
+		}),
+		LocksReleaseLockHandler: locks.ReleaseLockHandlerFunc(func(params locks.ReleaseLockParams) middleware.Responder {
+			return middleware.NotImplemented("operation LocksReleaseLock has not yet been implemented")
+		}),
+		SemaphoresReleaseSlotHandler: semaphores.ReleaseSlotHandlerFunc(func(params semaphores.ReleaseSlotParams) middleware.Responder {
+			return middleware.NotImplemented("operation SemaphoresReleaseSlot has not yet been implemented")
+		}),
+		ClusterRemoveClusterMemberHandler: cluster.RemoveClusterMemberHandlerFunc(func(params cluster.RemoveClusterMemberParams) middleware.Responder {
+			return middleware.NotImplemented("operation ClusterRemoveClusterMember has not yet been implemented")
+		}),
+		ZsetsRemoveMembersHandler: zsets.RemoveMembersHandlerFunc(func(params zsets.RemoveMembersParams) middleware.Responder {
+			return middleware.NotImplemented("operation ZsetsRemoveMembers has not yet been implemented")
+		}),
+		ElectionsRenewLeadershipHandler: elections.RenewLeadershipHandlerFunc(func(params elections.RenewLeadershipParams) middleware.Responder {
+			return middleware.NotImplemented("operation ElectionsRenewLeadership has not yet been implemented")
+		}),
+		LocksRenewLockHandler: locks.RenewLockHandlerFunc(func(params locks.RenewLockParams) middleware.Responder {
+			return middleware.NotImplemented("operation LocksRenewLock has not yet been implemented")
+		}),
+		SessionsRenewSessionHandler: sessions.RenewSessionHandlerFunc(func(params sessions.RenewSessionParams) middleware.Responder {
+			return middleware.NotImplemented("operation SessionsRenewSession has not yet been implemented")
+		}),
+		SemaphoresRenewSlotHandler: semaphores.RenewSlotHandlerFunc(func(params semaphores.RenewSlotParams) middleware.Responder {
+			return middleware.NotImplemented("operation SemaphoresRenewSlot has not yet been implemented")
+		}),
+		MerkleRepairFromHandler: merkle.RepairFromHandlerFunc(func(params merkle.RepairFromParams) middleware.Responder {
+			return middleware.NotImplemented("operation MerkleRepairFrom has not yet been implemented")
+		}),
+		ElectionsResignHandler: elections.ResignHandlerFunc(func(params elections.ResignParams) middleware.Responder {
+			return middleware.NotImplemented("operation ElectionsResign has not yet been implemented")
+		}),
+		SearchSearchHandler: search.SearchHandlerFunc(func(params search.SearchParams) middleware.Responder {
+			return middleware.NotImplemented("operation SearchSearch has not yet been implemented")
+		}),
+		HashesSetFieldHandler: hashes.SetFieldHandlerFunc(func(params hashes.SetFieldParams) middleware.Responder {
+			return middleware.NotImplemented("operation HashesSetField has not yet been implemented")
+		}),
+		KvSetLabelsHandler: kv.SetLabelsHandlerFunc(func(params kv.SetLabelsParams) middleware.Responder {
+			return middleware.NotImplemented("operation KvSetLabels has not yet been implemented")
+		}),
 	}
 }
 
@@ -88,14 +279,132 @@ type KvstoreAPI struct {
 	// BinProducer registers a producer for a "application/octet-stream" mime type
 	BinProducer runtime.Producer
 
+	// QueuesAckMessageHandler sets the operation handler for the ack message operation
+	QueuesAckMessageHandler queues.AckMessageHandler
+	// LocksAcquireLockHandler sets the operation handler for the acquire lock operation
+	LocksAcquireLockHandler locks.AcquireLockHandler
+	// SemaphoresAcquireSlotHandler sets the operation handler for the acquire slot operation
+	SemaphoresAcquireSlotHandler semaphores.AcquireSlotHandler
+	// ClusterAddClusterMemberHandler sets the operation handler for the add cluster member operation
+	ClusterAddClusterMemberHandler cluster.AddClusterMemberHandler
+	// ZsetsAddMembersHandler sets the operation handler for the add members operation
+	ZsetsAddMembersHandler zsets.AddMembersHandler
+	// KvAppendEntryHandler sets the operation handler for the append entry operation
+	KvAppendEntryHandler kv.AppendEntryHandler
+	// ElectionsCampaignHandler sets the operation handler for the campaign operation
+	ElectionsCampaignHandler elections.CampaignHandler
+	// KvCopyEntryHandler sets the operation handler for the copy entry operation
+	KvCopyEntryHandler kv.CopyEntryHandler
+	// IndexesCreateIndexHandler sets the operation handler for the create index operation
+	IndexesCreateIndexHandler indexes.CreateIndexHandler
+	// SessionsCreateSessionHandler sets the operation handler for the create session operation
+	SessionsCreateSessionHandler sessions.CreateSessionHandler
 	// KvDeleteEntryHandler sets the operation handler for the delete entry operation
 	KvDeleteEntryHandler kv.DeleteEntryHandler
+	// HashesDeleteFieldHandler sets the operation handler for the delete field operation
+	HashesDeleteFieldHandler hashes.DeleteFieldHandler
+	// KvDeleteKeysHandler sets the operation handler for the delete keys operation
+	KvDeleteKeysHandler kv.DeleteKeysHandler
+	// QueuesDequeueMessageHandler sets the operation handler for the dequeue message operation
+	QueuesDequeueMessageHandler queues.DequeueMessageHandler
+	// SessionsDestroySessionHandler sets the operation handler for the destroy session operation
+	SessionsDestroySessionHandler sessions.DestroySessionHandler
+	// IndexesDropIndexHandler sets the operation handler for the drop index operation
+	IndexesDropIndexHandler indexes.DropIndexHandler
+	// QueuesEnqueueMessageHandler sets the operation handler for the enqueue message operation
+	QueuesEnqueueMessageHandler queues.EnqueueMessageHandler
 	// KvFindKeysHandler sets the operation handler for the find keys operation
 	KvFindKeysHandler kv.FindKeysHandler
+	// ClusterGetClusterStatusHandler sets the operation handler for the get cluster status operation
+	ClusterGetClusterStatusHandler cluster.GetClusterStatusHandler
 	// KvGetEntryHandler sets the operation handler for the get entry operation
 	KvGetEntryHandler kv.GetEntryHandler
+	// HashesGetFieldHandler sets the operation handler for the get field operation
+	HashesGetFieldHandler hashes.GetFieldHandler
+	// HashesGetHashHandler sets the operation handler for the get hash operation
+	HashesGetHashHandler hashes.GetHashHandler
+	// ElectionsGetLeaderHandler sets the operation handler for the get leader operation
+	ElectionsGetLeaderHandler elections.GetLeaderHandler
+	// LocksGetLockHandler sets the operation handler for the get lock operation
+	LocksGetLockHandler locks.GetLockHandler
+	// MerkleGetMerkleLevelHandler sets the operation handler for the get merkle level operation
+	MerkleGetMerkleLevelHandler merkle.GetMerkleLevelHandler
+	// QueuesGetQueueHandler sets the operation handler for the get queue operation
+	QueuesGetQueueHandler queues.GetQueueHandler
+	// ZsetsGetRankHandler sets the operation handler for the get rank operation
+	ZsetsGetRankHandler zsets.GetRankHandler
+	// ReplicationGetReplicationStatusHandler sets the operation handler for the get replication status operation
+	ReplicationGetReplicationStatusHandler replication.GetReplicationStatusHandler
+	// SemaphoresGetSemaphoreHandler sets the operation handler for the get semaphore operation
+	SemaphoresGetSemaphoreHandler semaphores.GetSemaphoreHandler
+	// SessionsGetSessionHandler sets the operation handler for the get session operation
+	SessionsGetSessionHandler sessions.GetSessionHandler
+	// ReplicationGetSnapshotHandler sets the operation handler for the get snapshot operation
+	ReplicationGetSnapshotHandler replication.GetSnapshotHandler
+	// KvGetStatsHandler sets the operation handler for the get stats operation
+	KvGetStatsHandler kv.GetStatsHandler
+	// ZsetsGetZsetHandler sets the operation handler for the get zset operation
+	ZsetsGetZsetHandler zsets.GetZsetHandler
+	// KvIncrEntryHandler sets the operation handler for the incr entry operation
+	KvIncrEntryHandler kv.IncrEntryHandler
+	// ZsetsIncrScoreHandler sets the operation handler for the incr score operation
+	ZsetsIncrScoreHandler zsets.IncrScoreHandler
+	// KvListChangesHandler sets the operation handler for the list changes operation
+	KvListChangesHandler kv.ListChangesHandler
+	// IndexesListIndexesHandler sets the operation handler for the list indexes operation
+	IndexesListIndexesHandler indexes.ListIndexesHandler
+	// MerkleListMerkleKeysHandler sets the operation handler for the list merkle keys operation
+	MerkleListMerkleKeysHandler merkle.ListMerkleKeysHandler
+	// SessionsListSessionsHandler sets the operation handler for the list sessions operation
+	SessionsListSessionsHandler sessions.ListSessionsHandler
+	// KvMoveEntryHandler sets the operation handler for the move entry operation
+	KvMoveEntryHandler kv.MoveEntryHandler
+	// QueuesNackMessageHandler sets the operation handler for the nack message operation
+	QueuesNackMessageHandler queues.NackMessageHandler
+	// SequencesNextIdsHandler sets the operation handler for the next ids operation
+	SequencesNextIdsHandler sequences.NextIdsHandler
+	// KvPatchEntryHandler sets the operation handler for the patch entry operation
+	KvPatchEntryHandler kv.PatchEntryHandler
+	// QueuesPeekMessageHandler sets the operation handler for the peek message operation
+	QueuesPeekMessageHandler queues.PeekMessageHandler
 	// KvPutEntryHandler sets the operation handler for the put entry operation
 	KvPutEntryHandler kv.PutEntryHandler
+	// IndexesQueryIndexHandler sets the operation handler for the query index operation
+	IndexesQueryIndexHandler indexes.QueryIndexHandler
+	// ZsetsRangeByRankHandler sets the operation handler for the range by rank operation
+	ZsetsRangeByRankHandler zsets.RangeByRankHandler
+	// ZsetsRangeByScoreHandler sets the operation handler for the range by score operation
+	ZsetsRangeByScoreHandler zsets.RangeByScoreHandler
+	// ChangelogReadChangelogHandler sets the operation handler for the read changelog operation
+	ChangelogReadChangelogHandler changelog.ReadChangelogHandler
+	// SearchRebuildSearchHandler sets the operation handler for the rebuild search operation
+	SearchRebuildSearchHandler search.RebuildSearchHandler
+	// LocksReleaseLockHandler sets the operation handler for the release lock operation
+	LocksReleaseLockHandler locks.ReleaseLockHandler
+	// SemaphoresReleaseSlotHandler sets the operation handler for the release slot operation
+	SemaphoresReleaseSlotHandler semaphores.ReleaseSlotHandler
+	// ClusterRemoveClusterMemberHandler sets the operation handler for the remove cluster member operation
+	ClusterRemoveClusterMemberHandler cluster.RemoveClusterMemberHandler
+	// ZsetsRemoveMembersHandler sets the operation handler for the remove members operation
+	ZsetsRemoveMembersHandler zsets.RemoveMembersHandler
+	// ElectionsRenewLeadershipHandler sets the operation handler for the renew leadership operation
+	ElectionsRenewLeadershipHandler elections.RenewLeadershipHandler
+	// LocksRenewLockHandler sets the operation handler for the renew lock operation
+	LocksRenewLockHandler locks.RenewLockHandler
+	// SessionsRenewSessionHandler sets the operation handler for the renew session operation
+	SessionsRenewSessionHandler sessions.RenewSessionHandler
+	// SemaphoresRenewSlotHandler sets the operation handler for the renew slot operation
+	SemaphoresRenewSlotHandler semaphores.RenewSlotHandler
+	// MerkleRepairFromHandler sets the operation handler for the repair from operation
+	MerkleRepairFromHandler merkle.RepairFromHandler
+	// ElectionsResignHandler sets the operation handler for the resign operation
+	ElectionsResignHandler elections.ResignHandler
+	// SearchSearchHandler sets the operation handler for the search operation
+	SearchSearchHandler search.SearchHandler
+	// HashesSetFieldHandler sets the operation handler for the set field operation
+	HashesSetFieldHandler hashes.SetFieldHandler
+	// KvSetLabelsHandler sets the operation handler for the set labels operation
+	KvSetLabelsHandler kv.SetLabelsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -167,22 +476,258 @@ func (o *KvstoreAPI) Validate() error {
 		unregistered = append(unregistered, "BinProducer")
 	}
 
+	if o.QueuesAckMessageHandler == nil {
+		unregistered = append(unregistered, "queues.AckMessageHandler")
+	}
+
+	if o.LocksAcquireLockHandler == nil {
+		unregistered = append(unregistered, "locks.AcquireLockHandler")
+	}
+
+	if o.SemaphoresAcquireSlotHandler == nil {
+		unregistered = append(unregistered, "semaphores.AcquireSlotHandler")
+	}
+
+	if o.ClusterAddClusterMemberHandler == nil {
+		unregistered = append(unregistered, "cluster.AddClusterMemberHandler")
+	}
+
+	if o.ZsetsAddMembersHandler == nil {
+		unregistered = append(unregistered, "zsets.AddMembersHandler")
+	}
+
+	if o.KvAppendEntryHandler == nil {
+		unregistered = append(unregistered, "kv.AppendEntryHandler")
+	}
+
+	if o.ElectionsCampaignHandler == nil {
+		unregistered = append(unregistered, "elections.CampaignHandler")
+	}
+
+	if o.KvCopyEntryHandler == nil {
+		unregistered = append(unregistered, "kv.CopyEntryHandler")
+	}
+
+	if o.IndexesCreateIndexHandler == nil {
+		unregistered = append(unregistered, "indexes.CreateIndexHandler")
+	}
+
+	if o.SessionsCreateSessionHandler == nil {
+		unregistered = append(unregistered, "sessions.CreateSessionHandler")
+	}
+
 	if o.KvDeleteEntryHandler == nil {
 		unregistered = append(unregistered, "kv.DeleteEntryHandler")
 	}
 
+	if o.HashesDeleteFieldHandler == nil {
+		unregistered = append(unregistered, "hashes.DeleteFieldHandler")
+	}
+
+	if o.KvDeleteKeysHandler == nil {
+		unregistered = append(unregistered, "kv.DeleteKeysHandler")
+	}
+
+	if o.QueuesDequeueMessageHandler == nil {
+		unregistered = append(unregistered, "queues.DequeueMessageHandler")
+	}
+
+	if o.SessionsDestroySessionHandler == nil {
+		unregistered = append(unregistered, "sessions.DestroySessionHandler")
+	}
+
+	if o.IndexesDropIndexHandler == nil {
+		unregistered = append(unregistered, "indexes.DropIndexHandler")
+	}
+
+	if o.QueuesEnqueueMessageHandler == nil {
+		unregistered = append(unregistered, "queues.EnqueueMessageHandler")
+	}
+
 	if o.KvFindKeysHandler == nil {
 		unregistered = append(unregistered, "kv.FindKeysHandler")
 	}
 
+	if o.ClusterGetClusterStatusHandler == nil {
+		unregistered = append(unregistered, "cluster.GetClusterStatusHandler")
+	}
+
 	if o.KvGetEntryHandler == nil {
 		unregistered = append(unregistered, "kv.GetEntryHandler")
 	}
 
+	if o.HashesGetFieldHandler == nil {
+		unregistered = append(unregistered, "hashes.GetFieldHandler")
+	}
+
+	if o.HashesGetHashHandler == nil {
+		unregistered = append(unregistered, "hashes.GetHashHandler")
+	}
+
+	if o.ElectionsGetLeaderHandler == nil {
+		unregistered = append(unregistered, "elections.GetLeaderHandler")
+	}
+
+	if o.LocksGetLockHandler == nil {
+		unregistered = append(unregistered, "locks.GetLockHandler")
+	}
+
+	if o.MerkleGetMerkleLevelHandler == nil {
+		unregistered = append(unregistered, "merkle.GetMerkleLevelHandler")
+	}
+
+	if o.QueuesGetQueueHandler == nil {
+		unregistered = append(unregistered, "queues.GetQueueHandler")
+	}
+
+	if o.ZsetsGetRankHandler == nil {
+		unregistered = append(unregistered, "zsets.GetRankHandler")
+	}
+
+	if o.ReplicationGetReplicationStatusHandler == nil {
+		unregistered = append(unregistered, "replication.GetReplicationStatusHandler")
+	}
+
+	if o.SemaphoresGetSemaphoreHandler == nil {
+		unregistered = append(unregistered, "semaphores.GetSemaphoreHandler")
+	}
+
+	if o.SessionsGetSessionHandler == nil {
+		unregistered = append(unregistered, "sessions.GetSessionHandler")
+	}
+
+	if o.ReplicationGetSnapshotHandler == nil {
+		unregistered = append(unregistered, "replication.GetSnapshotHandler")
+	}
+
+	if o.KvGetStatsHandler == nil {
+		unregistered = append(unregistered, "kv.GetStatsHandler")
+	}
+
+	if o.ZsetsGetZsetHandler == nil {
+		unregistered = append(unregistered, "zsets.GetZsetHandler")
+	}
+
+	if o.KvIncrEntryHandler == nil {
+		unregistered = append(unregistered, "kv.IncrEntryHandler")
+	}
+
+	if o.ZsetsIncrScoreHandler == nil {
+		unregistered = append(unregistered, "zsets.IncrScoreHandler")
+	}
+
+	if o.KvListChangesHandler == nil {
+		unregistered = append(unregistered, "kv.ListChangesHandler")
+	}
+
+	if o.IndexesListIndexesHandler == nil {
+		unregistered = append(unregistered, "indexes.ListIndexesHandler")
+	}
+
+	if o.MerkleListMerkleKeysHandler == nil {
+		unregistered = append(unregistered, "merkle.ListMerkleKeysHandler")
+	}
+
+	if o.SessionsListSessionsHandler == nil {
+		unregistered = append(unregistered, "sessions.ListSessionsHandler")
+	}
+
+	if o.KvMoveEntryHandler == nil {
+		unregistered = append(unregistered, "kv.MoveEntryHandler")
+	}
+
+	if o.QueuesNackMessageHandler == nil {
+		unregistered = append(unregistered, "queues.NackMessageHandler")
+	}
+
+	if o.SequencesNextIdsHandler == nil {
+		unregistered = append(unregistered, "sequences.NextIdsHandler")
+	}
+
+	if o.KvPatchEntryHandler == nil {
+		unregistered = append(unregistered, "kv.PatchEntryHandler")
+	}
+
+	if o.QueuesPeekMessageHandler == nil {
+		unregistered = append(unregistered, "queues.PeekMessageHandler")
+	}
+
 	if o.KvPutEntryHandler == nil {
 		unregistered = append(unregistered, "kv.PutEntryHandler")
 	}
 
+	if o.IndexesQueryIndexHandler == nil {
+		unregistered = append(unregistered, "indexes.QueryIndexHandler")
+	}
+
+	if o.ZsetsRangeByRankHandler == nil {
+		unregistered = append(unregistered, "zsets.RangeByRankHandler")
+	}
+
+	if o.ZsetsRangeByScoreHandler == nil {
+		unregistered = append(unregistered, "zsets.RangeByScoreHandler")
+	}
+
+	if o.ChangelogReadChangelogHandler == nil {
+		unregistered = append(unregistered, "changelog.ReadChangelogHandler")
+	}
+
+	if o.SearchRebuildSearchHandler == nil {
+		unregistered = append(unregistered, "search.RebuildSearchHandler")
+	}
+
+	if o.LocksReleaseLockHandler == nil {
+		unregistered = append(unregistered, "locks.ReleaseLockHandler")
+	}
+
+	if o.SemaphoresReleaseSlotHandler == nil {
+		unregistered = append(unregistered, "semaphores.ReleaseSlotHandler")
+	}
+
+	if o.ClusterRemoveClusterMemberHandler == nil {
+		unregistered = append(unregistered, "cluster.RemoveClusterMemberHandler")
+	}
+
+	if o.ZsetsRemoveMembersHandler == nil {
+		unregistered = append(unregistered, "zsets.RemoveMembersHandler")
+	}
+
+	if o.ElectionsRenewLeadershipHandler == nil {
+		unregistered = append(unregistered, "elections.RenewLeadershipHandler")
+	}
+
+	if o.LocksRenewLockHandler == nil {
+		unregistered = append(unregistered, "locks.RenewLockHandler")
+	}
+
+	if o.SessionsRenewSessionHandler == nil {
+		unregistered = append(unregistered, "sessions.RenewSessionHandler")
+	}
+
+	if o.SemaphoresRenewSlotHandler == nil {
+		unregistered = append(unregistered, "semaphores.RenewSlotHandler")
+	}
+
+	if o.MerkleRepairFromHandler == nil {
+		unregistered = append(unregistered, "merkle.RepairFromHandler")
+	}
+
+	if o.ElectionsResignHandler == nil {
+		unregistered = append(unregistered, "elections.ResignHandler")
+	}
+
+	if o.SearchSearchHandler == nil {
+		unregistered = append(unregistered, "search.SearchHandler")
+	}
+
+	if o.HashesSetFieldHandler == nil {
+		unregistered = append(unregistered, "hashes.SetFieldHandler")
+	}
+
+	if o.KvSetLabelsHandler == nil {
+		unregistered = append(unregistered, "kv.SetLabelsHandler")
+	}
+
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
 	}
@@ -219,6 +764,12 @@ func (o *KvstoreAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consum
 		case "application/json":
 			result["application/json"] = o.JSONConsumer
 
+		case "application/json-patch+json":
+			result["application/json-patch+json"] = o.JSONConsumer
+
+		case "application/merge-patch+json":
+			result["application/merge-patch+json"] = o.JSONConsumer
+
 		case "application/octet-stream":
 			result["application/octet-stream"] = o.BinConsumer
 
@@ -287,26 +838,321 @@ func (o *KvstoreAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/queues/{name}/{id}/_ack"] = queues.NewAckMessage(o.context, o.QueuesAckMessageHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/locks/{name}"] = locks.NewAcquireLock(o.context, o.LocksAcquireLockHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/semaphores/{name}"] = semaphores.NewAcquireSlot(o.context, o.SemaphoresAcquireSlotHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/cluster/members"] = cluster.NewAddClusterMember(o.context, o.ClusterAddClusterMemberHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/zsets/{name}"] = zsets.NewAddMembers(o.context, o.ZsetsAddMembersHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kv/{key}/_append"] = kv.NewAppendEntry(o.context, o.KvAppendEntryHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/elections/{name}"] = elections.NewCampaign(o.context, o.ElectionsCampaignHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kv/{key}/_copy"] = kv.NewCopyEntry(o.context, o.KvCopyEntryHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/indexes/{name}"] = indexes.NewCreateIndex(o.context, o.IndexesCreateIndexHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sessions"] = sessions.NewCreateSession(o.context, o.SessionsCreateSessionHandler)
+
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/kv/{key}"] = kv.NewDeleteEntry(o.context, o.KvDeleteEntryHandler)
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/hashes/{key}/{field}"] = hashes.NewDeleteField(o.context, o.HashesDeleteFieldHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/kv"] = kv.NewDeleteKeys(o.context, o.KvDeleteKeysHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/queues/{name}/_dequeue"] = queues.NewDequeueMessage(o.context, o.QueuesDequeueMessageHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/sessions/{id}"] = sessions.NewDestroySession(o.context, o.SessionsDestroySessionHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/indexes/{name}"] = indexes.NewDropIndex(o.context, o.IndexesDropIndexHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/queues/{name}"] = queues.NewEnqueueMessage(o.context, o.QueuesEnqueueMessageHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/kv"] = kv.NewFindKeys(o.context, o.KvFindKeysHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/cluster"] = cluster.NewGetClusterStatus(o.context, o.ClusterGetClusterStatusHandler)
+
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/kv/{key}"] = kv.NewGetEntry(o.context, o.KvGetEntryHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/hashes/{key}/{field}"] = hashes.NewGetField(o.context, o.HashesGetFieldHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/hashes/{key}"] = hashes.NewGetHash(o.context, o.HashesGetHashHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/elections/{name}"] = elections.NewGetLeader(o.context, o.ElectionsGetLeaderHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/locks/{name}"] = locks.NewGetLock(o.context, o.LocksGetLockHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/merkle"] = merkle.NewGetMerkleLevel(o.context, o.MerkleGetMerkleLevelHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/queues/{name}"] = queues.NewGetQueue(o.context, o.QueuesGetQueueHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/zsets/{name}/_rank"] = zsets.NewGetRank(o.context, o.ZsetsGetRankHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication"] = replication.NewGetReplicationStatus(o.context, o.ReplicationGetReplicationStatusHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/semaphores/{name}"] = semaphores.NewGetSemaphore(o.context, o.SemaphoresGetSemaphoreHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions/{id}"] = sessions.NewGetSession(o.context, o.SessionsGetSessionHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/replication/_snapshot"] = replication.NewGetSnapshot(o.context, o.ReplicationGetSnapshotHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/kv/_stats"] = kv.NewGetStats(o.context, o.KvGetStatsHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/zsets/{name}"] = zsets.NewGetZset(o.context, o.ZsetsGetZsetHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kv/{key}/_incr"] = kv.NewIncrEntry(o.context, o.KvIncrEntryHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/zsets/{name}/_incr"] = zsets.NewIncrScore(o.context, o.ZsetsIncrScoreHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/kv/_changes"] = kv.NewListChanges(o.context, o.KvListChangesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/indexes"] = indexes.NewListIndexes(o.context, o.IndexesListIndexesHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/merkle/_keys"] = merkle.NewListMerkleKeys(o.context, o.MerkleListMerkleKeysHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/sessions"] = sessions.NewListSessions(o.context, o.SessionsListSessionsHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kv/{key}/_move"] = kv.NewMoveEntry(o.context, o.KvMoveEntryHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/queues/{name}/{id}/_nack"] = queues.NewNackMessage(o.context, o.QueuesNackMessageHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/sequences/{name}/_next"] = sequences.NewNextIds(o.context, o.SequencesNextIdsHandler)
+
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/kv/{key}"] = kv.NewPatchEntry(o.context, o.KvPatchEntryHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/queues/{name}/_peek"] = queues.NewPeekMessage(o.context, o.QueuesPeekMessageHandler)
+
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/kv/{key}"] = kv.NewPutEntry(o.context, o.KvPutEntryHandler)
 
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/indexes/{name}"] = indexes.NewQueryIndex(o.context, o.IndexesQueryIndexHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/zsets/{name}/_range"] = zsets.NewRangeByRank(o.context, o.ZsetsRangeByRankHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/zsets/{name}/_rangebyscore"] = zsets.NewRangeByScore(o.context, o.ZsetsRangeByScoreHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/changelog"] = changelog.NewReadChangelog(o.context, o.ChangelogReadChangelogHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/search/_rebuild"] = search.NewRebuildSearch(o.context, o.SearchRebuildSearchHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/locks/{name}"] = locks.NewReleaseLock(o.context, o.LocksReleaseLockHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/semaphores/{name}"] = semaphores.NewReleaseSlot(o.context, o.SemaphoresReleaseSlotHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/cluster/members/{id}"] = cluster.NewRemoveClusterMember(o.context, o.ClusterRemoveClusterMemberHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/zsets/{name}/_remove"] = zsets.NewRemoveMembers(o.context, o.ZsetsRemoveMembersHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/elections/{name}"] = elections.NewRenewLeadership(o.context, o.ElectionsRenewLeadershipHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/locks/{name}"] = locks.NewRenewLock(o.context, o.LocksRenewLockHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/sessions/{id}/renew"] = sessions.NewRenewSession(o.context, o.SessionsRenewSessionHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/semaphores/{name}"] = semaphores.NewRenewSlot(o.context, o.SemaphoresRenewSlotHandler)
+
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/merkle/_repair"] = merkle.NewRepairFrom(o.context, o.MerkleRepairFromHandler)
+
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/elections/{name}"] = elections.NewResign(o.context, o.ElectionsResignHandler)
+
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/search"] = search.NewSearch(o.context, o.SearchSearchHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/hashes/{key}/{field}"] = hashes.NewSetField(o.context, o.HashesSetFieldHandler)
+
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/kv/{key}/_labels"] = kv.NewSetLabels(o.context, o.KvSetLabelsHandler)
+
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,396 @@
+// Package patch applies JSON merge patches (RFC 7386) and JSON patches (RFC 6902) to JSON documents
+package patch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/kvstore/filter"
+)
+
+// Media types for the supported kinds of patches
+const (
+	MergePatchMime = "application/merge-patch+json"
+	JSONPatchMime  = "application/json-patch+json"
+)
+
+// Common errors.
+var (
+	ErrNotJSON      = errors.New("the entry doesn't hold a JSON document")
+	ErrTestFailed   = errors.New("test operation failed")
+	ErrPathNotFound = errors.New("path not found")
+)
+
+// InvalidPatchError is returned when the patch document itself is malformed
+type InvalidPatchError struct {
+	Reason string
+}
+
+func (e *InvalidPatchError) Error() string {
+	return "invalid patch: " + e.Reason
+}
+
+func invalidPatch(format string, args ...interface{}) error {
+	return &InvalidPatchError{Reason: fmt.Sprintf(format, args...)}
+}
+
+// MergePatch applies a JSON merge patch to the document
+func MergePatch(doc, patch []byte) ([]byte, error) {
+	target, err := filter.Decode(doc)
+	if err != nil {
+		return nil, ErrNotJSON
+	}
+	p, err := filter.Decode(patch)
+	if err != nil {
+		return nil, invalidPatch("%v", err)
+	}
+	return json.Marshal(mergePatch(target, p))
+}
+
+func mergePatch(target, patch interface{}) interface{} {
+	pm, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	tm, ok := target.(map[string]interface{})
+	if !ok {
+		tm = make(map[string]interface{}, len(pm))
+	}
+	for k, v := range pm {
+		if v == nil {
+			delete(tm, k)
+			continue
+		}
+		tm[k] = mergePatch(tm[k], v)
+	}
+	return tm
+}
+
+type operation struct {
+	Op   string  `json:"op"`
+	Path *string `json:"path"`
+	From *string `json:"from"`
+	// Value holds null when the value is null and is nil when the operation has no value
+	Value json.RawMessage `json:"value"`
+}
+
+func (o *operation) value() (interface{}, error) {
+	if o.Value == nil {
+		return nil, invalidPatch("%s operation requires a value", o.Op)
+	}
+	v, err := filter.Decode(o.Value)
+	if err != nil {
+		return nil, invalidPatch("%v", err)
+	}
+	return v, nil
+}
+
+func tokens(ptr *string, field, op string) ([]string, error) {
+	if ptr == nil {
+		return nil, invalidPatch("%s operation requires a %s", op, field)
+	}
+	p, err := jsonpointer.New(*ptr)
+	if err != nil {
+		return nil, invalidPatch("%v", err)
+	}
+	return p.DecodedTokens(), nil
+}
+
+// JSONPatch applies a JSON patch to the document, the operations are applied in order
+// and when one of them fails the document is left unchanged.
+func JSONPatch(doc, patch []byte) ([]byte, error) {
+	target, err := filter.Decode(doc)
+	if err != nil {
+		return nil, ErrNotJSON
+	}
+
+	var ops []operation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, invalidPatch("%v", err)
+	}
+
+	for _, op := range ops {
+		target, err = apply(target, &op)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(target)
+}
+
+func apply(doc interface{}, op *operation) (interface{}, error) {
+	path, err := tokens(op.Path, "path", op.Op)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case "add":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "remove":
+		doc, _, err = remove(doc, path)
+		return doc, err
+
+	case "replace":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		if doc, _, err = remove(doc, path); err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "move":
+		from, err := tokens(op.From, "from", op.Op)
+		if err != nil {
+			return nil, err
+		}
+		if len(from) < len(path) && isPrefix(from, path) {
+			return nil, invalidPatch("can't move %q into one of its children", *op.From)
+		}
+		doc, value, err := remove(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, value)
+
+	case "copy":
+		from, err := tokens(op.From, "from", op.Op)
+		if err != nil {
+			return nil, err
+		}
+		value, err := get(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		return add(doc, path, deepCopy(value))
+
+	case "test":
+		value, err := op.value()
+		if err != nil {
+			return nil, err
+		}
+		actual, err := get(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !equal(actual, value) {
+			return nil, ErrTestFailed
+		}
+		return doc, nil
+
+	default:
+		return nil, invalidPatch("unknown operation %q", op.Op)
+	}
+}
+
+func isPrefix(prefix, path []string) bool {
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// arrayIndex parses an array index, when appending is allowed the index can be one past the end
+func arrayIndex(token string, length int, appending bool) (int, error) {
+	if appending && token == "-" {
+		return length, nil
+	}
+	if token == "" || (len(token) > 1 && token[0] == '0') {
+		return 0, ErrPathNotFound
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 {
+		return 0, ErrPathNotFound
+	}
+	if idx > length || (idx == length && !appending) {
+		return 0, ErrPathNotFound
+	}
+	return idx, nil
+}
+
+func get(doc interface{}, path []string) (interface{}, error) {
+	for _, token := range path {
+		switch node := doc.(type) {
+		case map[string]interface{}:
+			child, ok := node[token]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			doc = child
+		case []interface{}:
+			idx, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			doc = node[idx]
+		default:
+			return nil, ErrPathNotFound
+		}
+	}
+	return doc, nil
+}
+
+// update walks to the parent of the last token of the path and replaces it with the result of fn
+func update(doc interface{}, path []string, fn func(parent interface{}, last string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(doc, path[0])
+	}
+
+	switch node := doc.(type) {
+	case map[string]interface{}:
+		child, ok := node[path[0]]
+		if !ok {
+			return nil, ErrPathNotFound
+		}
+		child, err := update(child, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[path[0]] = child
+		return node, nil
+	case []interface{}:
+		idx, err := arrayIndex(path[0], len(node), false)
+		if err != nil {
+			return nil, err
+		}
+		child, err := update(node[idx], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		node[idx] = child
+		return node, nil
+	default:
+		return nil, ErrPathNotFound
+	}
+}
+
+func add(doc interface{}, path []string, value interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+
+	return update(doc, path, func(parent interface{}, last string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[last] = value
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(last, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[idx+1:], node[idx:])
+			node[idx] = value
+			return node, nil
+		default:
+			return nil, ErrPathNotFound
+		}
+	})
+}
+
+func remove(doc interface{}, path []string) (interface{}, interface{}, error) {
+	if len(path) == 0 {
+		return nil, doc, nil
+	}
+
+	var removed interface{}
+	doc, err := update(doc, path, func(parent interface{}, last string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			value, ok := node[last]
+			if !ok {
+				return nil, ErrPathNotFound
+			}
+			removed = value
+			delete(node, last)
+			return node, nil
+		case []interface{}:
+			idx, err := arrayIndex(last, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			removed = node[idx]
+			return append(node[:idx], node[idx+1:]...), nil
+		default:
+			return nil, ErrPathNotFound
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, removed, nil
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for k, e := range v {
+			result[k] = deepCopy(e)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, e := range v {
+			result[i] = deepCopy(e)
+		}
+		return result
+	default:
+		return v
+	}
+}
+
+func equal(a, b interface{}) bool {
+	switch av := a.(type) {
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, e := range av {
+			be, ok := bv[k]
+			if !ok || !equal(e, be) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !equal(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if av == bv {
+			return true
+		}
+		af, err1 := av.Float64()
+		bf, err2 := bv.Float64()
+		return err1 == nil && err2 == nil && af == bf
+	default:
+		return a == b
+	}
+}
@@ -0,0 +1,166 @@
+package patch
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// sameJSON reports if the documents are the same JSON value, whatever the order of the object members
+func sameJSON(t *testing.T, a, b []byte) bool {
+	var av, bv interface{}
+	if err := json.Unmarshal(a, &av); err != nil {
+		t.Fatalf("%s: %v", a, err)
+	}
+	if err := json.Unmarshal(b, &bv); err != nil {
+		t.Fatalf("%s: %v", b, err)
+	}
+	ad, _ := json.Marshal(av)
+	bd, _ := json.Marshal(bv)
+	return string(ad) == string(bd)
+}
+
+func TestMergePatch(t *testing.T) {
+	tests := []struct {
+		name, doc, patch, want string
+	}{
+		{"adds a member", `{"a":1}`, `{"b":2}`, `{"a":1,"b":2}`},
+		{"replaces a member", `{"a":1}`, `{"a":"x"}`, `{"a":"x"}`},
+		{"removes a member", `{"a":1,"b":2}`, `{"a":null}`, `{"b":2}`},
+		{"merges nested objects", `{"a":{"b":1,"c":2}}`, `{"a":{"c":null,"d":3}}`, `{"a":{"b":1,"d":3}}`},
+		{"replaces arrays", `{"a":[1,2]}`, `{"a":[3]}`, `{"a":[3]}`},
+		{"replaces a document that isn't an object", `[1]`, `{"a":1}`, `{"a":1}`},
+		{"replaces with a patch that isn't an object", `{"a":1}`, `"x"`, `"x"`},
+		{"keeps large integers", `{"a":12345678901234567890}`, `{}`, `{"a":12345678901234567890}`},
+	}
+	for _, tt := range tests {
+		got, err := MergePatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !sameJSON(t, got, []byte(tt.want)) {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestMergePatchErrors(t *testing.T) {
+	if _, err := MergePatch([]byte("not json"), []byte(`{}`)); err != ErrNotJSON {
+		t.Errorf("patching a document that isn't JSON got %v", err)
+	}
+	if _, err := MergePatch([]byte(`{}`), []byte(`{`)); err == nil {
+		t.Error("a malformed patch was applied")
+	} else if _, ok := err.(*InvalidPatchError); !ok {
+		t.Errorf("a malformed patch got %v", err)
+	}
+}
+
+func TestJSONPatch(t *testing.T) {
+	tests := []struct {
+		name, doc, patch, want string
+	}{
+		{"add a member", `{"a":1}`, `[{"op":"add","path":"/b","value":2}]`, `{"a":1,"b":2}`},
+		{"add replaces a member", `{"a":1}`, `[{"op":"add","path":"/a","value":2}]`, `{"a":2}`},
+		{"add inserts in an array", `{"a":[1,3]}`, `[{"op":"add","path":"/a/1","value":2}]`, `{"a":[1,2,3]}`},
+		{"add at the end of an array", `{"a":[1,2]}`, `[{"op":"add","path":"/a/-","value":3}]`, `{"a":[1,2,3]}`},
+		{"add at the index past the end", `{"a":[1,2]}`, `[{"op":"add","path":"/a/2","value":3}]`, `{"a":[1,2,3]}`},
+		{"add the whole document", `{"a":1}`, `[{"op":"add","path":"","value":[1]}]`, `[1]`},
+		{"remove a member", `{"a":1,"b":2}`, `[{"op":"remove","path":"/a"}]`, `{"b":2}`},
+		{"remove from an array", `{"a":[1,2,3]}`, `[{"op":"remove","path":"/a/1"}]`, `{"a":[1,3]}`},
+		{"replace a member", `{"a":{"b":1}}`, `[{"op":"replace","path":"/a/b","value":"x"}]`, `{"a":{"b":"x"}}`},
+		{"replace in an array", `[1,2,3]`, `[{"op":"replace","path":"/1","value":5}]`, `[1,5,3]`},
+		{"move a member", `{"a":{"b":1},"c":{}}`, `[{"op":"move","from":"/a/b","path":"/c/d"}]`, `{"a":{},"c":{"d":1}}`},
+		{"move in an array", `[1,2,3]`, `[{"op":"move","from":"/0","path":"/-"}]`, `[2,3,1]`},
+		{"copy a member", `{"a":{"b":[1]}}`, `[{"op":"copy","from":"/a/b","path":"/c"}]`, `{"a":{"b":[1]},"c":[1]}`},
+		{"copy doesn't share the value", `{"a":{"b":1}}`, `[{"op":"copy","from":"/a","path":"/c"},{"op":"replace","path":"/c/b","value":2}]`, `{"a":{"b":1},"c":{"b":2}}`},
+		{"test a value", `{"a":[1,{"b":"x"}]}`, `[{"op":"test","path":"/a","value":[1,{"b":"x"}]}]`, `{"a":[1,{"b":"x"}]}`},
+		{"test numbers by value", `{"a":1.0}`, `[{"op":"test","path":"/a","value":1}]`, `{"a":1.0}`},
+		{"escaped slash", `{"a/b":1}`, `[{"op":"replace","path":"/a~1b","value":2}]`, `{"a/b":2}`},
+		{"escaped tilde", `{"a~b":1}`, `[{"op":"remove","path":"/a~0b"}]`, `{}`},
+		{"escaped tilde before a one", `{"~1":1}`, `[{"op":"move","from":"/~01","path":"/x"}]`, `{"x":1}`},
+		{"add a null member", `{"a":1}`, `[{"op":"add","path":"/b","value":null}]`, `{"a":1,"b":null}`},
+		{"add a null to an array", `[1]`, `[{"op":"add","path":"/-","value":null}]`, `[1,null]`},
+		{"replace with null", `{"a":{"b":1}}`, `[{"op":"replace","path":"/a","value":null}]`, `{"a":null}`},
+		{"replace the whole document with null", `{"a":1}`, `[{"op":"replace","path":"","value":null}]`, `null`},
+		{"test a null value", `{"a":null}`, `[{"op":"test","path":"/a","value":null}]`, `{"a":null}`},
+		{"operations in order", `{}`, `[{"op":"add","path":"/a","value":[]},{"op":"add","path":"/a/-","value":1},{"op":"test","path":"/a/0","value":1}]`, `{"a":[1]}`},
+	}
+	for _, tt := range tests {
+		got, err := JSONPatch([]byte(tt.doc), []byte(tt.patch))
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !sameJSON(t, got, []byte(tt.want)) {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+// TestJSONPatchConflicts covers the failures of patches that are valid but don't apply to the document,
+// the patch handler responds to those with a conflict
+func TestJSONPatchConflicts(t *testing.T) {
+	tests := []struct {
+		name, doc, patch string
+		want             error
+	}{
+		{"document isn't JSON", `{"a":`, `[]`, ErrNotJSON},
+		{"test of a different value", `{"a":1}`, `[{"op":"test","path":"/a","value":2}]`, ErrTestFailed},
+		{"test of a different type", `{"a":"1"}`, `[{"op":"test","path":"/a","value":1}]`, ErrTestFailed},
+		{"test of null against a value", `{"a":0}`, `[{"op":"test","path":"/a","value":null}]`, ErrTestFailed},
+		{"test of null against a missing member", `{}`, `[{"op":"test","path":"/a","value":null}]`, ErrPathNotFound},
+		{"test of a missing member", `{}`, `[{"op":"test","path":"/a","value":1}]`, ErrPathNotFound},
+		{"remove a missing member", `{"a":1}`, `[{"op":"remove","path":"/b"}]`, ErrPathNotFound},
+		{"replace a missing member", `{"a":1}`, `[{"op":"replace","path":"/b","value":1}]`, ErrPathNotFound},
+		{"add below a missing member", `{}`, `[{"op":"add","path":"/a/b","value":1}]`, ErrPathNotFound},
+		{"add below a scalar", `{"a":1}`, `[{"op":"add","path":"/a/b","value":1}]`, ErrPathNotFound},
+		{"add past the end of an array", `[1]`, `[{"op":"add","path":"/2","value":1}]`, ErrPathNotFound},
+		{"remove the end of an array", `[1]`, `[{"op":"remove","path":"/-"}]`, ErrPathNotFound},
+		{"replace past the end of an array", `[1]`, `[{"op":"replace","path":"/1","value":1}]`, ErrPathNotFound},
+		{"index with a leading zero", `[1,2]`, `[{"op":"remove","path":"/01"}]`, ErrPathNotFound},
+		{"negative index", `[1,2]`, `[{"op":"remove","path":"/-1"}]`, ErrPathNotFound},
+		{"move from a missing member", `{}`, `[{"op":"move","from":"/a","path":"/b"}]`, ErrPathNotFound},
+		{"copy from a missing member", `{}`, `[{"op":"copy","from":"/a","path":"/b"}]`, ErrPathNotFound},
+		{"unescaped pointer", `{"a/b":1}`, `[{"op":"remove","path":"/a/b"}]`, ErrPathNotFound},
+		{"failure after applied operations", `{}`, `[{"op":"add","path":"/a","value":1},{"op":"test","path":"/a","value":2}]`, ErrTestFailed},
+	}
+	for _, tt := range tests {
+		got, err := JSONPatch([]byte(tt.doc), []byte(tt.patch))
+		if err != tt.want {
+			t.Errorf("%s: got %s, %v, want %v", tt.name, got, err, tt.want)
+		}
+	}
+}
+
+func TestJSONPatchInvalid(t *testing.T) {
+	tests := []struct {
+		name, patch string
+	}{
+		{"not an array", `{"op":"add","path":"/a","value":1}`},
+		{"malformed", `[{"op":"add"`},
+		{"unknown operation", `[{"op":"merge","path":"/a","value":1}]`},
+		{"missing path", `[{"op":"remove"}]`},
+		{"missing value", `[{"op":"add","path":"/a"}]`},
+		{"missing replace value", `[{"op":"replace","path":"/a"}]`},
+		{"missing test value", `[{"op":"test","path":"/a"}]`},
+		{"missing from", `[{"op":"copy","path":"/a"}]`},
+		{"pointer without a leading slash", `[{"op":"remove","path":"a"}]`},
+		{"move into a child", `[{"op":"move","from":"/a","path":"/a/b"}]`},
+	}
+	for _, tt := range tests {
+		_, err := JSONPatch([]byte(`{"a":{}}`), []byte(tt.patch))
+		if _, ok := err.(*InvalidPatchError); !ok {
+			t.Errorf("%s: got %v, want an invalid patch", tt.name, err)
+		}
+	}
+}
+
+func TestJSONPatchLeavesDocument(t *testing.T) {
+	doc := []byte(`{"a":[1,2]}`)
+	if _, err := JSONPatch(doc, []byte(`[{"op":"remove","path":"/a/0"},{"op":"test","path":"/b","value":1}]`)); err != ErrPathNotFound {
+		t.Fatalf("got %v", err)
+	}
+	if string(doc) != `{"a":[1,2]}` {
+		t.Errorf("a failed patch changed the document to %s", doc)
+	}
+}
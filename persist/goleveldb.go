@@ -1,13 +1,17 @@
 package persist
 
 import (
+	"bytes"
 	"fmt"
-	"time"
+	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tinylib/msgp/msgp"
 )
 
 // NewGoLevelDBStore creates a new store backed by goleveldb
@@ -16,11 +20,58 @@ func NewGoLevelDBStore(cfg *viper.Viper) (Store, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &goleveldbStore{
-		DB: db,
-	}, nil
+	store := &goleveldbStore{
+		DB:                db,
+		done:              make(chan struct{}),
+		watchers:          make(map[string]chan struct{}),
+		sequences:         make(map[string]*goleveldbSequence),
+		sequenceBlockSize: cfg.GetInt64("store.sequence_block_size"),
+		indexes:           make(map[string]*goleveldbIndex),
+		searchPrefixes:    cfg.GetStringSlice("store.search_prefixes"),
+	}
+	if err := store.loadIndexes(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := store.loadSearch(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := store.loadMerkle(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := store.loadChanges(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	if err := store.loadNodeID(cfg.GetString("store.node_id")); err != nil {
+		db.Close()
+		return nil, err
+	}
+	go store.expireSessions(cfg.GetDuration("store.session_check_interval"))
+	go store.pruneChanges(cfg.GetDuration("store.changes_retention"), uint64(cfg.GetInt64("store.changes_max")))
+	go store.pruneTombstones(cfg.GetDuration("store.tombstones_retention"))
+	return store, nil
+}
+
+// goleveldbInternalPrefix starts the keys the store uses to keep its own state,
+// these keys are never part of the prefix scans over the entries
+const goleveldbInternalPrefix = "\x00"
+
+// goleveldbEntryRange is the range of the entries that start with prefix,
+// without a prefix this excludes the internal keys
+func goleveldbEntryRange(prefix string) *util.Range {
+	if prefix == "" {
+		return &util.Range{Start: []byte{1}}
+	}
+	return util.BytesPrefix([]byte(prefix))
 }
 
+// goleveldbDeleteBatchSize is the max number of keys removed in a single write batch
+// when deleting by prefix, this keeps the memory and the write stalls bounded
+const goleveldbDeleteBatchSize = 1000
+
 var (
 	goleveldbSyncWrite   *opt.WriteOptions
 	goleveldbNoCacheRead *opt.ReadOptions
@@ -60,11 +111,120 @@ func goleveldbRewriteValueError(value []byte, err error) (Value, error) {
 	return result, nil
 }
 
+// goleveldbValueStats reads the size of the value and the last updated time from
+// an encoded value, without copying the value itself
+func goleveldbValueStats(data []byte) (int64, int64, error) {
+	sz, data, err := msgp.ReadMapHeaderBytes(data)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var size, lastUpdated int64
+	for ; sz > 0; sz-- {
+		var field []byte
+		field, data, err = msgp.ReadMapKeyZC(data)
+		if err != nil {
+			return 0, 0, err
+		}
+		switch msgp.UnsafeString(field) {
+		case "Value":
+			var value []byte
+			value, data, err = msgp.ReadBytesZC(data)
+			size = int64(len(value))
+		case "LastUpdated":
+			lastUpdated, data, err = msgp.ReadInt64Bytes(data)
+		default:
+			data, err = msgp.Skip(data)
+		}
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+	return size, lastUpdated, nil
+}
+
 type goleveldbStore struct {
 	DB *leveldb.DB
+
+	// writeLock serializes the writes that need to read an entry before changing it
+	writeLock sync.Mutex
+	done      chan struct{}
+	closeOnce sync.Once
+
+	watchLock sync.Mutex
+	watchers  map[string]chan struct{}
+
+	// sequenceLock guards the blocks of ids that are reserved for the sequences
+	sequenceLock      sync.Mutex
+	sequences         map[string]*goleveldbSequence
+	sequenceBlockSize int64
+
+	// indexes changes while holding both the write lock and indexLock,
+	// so the writes that maintain the index entries can read it without taking indexLock
+	indexLock sync.RWMutex
+	indexes   map[string]*goleveldbIndex
+
+	// searchPrefixes are the prefixes of the entries in the search index,
+	// searchDocs is the number of entries in it and changes while holding the write lock
+	searchPrefixes []string
+	searchDocs     uint64
+
+	// lastRevision and lastSequence are the revision and the sequence of the last change that was written,
+	// they change while holding the write lock. The changes recorded in pendingBatch come after those and
+	// count once the batch is written, changesLock guards the horizon of the changes log
+	lastRevision    int64
+	lastSequence    uint64
+	pendingBatch    *leveldb.Batch
+	pendingRevision int64
+	pendingChanges  uint64
+	changesLock     sync.RWMutex
+	changesHorizon  int64
+
+	// merkleLock guards the last merkle tree that was built, it stays good until the next change
+	merkleLock     sync.Mutex
+	merkleTree     *MerkleTree
+	merkleSequence uint64
+
+	// clock stamps the writes, nodeID is the origin of the writes made here.
+	// command is the command of a cluster that is being applied and changes while holding the write lock
+	clock   hybridClock
+	nodeID  string
+	command *goleveldbCommand
+}
+
+// stamp sets the time of a write made here and its origin
+func (g *goleveldbStore) stamp(value *Value) {
+	value.LastUpdated, value.Origin = g.now()
+}
+
+// watch returns a channel that gets closed the next time notify is called for the key
+func (g *goleveldbStore) watch(key string) <-chan struct{} {
+	g.watchLock.Lock()
+	defer g.watchLock.Unlock()
+
+	ch, ok := g.watchers[key]
+	if !ok {
+		ch = make(chan struct{})
+		g.watchers[key] = ch
+	}
+	return ch
+}
+
+// notify wakes up everyone that is watching the key
+func (g *goleveldbStore) notify(key string) {
+	g.watchLock.Lock()
+	defer g.watchLock.Unlock()
+
+	if ch, ok := g.watchers[key]; ok {
+		close(ch)
+		delete(g.watchers, key)
+	}
 }
 
 func (g *goleveldbStore) Put(key string, value *Value) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
 	// need this to be 0 when this is a new entry
 	newVersion := value.Version
 
@@ -76,20 +236,49 @@ func (g *goleveldbStore) Put(key string, value *Value) error {
 		if err == ErrNotFound && newVersion != 0 {
 			return ErrGone
 		}
-		value.Version = VersionOf(value.Value)
 	}
 
 	if prev.Version != newVersion {
 		return ErrVersionMismatch
 	}
+	if value.Labels == nil {
+		value.Labels = prev.Labels
+	}
+	if err := ValidateLabels(value.Labels); err != nil {
+		return err
+	}
+
+	batch := new(leveldb.Batch)
+	switch {
+	case value.Session == "":
+		// updates keep the session binding of the entry
+		value.Session = prev.Session
+	case value.Session != prev.Session:
+		if prev.Session != "" {
+			if _, err := g.liveSession(prev.Session); err == nil {
+				return ErrSessionConflict
+			}
+		}
+		if _, err := g.liveSession(value.Session); err != nil {
+			if err == ErrNotFound {
+				return ErrSessionNotFound
+			}
+			return err
+		}
+	}
 
-	value.LastUpdated = time.Now().UTC().UnixNano()
+	value.Version = versionOf(value)
+	g.stamp(value)
 	data, err := value.MarshalMsg(nil)
 	if err != nil {
 		return err
 	}
+	batch.Put([]byte(key), data)
+	if err := g.updateIndexes(batch, key, &prev, value); err != nil {
+		return err
+	}
 
-	return goleveldbRewriteError(g.DB.Put(UnsafeStringToBytes(key), data, goleveldbSyncWrite))
+	return g.writeChanges(batch)
 }
 
 func (g *goleveldbStore) Get(key string) (Value, error) {
@@ -97,12 +286,7 @@ func (g *goleveldbStore) Get(key string) (Value, error) {
 }
 
 func (g *goleveldbStore) FindByPrefix(prefix string) ([]KeyValue, error) {
-	var rg *util.Range
-	if prefix != "" {
-		rg = util.BytesPrefix(UnsafeStringToBytes(prefix))
-	}
-
-	iter := g.DB.NewIterator(rg, nil)
+	iter := g.DB.NewIterator(goleveldbEntryRange(prefix), nil)
 	var result []KeyValue
 	for iter.Next() {
 		value, err := goleveldbRewriteValueError(iter.Value(), nil)
@@ -110,7 +294,8 @@ func (g *goleveldbStore) FindByPrefix(prefix string) ([]KeyValue, error) {
 			iter.Release()
 			return nil, err
 		}
-		result = append(result, KeyValue{Key: UnsafeBytesToString(iter.Key()), Value: value})
+		// the iterator reuses the key buffer, so the key needs to be copied
+		result = append(result, KeyValue{Key: string(iter.Key()), Value: value})
 	}
 	iter.Release()
 
@@ -121,10 +306,472 @@ func (g *goleveldbStore) FindByPrefix(prefix string) ([]KeyValue, error) {
 	return result, nil
 }
 
+// ScanPrefix calls fn for the entries that start with prefix in key order while iterating over them,
+// the scan stops when fn returns false
+func (g *goleveldbStore) ScanPrefix(prefix string, fn func(KeyValue) bool) error {
+	iter := g.DB.NewIterator(goleveldbEntryRange(prefix), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return err
+		}
+		if !fn(KeyValue{Key: string(iter.Key()), Value: value}) {
+			return nil
+		}
+	}
+	return goleveldbRewriteError(iter.Error())
+}
+
+// FindKeys lists the keys that start with prefix, when the delimiter is not empty the keys
+// that contain the delimiter after the prefix are rolled up into a single common prefix,
+// which includes the delimiter.
+func (g *goleveldbStore) FindKeys(prefix, delimiter string) ([]string, error) {
+	iter := g.DB.NewIterator(goleveldbEntryRange(prefix), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var result []string
+	for iter.Next() {
+		key := string(iter.Key())
+		if delimiter == "" {
+			result = append(result, key)
+			continue
+		}
+
+		idx := strings.Index(key[len(prefix):], delimiter)
+		if idx < 0 {
+			result = append(result, key)
+			continue
+		}
+
+		common := key[:len(prefix)+idx+len(delimiter)]
+		result = append(result, common)
+		// skip all the other keys in this common prefix, Seek positions the iterator
+		// on the next key so step back once, the loop advances it again
+		limit := util.BytesPrefix([]byte(common)).Limit
+		if limit == nil || !iter.Seek(limit) {
+			break
+		}
+		iter.Prev()
+	}
+	if err := iter.Error(); err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	return result, nil
+}
+
+// Stats aggregates the sizes of the entries that start with prefix, this only decodes
+// the sizes and the last updated times of the values.
+func (g *goleveldbStore) Stats(prefix string) (Stats, error) {
+	rg := goleveldbEntryRange(prefix)
+	iter := g.DB.NewIterator(rg, goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var stats Stats
+	var lastKey []byte
+	for iter.Next() {
+		size, lastUpdated, err := goleveldbValueStats(iter.Value())
+		if err != nil {
+			return Stats{}, fmt.Errorf("msgp unmarshal failed: %v", err)
+		}
+
+		if stats.Keys == 0 || size < stats.MinSize {
+			stats.MinSize = size
+		}
+		if size > stats.MaxSize {
+			stats.MaxSize = size
+		}
+		if stats.Keys == 0 || lastUpdated < stats.Oldest {
+			stats.Oldest = lastUpdated
+		}
+		if lastUpdated > stats.Newest {
+			stats.Newest = lastUpdated
+		}
+		stats.Keys++
+		stats.TotalBytes += size
+		lastKey = append(lastKey[:0], iter.Key()...)
+	}
+	if err := iter.Error(); err != nil {
+		return Stats{}, goleveldbRewriteError(err)
+	}
+	if stats.Keys == 0 {
+		return stats, nil
+	}
+
+	// without a prefix there is no upper bound, so the range ends right after the last key
+	if rg.Limit == nil {
+		rg.Limit = append(lastKey, 0)
+	}
+	sizes, err := g.DB.SizeOf([]util.Range{*rg})
+	if err != nil {
+		return Stats{}, goleveldbRewriteError(err)
+	}
+	stats.DiskBytes = sizes.Sum()
+	return stats, nil
+}
+
 func (g *goleveldbStore) Delete(key string) error {
-	return goleveldbRewriteError(g.DB.Delete(UnsafeStringToBytes(key), goleveldbSyncWrite))
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err == ErrNotFound {
+		// there is nothing to delete, so there is no change and no tombstone
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	if err := g.updateIndexes(batch, key, &prev, nil); err != nil {
+		return err
+	}
+	batch.Delete([]byte(key))
+
+	return g.writeChanges(batch)
+}
+
+// DeleteByPrefix removes all the keys that start with prefix in bounded batches.
+// Every batch is committed on its own and the write lock is released between the batches, so other writes
+// don't wait for the whole prefix. When this gets interrupted the keys that remain can be removed by calling
+// this again with the same prefix.
+func (g *goleveldbStore) DeleteByPrefix(prefix string) (int, error) {
+	rng := goleveldbEntryRange(prefix)
+	var deleted int
+	for {
+		n, last, err := g.deleteBatch(rng)
+		deleted += n
+		if err != nil || n < goleveldbDeleteBatchSize {
+			return deleted, err
+		}
+		// the next batch starts right after the last key that was deleted
+		rng.Start = append(last, 0)
+	}
+}
+
+// deleteBatch removes up to a batch of the entries in the range in a single write,
+// it returns the number of entries it removed and the last key it removed
+func (g *goleveldbStore) deleteBatch(rng *util.Range) (int, []byte, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	iter := g.DB.NewIterator(rng, goleveldbNoCacheRead)
+	defer iter.Release()
+
+	// the batch also holds the index entry deletes, pending counts the entries
+	var pending int
+	var last []byte
+	batch := new(leveldb.Batch)
+	for pending < goleveldbDeleteBatchSize && iter.Next() {
+		prev, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return 0, nil, err
+		}
+		if err := g.updateIndexes(batch, string(iter.Key()), &prev, nil); err != nil {
+			return 0, nil, err
+		}
+		last = append([]byte(nil), iter.Key()...)
+		batch.Delete(last)
+		pending++
+	}
+	if err := iter.Error(); err != nil {
+		return 0, nil, goleveldbRewriteError(err)
+	}
+	if pending == 0 {
+		return 0, nil, nil
+	}
+	// deleting the prefix again removes the keys that remain, so the batches don't store the index
+	// of the command this is part of
+	if err := g.writeStep(batch); err != nil {
+		return 0, nil, err
+	}
+	return pending, last, nil
+}
+
+// Copy the entry at src to dst in a single write
+func (g *goleveldbStore) Copy(src, dst string, pre Precondition) (Value, error) {
+	return g.transfer(src, dst, pre, false)
+}
+
+// Move the entry at src to dst, the put and the delete happen in a single write
+func (g *goleveldbStore) Move(src, dst string, pre Precondition) (Value, error) {
+	return g.transfer(src, dst, pre, true)
+}
+
+func (g *goleveldbStore) transfer(src, dst string, pre Precondition, remove bool) (Value, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	value, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(src), goleveldbNoCacheRead))
+	if err != nil {
+		return Value{}, err
+	}
+	if pre.SourceVersion != 0 && pre.SourceVersion != value.Version {
+		return Value{}, ErrVersionMismatch
+	}
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(dst), goleveldbNoCacheRead))
+	if err != nil && err != ErrNotFound {
+		return Value{}, err
+	}
+	if pre.DestinationVersion != nil {
+		if err == ErrNotFound && *pre.DestinationVersion != 0 {
+			return Value{}, ErrGone
+		}
+		if prev.Version != *pre.DestinationVersion {
+			return Value{}, ErrVersionMismatch
+		}
+	}
+
+	if src == dst {
+		return value, nil
+	}
+
+	// the labels are copied with the value, the session binding belongs to the source entry
+	source := value
+	value.Session = ""
+	value.Version = versionOf(&value)
+	g.stamp(&value)
+	data, err := value.MarshalMsg(nil)
+	if err != nil {
+		return Value{}, err
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(dst), data)
+	if err := g.updateIndexes(batch, dst, &prev, &value); err != nil {
+		return Value{}, err
+	}
+	if remove {
+		batch.Delete([]byte(src))
+		if err := g.updateIndexes(batch, src, &source, nil); err != nil {
+			return Value{}, err
+		}
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return Value{}, err
+	}
+	return value, nil
+}
+
+// Incr adds delta to the counter stored at key, when the key doesn't exist yet the counter
+// starts at initial. The counter is stored as a base 10 int64.
+func (g *goleveldbStore) Incr(key string, delta, initial int64) (int64, Value, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	counter := initial
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err != nil && err != ErrNotFound {
+		return 0, Value{}, err
+	}
+	if err == nil {
+		counter, err = strconv.ParseInt(UnsafeBytesToString(prev.Value), 10, 64)
+		if err != nil {
+			return 0, Value{}, ErrNotCounter
+		}
+	}
+
+	next := counter + delta
+	if (delta > 0 && next < counter) || (delta < 0 && next > counter) {
+		return 0, Value{}, ErrOverflow
+	}
+
+	value := Value{Value: strconv.AppendInt(nil, next, 10), Session: prev.Session, Labels: prev.Labels}
+	value.Version = versionOf(&value)
+	g.stamp(&value)
+	data, err := value.MarshalMsg(nil)
+	if err != nil {
+		return 0, Value{}, err
+	}
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(key), data)
+	if err := g.updateIndexes(batch, key, &prev, &value); err != nil {
+		return 0, Value{}, err
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return 0, Value{}, err
+	}
+	return next, value, nil
+}
+
+// Update replaces the value at key with the result of fn, this happens while holding the write lock
+// so the value can't change in between. When version is not 0 the entry needs to have that version.
+func (g *goleveldbStore) Update(key string, version uint64, fn func([]byte) ([]byte, error)) (Value, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err != nil {
+		return Value{}, err
+	}
+	if version != 0 && prev.Version != version {
+		return Value{}, ErrVersionMismatch
+	}
+
+	data, err := fn(prev.Value)
+	if err != nil {
+		return Value{}, err
+	}
+
+	value := Value{Value: data, Session: prev.Session, Labels: prev.Labels}
+	value.Version = versionOf(&value)
+	g.stamp(&value)
+	enc, err := value.MarshalMsg(nil)
+	if err != nil {
+		return Value{}, err
+	}
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(key), enc)
+	if err := g.updateIndexes(batch, key, &prev, &value); err != nil {
+		return Value{}, err
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return Value{}, err
+	}
+	return value, nil
+}
+
+// Append adds data to the end of the value at key, when the key doesn't exist yet it gets created
+func (g *goleveldbStore) Append(key string, data []byte, opts AppendOptions) (Value, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err != nil {
+		if err != ErrNotFound {
+			return Value{}, err
+		}
+		if opts.Version != 0 {
+			return Value{}, ErrGone
+		}
+	}
+	if opts.Version != 0 && prev.Version != opts.Version {
+		return Value{}, ErrVersionMismatch
+	}
+
+	value := Value{Value: trimOldest(append(prev.Value, data...), opts.MaxSize, opts.Delimiter), Session: prev.Session, Labels: prev.Labels}
+	value.Version = versionOf(&value)
+	g.stamp(&value)
+	enc, err := value.MarshalMsg(nil)
+	if err != nil {
+		return Value{}, err
+	}
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(key), enc)
+	if err := g.updateIndexes(batch, key, &prev, &value); err != nil {
+		return Value{}, err
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return Value{}, err
+	}
+	return value, nil
+}
+
+// trimOldest removes data from the start of the value so it fits in maxSize, when there is a delimiter
+// this removes whole records. When the newest record doesn't fit on its own this trims by bytes.
+func trimOldest(value []byte, maxSize int, delimiter []byte) []byte {
+	if maxSize <= 0 || len(value) <= maxSize {
+		return value
+	}
+
+	start := len(value) - maxSize
+	if len(delimiter) > 0 {
+		// the first record that starts at or after start, which is right after a delimiter
+		lookFrom := start - len(delimiter)
+		if lookFrom < 0 {
+			lookFrom = 0
+		}
+		if idx := bytes.Index(value[lookFrom:], delimiter); idx >= 0 && lookFrom+idx+len(delimiter) < len(value) {
+			start = lookFrom + idx + len(delimiter)
+		}
+	}
+	return value[start:]
+}
+
+// CopyPrefix copies all the entries below the src prefix to the dst prefix in a single write
+func (g *goleveldbStore) CopyPrefix(src, dst string) (int, error) {
+	return g.transferPrefix(src, dst, false)
+}
+
+// MovePrefix moves all the entries below the src prefix to the dst prefix in a single write
+func (g *goleveldbStore) MovePrefix(src, dst string) (int, error) {
+	return g.transferPrefix(src, dst, true)
+}
+
+func (g *goleveldbStore) transferPrefix(src, dst string, remove bool) (int, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if src == dst {
+		return 0, nil
+	}
+
+	iter := g.DB.NewIterator(goleveldbEntryRange(src), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var moved []KeyValue
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return 0, err
+		}
+		moved = append(moved, KeyValue{Key: string(iter.Key()), Value: value})
+	}
+	if err := iter.Error(); err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	if len(moved) == 0 {
+		return 0, nil
+	}
+
+	// the deletes go in the batch before the puts, so that moving a prefix into a
+	// prefix below itself doesn't remove the entries it just wrote
+	batch := new(leveldb.Batch)
+	removed := make(map[string]bool, len(moved))
+	if remove {
+		for _, kv := range moved {
+			batch.Delete([]byte(kv.Key))
+			if err := g.updateIndexes(batch, kv.Key, &kv.Value, nil); err != nil {
+				return 0, err
+			}
+			removed[kv.Key] = true
+		}
+	}
+	now, origin := g.now()
+	for _, kv := range moved {
+		target := dst + strings.TrimPrefix(kv.Key, src)
+		// the index entries of a destination that is also a removed source are already gone
+		var prev Value
+		if !removed[target] {
+			var err error
+			prev, err = goleveldbRewriteValueError(g.DB.Get([]byte(target), goleveldbNoCacheRead))
+			if err != nil && err != ErrNotFound {
+				return 0, err
+			}
+		}
+
+		kv.Value.Session = ""
+		kv.Value.Version = versionOf(&kv.Value)
+		kv.Value.LastUpdated = now
+		kv.Value.Origin = origin
+		data, err := kv.Value.MarshalMsg(nil)
+		if err != nil {
+			return 0, err
+		}
+		batch.Put([]byte(target), data)
+		if err := g.updateIndexes(batch, target, &prev, &kv.Value); err != nil {
+			return 0, err
+		}
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return 0, err
+	}
+	return len(moved), nil
 }
 
 func (g *goleveldbStore) Close() error {
+	g.closeOnce.Do(func() { close(g.done) })
 	return g.DB.Close()
 }
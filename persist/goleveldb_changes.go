@@ -0,0 +1,445 @@
+package persist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbChangesPrefix starts the keys of the changes log
+	goleveldbChangesPrefix = goleveldbInternalPrefix + "changes/"
+	// goleveldbChangesHorizonKey holds the revision the changes log is complete after
+	goleveldbChangesHorizonKey = goleveldbChangesPrefix + "horizon"
+	// goleveldbChangesFirstKey holds the sequence of the oldest change that is kept
+	goleveldbChangesFirstKey = goleveldbChangesPrefix + "first"
+	// goleveldbChangesLogPrefix starts the changes, ordered by revision
+	goleveldbChangesLogPrefix = goleveldbChangesPrefix + "log/"
+	// goleveldbChangesSequencePrefix starts the sequences of the changes, they hold the revision of the change
+	goleveldbChangesSequencePrefix = goleveldbChangesPrefix + "seq/"
+	// goleveldbChangesPruneInterval is the time between looking for changes that are older than the retention
+	goleveldbChangesPruneInterval = time.Minute
+)
+
+func goleveldbChangeKey(revision int64) []byte {
+	key := make([]byte, len(goleveldbChangesLogPrefix)+8)
+	copy(key, goleveldbChangesLogPrefix)
+	binary.BigEndian.PutUint64(key[len(goleveldbChangesLogPrefix):], uint64(revision))
+	return key
+}
+
+func goleveldbChangeSequenceKey(sequence uint64) []byte {
+	key := make([]byte, len(goleveldbChangesSequencePrefix)+8)
+	copy(key, goleveldbChangesSequencePrefix)
+	binary.BigEndian.PutUint64(key[len(goleveldbChangesSequencePrefix):], sequence)
+	return key
+}
+
+func goleveldbRewriteChangeError(value []byte, err error) (Change, error) {
+	if err != nil {
+		return Change{}, goleveldbRewriteError(err)
+	}
+	var change Change
+	if _, err := change.UnmarshalMsg(value); err != nil {
+		return Change{}, fmt.Errorf("msgp unmarshal failed: %v", err)
+	}
+	return change, nil
+}
+
+// loadChanges reads the horizon, the first sequence and the last revision and sequence of the changes log,
+// a store that didn't have a changes log yet starts it now
+func (g *goleveldbStore) loadChanges() error {
+	data, err := g.DB.Get([]byte(goleveldbChangesHorizonKey), nil)
+	switch err {
+	case nil:
+		g.changesHorizon = int64(binary.BigEndian.Uint64(data))
+	case leveldb.ErrNotFound:
+		g.changesHorizon = time.Now().UTC().UnixNano()
+	default:
+		return goleveldbRewriteError(err)
+	}
+	var first uint64 = 1
+	data, err = g.DB.Get([]byte(goleveldbChangesFirstKey), nil)
+	switch err {
+	case nil:
+		first = binary.BigEndian.Uint64(data)
+	case leveldb.ErrNotFound:
+		// the changes that were logged before there were sequences can't be read by sequence
+		if err := g.putChangesHorizon(g.changesHorizon, first); err != nil {
+			return err
+		}
+	default:
+		return goleveldbRewriteError(err)
+	}
+	g.lastRevision = g.changesHorizon
+	g.lastSequence = first - 1
+
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbChangesLogPrefix)), nil)
+	defer iter.Release()
+	if iter.Last() {
+		g.lastRevision = int64(binary.BigEndian.Uint64(iter.Key()[len(goleveldbChangesLogPrefix):]))
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+
+	seqIter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbChangesSequencePrefix)), nil)
+	defer seqIter.Release()
+	if seqIter.Last() {
+		g.lastSequence = binary.BigEndian.Uint64(seqIter.Key()[len(goleveldbChangesSequencePrefix):])
+	}
+	// the times of the writes stay after the ones of before a restart, even when the wall clock went back
+	g.clock.observe(g.lastRevision)
+	return goleveldbRewriteError(seqIter.Error())
+}
+
+// putChangesHorizon stores the horizon together with the first sequence, they move up at the same time
+func (g *goleveldbStore) putChangesHorizon(horizon int64, first uint64) error {
+	var b [8]byte
+	batch := new(leveldb.Batch)
+	binary.BigEndian.PutUint64(b[:], uint64(horizon))
+	batch.Put([]byte(goleveldbChangesHorizonKey), b[:])
+	binary.BigEndian.PutUint64(b[:], first)
+	batch.Put([]byte(goleveldbChangesFirstKey), b[:])
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
+
+// recordChange adds the change to the entry at key to the changes log in the batch, next is nil for a delete.
+// A delete leaves the tombstone, or a tombstone of its own when that is nil, and a write removes the tombstone.
+// The revisions and the sequences are handed out while holding the write lock, so they are in the order
+// the batches are written. They are taken only when writeChanges writes the batch, the changes of a batch
+// that doesn't get written are handed out again.
+func (g *goleveldbStore) recordChange(batch *leveldb.Batch, key string, next *Value, tombstone *Tombstone) error {
+	if batch != g.pendingBatch {
+		g.pendingBatch, g.pendingRevision, g.pendingChanges = batch, g.lastRevision, 0
+	}
+	revision, origin := g.now()
+	if revision <= g.pendingRevision {
+		revision = g.pendingRevision + 1
+	}
+
+	sequence := atomic.LoadUint64(&g.lastSequence) + g.pendingChanges + 1
+	change := Change{Sequence: sequence, Revision: revision, Key: key, Op: ChangeDelete}
+	if next != nil {
+		change.Version = next.Version
+		change.Op = ChangePut
+		change.Value = next
+	} else {
+		if tombstone == nil {
+			tombstone = &Tombstone{DeletedAt: revision, Origin: origin}
+		}
+		change.Tombstone = tombstone
+	}
+	data, err := change.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	if next != nil {
+		batch.Delete(goleveldbTombstoneKey(key))
+	} else {
+		tomb, err := tombstone.MarshalMsg(nil)
+		if err != nil {
+			return err
+		}
+		batch.Put(goleveldbTombstoneKey(key), tomb)
+	}
+	g.pendingRevision = revision
+	g.pendingChanges++
+
+	var rev [8]byte
+	binary.BigEndian.PutUint64(rev[:], uint64(revision))
+	batch.Put(goleveldbChangeKey(revision), data)
+	batch.Put(goleveldbChangeSequenceKey(change.Sequence), rev[:])
+	return nil
+}
+
+// writeChanges writes the batch together with the index of the command of a cluster that is being applied,
+// like writeStep does. This needs to be called while holding the write lock.
+func (g *goleveldbStore) writeChanges(batch *leveldb.Batch) error {
+	g.tagCommand(batch)
+	if err := g.writeStep(batch); err != nil {
+		return err
+	}
+	if g.command != nil {
+		g.command.stored = true
+	}
+	return nil
+}
+
+// writeStep writes the batch and hands out the revisions and the sequences of the changes recorded in it,
+// when the write fails they are handed out again so there are no gaps in the sequences. The writes that take
+// more than one batch write them with this, the command they are part of counts as applied once they are done.
+// This needs to be called while holding the write lock.
+func (g *goleveldbStore) writeStep(batch *leveldb.Batch) error {
+	err := g.DB.Write(batch, goleveldbSyncWrite)
+	if batch == g.pendingBatch {
+		if err == nil {
+			g.lastRevision = g.pendingRevision
+			atomic.StoreUint64(&g.lastSequence, atomic.LoadUint64(&g.lastSequence)+g.pendingChanges)
+		}
+		g.pendingBatch, g.pendingChanges = nil, 0
+	}
+	return goleveldbRewriteError(err)
+}
+
+// Changes lists the changes to the entries that start with prefix after the since revision in the order they happened,
+// up to limit changes. It also returns the revision to continue from, which is past the changes that were looked at.
+// When the changes log doesn't go back to since anymore this returns ErrChangesPruned, a since of 0 starts at the horizon.
+func (g *goleveldbStore) Changes(prefix string, since int64, limit int) ([]Change, int64, error) {
+	horizon := g.horizon()
+	if since != 0 && since < horizon {
+		return nil, 0, ErrChangesPruned
+	}
+
+	rng := util.BytesPrefix([]byte(goleveldbChangesLogPrefix))
+	rng.Start = goleveldbChangeKey(since + 1)
+	iter := g.DB.NewIterator(rng, nil)
+	defer iter.Release()
+
+	next := since
+	var result []Change
+	for (limit <= 0 || len(result) < limit) && iter.Next() {
+		change, err := goleveldbRewriteChangeError(iter.Value(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		next = change.Revision
+		if strings.HasPrefix(change.Key, prefix) {
+			result = append(result, change)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, goleveldbRewriteError(err)
+	}
+	return result, next, nil
+}
+
+// ChangeLog reads the changes starting at the from sequence in the order they happened, up to limit changes.
+// It also returns the sequence to continue from and the sequence of the oldest change that is kept,
+// a from of 0 starts at that oldest change. When the changes starting at from were pruned this returns ErrChangesPruned.
+// Only the changes to the entries are in the changes log, the hashes, sorted sets, queues, sequences, locks,
+// semaphores and sessions are not.
+func (g *goleveldbStore) ChangeLog(from uint64, limit int) ([]Change, uint64, uint64, error) {
+	// the snapshot has the first sequence that goes with the changes that are in it, the changes
+	// that get pruned while reading are still there so there is no gap between from and the changes
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return nil, 0, 0, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	data, err := snap.Get([]byte(goleveldbChangesFirstKey), nil)
+	if err != nil {
+		return nil, 0, 0, goleveldbRewriteError(err)
+	}
+	first := binary.BigEndian.Uint64(data)
+	if from == 0 {
+		from = first
+	}
+	if from < first {
+		return nil, 0, first, ErrChangesPruned
+	}
+
+	rev, err := snap.Get(goleveldbChangeSequenceKey(from), nil)
+	if err == leveldb.ErrNotFound {
+		// from is past the last change
+		return nil, from, first, nil
+	}
+	if err != nil {
+		return nil, 0, 0, goleveldbRewriteError(err)
+	}
+
+	rng := util.BytesPrefix([]byte(goleveldbChangesLogPrefix))
+	rng.Start = goleveldbChangeKey(int64(binary.BigEndian.Uint64(rev)))
+	iter := snap.NewIterator(rng, nil)
+	defer iter.Release()
+
+	next := from
+	var result []Change
+	for (limit <= 0 || len(result) < limit) && iter.Next() {
+		change, err := goleveldbRewriteChangeError(iter.Value(), nil)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		result = append(result, change)
+		next = change.Sequence + 1
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, 0, goleveldbRewriteError(err)
+	}
+	return result, next, first, nil
+}
+
+// NextChange returns the sequence the next change gets
+func (g *goleveldbStore) NextChange() uint64 {
+	return atomic.LoadUint64(&g.lastSequence) + 1
+}
+
+// ModifiedKeys lists the keys that start with prefix of the entries that were written at or after since in order,
+// since is a time in unix nanoseconds. When the changes log doesn't go back that far this looks at every entry below the prefix.
+func (g *goleveldbStore) ModifiedKeys(prefix string, since int64) ([]string, error) {
+	if since <= g.horizon() {
+		var keys []string
+		err := g.ScanPrefix(prefix, func(kv KeyValue) bool {
+			if kv.Value.LastUpdated >= since {
+				keys = append(keys, kv.Key)
+			}
+			return true
+		})
+		return keys, err
+	}
+
+	changes, _, err := g.Changes(prefix, since-1, 0)
+	if err != nil {
+		return nil, err
+	}
+	// the last change to a key decides if it still exists
+	exists := make(map[string]bool)
+	for _, change := range changes {
+		exists[change.Key] = change.Op == ChangePut
+	}
+	var keys []string
+	for key, ok := range exists {
+		if ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (g *goleveldbStore) horizon() int64 {
+	g.changesLock.RLock()
+	defer g.changesLock.RUnlock()
+	return g.changesHorizon
+}
+
+// pruneChanges periodically removes the changes that are older than the retention from the changes log
+// and the oldest changes when there are more than max, without a retention and a max the changes log keeps everything
+func (g *goleveldbStore) pruneChanges(retention time.Duration, max uint64) {
+	if retention <= 0 && max == 0 {
+		return
+	}
+	ticker := time.NewTicker(goleveldbChangesPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		// the changes that are left after a failure get removed the next time
+		_ = g.pruneChangesOver(retention, max)
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pruneChangesOver finds the revision before which the changes are over the retention or the max and prunes them
+func (g *goleveldbStore) pruneChangesOver(retention time.Duration, max uint64) error {
+	var cutoff int64
+	if retention > 0 {
+		cutoff = time.Now().UTC().Add(-retention).UnixNano()
+	}
+	if last := atomic.LoadUint64(&g.lastSequence); max > 0 && last > max {
+		rev, err := g.DB.Get(goleveldbChangeSequenceKey(last-max), nil)
+		if err != nil && err != leveldb.ErrNotFound {
+			return goleveldbRewriteError(err)
+		}
+		if err == nil && int64(binary.BigEndian.Uint64(rev)) > cutoff {
+			cutoff = int64(binary.BigEndian.Uint64(rev))
+		}
+	}
+	return g.pruneChangesBefore(cutoff)
+}
+
+// pruneChangesBefore moves the horizon up to the cutoff and the first sequence past the changes before it,
+// then removes those changes and compacts what is left of them on disk
+func (g *goleveldbStore) pruneChangesBefore(cutoff int64) error {
+	first, err := g.moveChangesHorizon(cutoff)
+	if err != nil || first == 0 {
+		return err
+	}
+
+	logRange := &util.Range{Start: []byte(goleveldbChangesLogPrefix), Limit: goleveldbChangeKey(cutoff + 1)}
+	if err := g.deleteRange(logRange); err != nil {
+		return err
+	}
+	seqRange := &util.Range{Start: []byte(goleveldbChangesSequencePrefix), Limit: goleveldbChangeSequenceKey(first)}
+	if err := g.deleteRange(seqRange); err != nil {
+		return err
+	}
+	// the deletes stay on disk until the tables they are in get compacted, the changes log only grows
+	// at the end so without this the start of it rarely gets compacted
+	if err := g.DB.CompactRange(*logRange); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.CompactRange(*seqRange))
+}
+
+// moveChangesHorizon moves the horizon up to the cutoff and returns the new first sequence,
+// this is 0 when the horizon is past the cutoff already. The write lock keeps the changes
+// from being logged while the first sequence after the cutoff is looked up.
+func (g *goleveldbStore) moveChangesHorizon(cutoff int64) (uint64, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if cutoff <= g.horizon() {
+		return 0, nil
+	}
+
+	first := atomic.LoadUint64(&g.lastSequence) + 1
+	rng := util.BytesPrefix([]byte(goleveldbChangesLogPrefix))
+	rng.Start = goleveldbChangeKey(cutoff + 1)
+	iter := g.DB.NewIterator(rng, nil)
+	if iter.Next() {
+		change, err := goleveldbRewriteChangeError(iter.Value(), nil)
+		if err != nil {
+			iter.Release()
+			return 0, err
+		}
+		if change.Sequence != 0 {
+			first = change.Sequence
+		}
+	}
+	err := iter.Error()
+	iter.Release()
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+
+	g.changesLock.Lock()
+	defer g.changesLock.Unlock()
+	if err := g.putChangesHorizon(cutoff, first); err != nil {
+		return 0, err
+	}
+	g.changesHorizon = cutoff
+	return first, nil
+}
+
+// deleteRange removes the keys in the range in batches
+func (g *goleveldbStore) deleteRange(rng *util.Range) error {
+	iter := g.DB.NewIterator(rng, goleveldbNoCacheRead)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		if batch.Len() < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
@@ -0,0 +1,254 @@
+package persist
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// checkSequences fails when the changes log doesn't hold the keys in order with sequences that have no gaps
+func checkSequences(t *testing.T, store Store, keys ...string) {
+	changes, next, first, err := store.ChangeLog(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != len(keys) {
+		t.Fatalf("the changes log has %d changes, want %d: %+v", len(changes), len(keys), changes)
+	}
+	for i, change := range changes {
+		if change.Sequence != first+uint64(i) || change.Key != keys[i] {
+			t.Errorf("change %d is %d for %s, want %d for %s", i, change.Sequence, change.Key, first+uint64(i), keys[i])
+		}
+	}
+	if next != first+uint64(len(keys)) {
+		t.Errorf("the next sequence is %d, want %d", next, first+uint64(len(keys)))
+	}
+}
+
+func TestChangeLogWithoutGaps(t *testing.T) {
+	store := newTestStore(t)
+	g := store.(*goleveldbStore)
+
+	if err := store.Put("a", &Value{Value: []byte("1")}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the changes of a batch that never gets written don't take a sequence
+	g.writeLock.Lock()
+	if err := g.recordChange(new(leveldb.Batch), "lost", &Value{Value: []byte("x")}, nil); err != nil {
+		t.Fatal(err)
+	}
+	g.writeLock.Unlock()
+
+	if err := store.Put("b", &Value{Value: []byte("2")}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Move("b", "c", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+	checkSequences(t, store, "a", "b", "c", "b")
+}
+
+func TestDeleteMissingKey(t *testing.T) {
+	store := newTestStore(t)
+	g := store.(*goleveldbStore)
+
+	if err := store.Delete("missing"); err != nil {
+		t.Fatal(err)
+	}
+	checkSequences(t, store)
+	if _, err := g.DB.Get(goleveldbTombstoneKey("missing"), nil); err != leveldb.ErrNotFound {
+		t.Errorf("deleting a key that doesn't exist left a tombstone: %v", err)
+	}
+
+	if err := store.Put("a", &Value{Value: []byte("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("a"); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("a"); err != nil {
+		t.Fatal(err)
+	}
+	checkSequences(t, store, "a", "a")
+}
+
+func TestReleasedSessionEntries(t *testing.T) {
+	store := newTestStore(t)
+
+	session, err := store.CreateSession(Session{TTL: int64(time.Minute), Behavior: SessionRelease})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("a", &Value{Value: []byte("1"), Session: session.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DestroySession(session.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Session != "" {
+		t.Errorf("the entry is still bound to %s", value.Session)
+	}
+	checkSequences(t, store, "a", "a")
+	changes, _, _, err := store.ChangeLog(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if last := changes[len(changes)-1]; last.Op != ChangePut || last.Value.Session != "" {
+		t.Errorf("releasing the entry was logged as %+v", last)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	store := newTestStore(t)
+
+	writes := []struct {
+		key, value string
+	}{
+		{"a/1", "one"},
+		{"b/1", "other"},
+		{"a/2", "two"},
+		{"a/1", "uno"},
+		{"a/2", ""},
+	}
+	versions := make(map[string]uint64)
+	for _, w := range writes {
+		var err error
+		if w.value == "" {
+			err = store.Delete(w.key)
+		} else {
+			err = store.Put(w.key, &Value{Value: []byte(w.value), Version: versions[w.key]})
+			versions[w.key] = VersionOf([]byte(w.value))
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	all, next, err := store.Changes("", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(writes) {
+		t.Fatalf("got %d changes, want %d", len(all), len(writes))
+	}
+	for i, change := range all {
+		w := writes[i]
+		wantOp, wantVersion := ChangePut, VersionOf([]byte(w.value))
+		if w.value == "" {
+			wantOp, wantVersion = ChangeDelete, 0
+		}
+		if change.Key != w.key || change.Op != wantOp || change.Version != wantVersion {
+			t.Errorf("change %d is %s %s at %d, want %s %s at %d", i, change.Op, change.Key, change.Version, wantOp, w.key, wantVersion)
+		}
+		if i > 0 && change.Revision <= all[i-1].Revision {
+			t.Errorf("change %d has revision %d after %d", i, change.Revision, all[i-1].Revision)
+		}
+	}
+	if next != all[len(all)-1].Revision {
+		t.Errorf("the listing continues from %d, want %d", next, all[len(all)-1].Revision)
+	}
+
+	// the pages of the changes below a prefix continue where the previous one stopped
+	var keys []string
+	since := int64(0)
+	for {
+		page, next, err := store.Changes("a/", since, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(page) == 0 {
+			break
+		}
+		for _, change := range page {
+			keys = append(keys, change.Key)
+		}
+		since = next
+	}
+	if got := strings.Join(keys, " "); got != "a/1 a/2 a/1 a/2" {
+		t.Errorf("the pages hold %s", got)
+	}
+
+	// the changes before the horizon are gone
+	g := store.(*goleveldbStore)
+	if err := g.pruneChangesBefore(all[1].Revision); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.Changes("", all[0].Revision, 0); err != ErrChangesPruned {
+		t.Errorf("reading pruned changes got %v", err)
+	}
+	rest, _, err := store.Changes("", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rest) != len(writes)-2 || rest[0].Revision != all[2].Revision {
+		t.Errorf("after pruning the changes start with %+v", rest)
+	}
+}
+
+func TestModifiedKeys(t *testing.T) {
+	store := newTestStore(t)
+
+	for _, key := range []string{"a/1", "a/2", "b/1"} {
+		if err := store.Put(key, &Value{Value: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	changes, _, err := store.Changes("", 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	since := changes[len(changes)-1].Revision + 1
+
+	if err := store.Put("a/3", &Value{Value: []byte("3")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("b/2", &Value{Value: []byte("2")}); err != nil {
+		t.Fatal(err)
+	}
+	a1, err := store.Get("a/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("a/1", &Value{Value: []byte("updated"), Version: a1.Version}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("a/2"); err != nil {
+		t.Fatal(err)
+	}
+	// written and deleted after since
+	if err := store.Put("a/4", &Value{Value: []byte("4")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("a/4"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		prefix string
+		since  int64
+		want   string
+	}{
+		{"a/", since, "a/1 a/3"},
+		{"", since, "a/1 a/3 b/2"},
+		// a time before the horizon looks at the entries themselves
+		{"a/", 0, "a/1 a/3"},
+		{"", 1, "a/1 a/3 b/1 b/2"},
+	}
+	for _, tt := range tests {
+		keys, err := store.ModifiedKeys(tt.prefix, tt.since)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := strings.Join(keys, " "); got != tt.want {
+			t.Errorf("the keys below %q modified since %d are %s, want %s", tt.prefix, tt.since, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package persist
+
+import (
+	"encoding/binary"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// goleveldbCommandAppliedKey holds the index of the last command of the raft log of a cluster that was applied
+const goleveldbCommandAppliedKey = goleveldbInternalPrefix + "cluster/applied"
+
+// goleveldbCommand is the command of the raft log whose writes are being applied,
+// time and origin stamp those writes the same way on every member of the cluster
+type goleveldbCommand struct {
+	index  uint64
+	time   int64
+	origin string
+	// stored is set once a write stored the index of the command
+	stored bool
+}
+
+// now returns the time and the origin of a write made here, the writes of a command get the ones of the command
+func (g *goleveldbStore) now() (int64, string) {
+	if cmd := g.command; cmd != nil && cmd.time != 0 {
+		return cmd.time, cmd.origin
+	}
+	return g.clock.now(), g.nodeID
+}
+
+// tagCommand adds the index of the command that is being applied to the batch, so the command counts as applied
+// exactly when its write is. This needs to be called while holding the write lock.
+func (g *goleveldbStore) tagCommand(batch *leveldb.Batch) {
+	if g.command != nil {
+		batch.Put([]byte(goleveldbCommandAppliedKey), goleveldbAppliedValue(g.command.index))
+	}
+}
+
+// writeBatch writes a batch without changes, together with the index of the command that is being applied.
+// This needs to be called while holding the write lock.
+func (g *goleveldbStore) writeBatch(batch *leveldb.Batch) error {
+	g.tagCommand(batch)
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	if g.command != nil {
+		g.command.stored = true
+	}
+	return nil
+}
+
+// ApplyCommand calls apply with the writes it makes stamped with the time and the origin of the command at index
+// of the raft log of a cluster. A write that takes a single batch stores the index in that batch, otherwise the index
+// is stored once apply returns. The writes that take more than one batch can be applied again.
+func (g *goleveldbStore) ApplyCommand(index uint64, at int64, origin string, apply func()) error {
+	g.writeLock.Lock()
+	g.clock.observe(at)
+	g.command = &goleveldbCommand{index: index, time: at, origin: origin}
+	g.writeLock.Unlock()
+
+	apply()
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+	cmd := g.command
+	g.command = nil
+	if cmd.stored {
+		return nil
+	}
+	return goleveldbRewriteError(g.DB.Put([]byte(goleveldbCommandAppliedKey), goleveldbAppliedValue(index), goleveldbSyncWrite))
+}
+
+// CommandApplied returns the index of the last command of the raft log of a cluster that was applied,
+// this is 0 when no command was applied yet
+func (g *goleveldbStore) CommandApplied() (uint64, error) {
+	data, err := g.DB.Get([]byte(goleveldbCommandAppliedKey), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
+
+// SetCommandApplied stores the index of the last command of the raft log of a cluster that was applied,
+// for when the entries of the store were replaced
+func (g *goleveldbStore) SetCommandApplied(index uint64) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+	return goleveldbRewriteError(g.DB.Put([]byte(goleveldbCommandAppliedKey), goleveldbAppliedValue(index), goleveldbSyncWrite))
+}
+
+// Now returns the next time of the hybrid logical clock of the store in unix nanoseconds
+func (g *goleveldbStore) Now() int64 {
+	return g.clock.now()
+}
@@ -0,0 +1,73 @@
+package persist
+
+import "testing"
+
+func TestApplyCommand(t *testing.T) {
+	store := newTestStore(t)
+
+	const at, origin = int64(1000), "leader"
+	err := store.ApplyCommand(3, at, origin, func() {
+		if err := store.Put("a", &Value{Value: []byte("1")}); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.LastUpdated != at || value.Origin != origin {
+		t.Errorf("the command wrote the entry at %d by %s, want %d by %s", value.LastUpdated, value.Origin, at, origin)
+	}
+	if applied, err := store.CommandApplied(); err != nil || applied != 3 {
+		t.Errorf("the applied command is %d: %v", applied, err)
+	}
+
+	// a command that doesn't write counts as applied too, the deletes it makes get its time and origin
+	if err := store.ApplyCommand(4, at+1, origin, func() {}); err != nil {
+		t.Fatal(err)
+	}
+	if applied, err := store.CommandApplied(); err != nil || applied != 4 {
+		t.Errorf("the applied command is %d: %v", applied, err)
+	}
+	err = store.ApplyCommand(5, at+2, origin, func() {
+		if _, err := store.DeleteByPrefix(""); err != nil {
+			t.Fatal(err)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes, _, _, err := store.ChangeLog(0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := changes[len(changes)-1]
+	if last.Op != ChangeDelete || last.Tombstone.DeletedAt < at+2 || last.Tombstone.Origin != origin {
+		t.Errorf("the delete was logged as %+v", last)
+	}
+	if applied, err := store.CommandApplied(); err != nil || applied != 5 {
+		t.Errorf("the applied command is %d: %v", applied, err)
+	}
+
+	// the writes made outside of a command get the time and the origin of this store
+	if err := store.Put("b", &Value{Value: []byte("2")}); err != nil {
+		t.Fatal(err)
+	}
+	value, err = store.Get("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value.Origin != store.NodeID() || value.LastUpdated <= at+2 {
+		t.Errorf("the entry was written at %d by %s", value.LastUpdated, value.Origin)
+	}
+
+	if err := store.SetCommandApplied(0); err != nil {
+		t.Fatal(err)
+	}
+	if applied, err := store.CommandApplied(); err != nil || applied != 0 {
+		t.Errorf("the applied command is %d: %v", applied, err)
+	}
+}
@@ -0,0 +1,183 @@
+package persist
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// goleveldbHashesPrefix starts the keys of the hashes, every field of a hash is its own record
+// next to the version of the hash
+const goleveldbHashesPrefix = goleveldbInternalPrefix + "hashes/"
+
+func goleveldbHashFieldsPrefix(key string) []byte {
+	return []byte(goleveldbHashesPrefix + key + "/f/")
+}
+
+func goleveldbHashFieldKey(key, field string) []byte {
+	return []byte(goleveldbHashesPrefix + key + "/f/" + field)
+}
+
+func goleveldbHashVersionKey(key string) []byte {
+	return []byte(goleveldbHashesPrefix + key + "/v")
+}
+
+// hashReader is implemented by the database and its snapshots
+type hashReader interface {
+	Get([]byte, *opt.ReadOptions) ([]byte, error)
+}
+
+func goleveldbHashVersion(r hashReader, key string) (uint64, error) {
+	data, err := r.Get(goleveldbHashVersionKey(key), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
+
+// HGet gets a field of the hash together with the version of the hash
+func (g *goleveldbStore) HGet(key, field string) (Value, uint64, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return Value{}, 0, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	value, err := goleveldbRewriteValueError(snap.Get(goleveldbHashFieldKey(key, field), nil))
+	if err != nil {
+		return Value{}, 0, err
+	}
+	version, err := goleveldbHashVersion(snap, key)
+	if err != nil {
+		return Value{}, 0, err
+	}
+	return value, version, nil
+}
+
+// HGetAll gets all the fields of the hash together with the version of the hash,
+// a hash without fields is not found
+func (g *goleveldbStore) HGetAll(key string) (map[string]Value, uint64, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return nil, 0, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	prefix := string(goleveldbHashFieldsPrefix(key))
+	iter := snap.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	fields := make(map[string]Value)
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		fields[strings.TrimPrefix(string(iter.Key()), prefix)] = value
+	}
+	if err := iter.Error(); err != nil {
+		return nil, 0, goleveldbRewriteError(err)
+	}
+	if len(fields) == 0 {
+		return nil, 0, ErrNotFound
+	}
+
+	version, err := goleveldbHashVersion(snap, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	return fields, version, nil
+}
+
+// checkFieldVersion checks the version of the field when it is not nil, a version of 0 requires the field to not exist.
+// It reports if the field exists.
+func (g *goleveldbStore) checkFieldVersion(key, field string, version *uint64) (bool, error) {
+	prev, err := goleveldbRewriteValueError(g.DB.Get(goleveldbHashFieldKey(key, field), nil))
+	if err != nil && err != ErrNotFound {
+		return false, err
+	}
+	exists := err == nil
+	if version != nil {
+		if *version == 0 && exists {
+			return false, ErrVersionMismatch
+		}
+		if *version != 0 && (!exists || prev.Version != *version) {
+			return false, ErrVersionMismatch
+		}
+	}
+	return exists, nil
+}
+
+// nextHashVersion adds the increment of the version of the hash to the batch
+func (g *goleveldbStore) nextHashVersion(batch *leveldb.Batch, key string) (uint64, error) {
+	version, err := goleveldbHashVersion(g.DB, key)
+	if err != nil {
+		return 0, err
+	}
+	version++
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], version)
+	batch.Put(goleveldbHashVersionKey(key), b[:])
+	return version, nil
+}
+
+// HSet sets the value of a field of the hash and returns the field with the new version of the hash.
+// When the version is not nil the field needs to have that version, a version of 0 requires the field to not exist.
+func (g *goleveldbStore) HSet(key, field string, data []byte, version *uint64) (Value, uint64, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if _, err := g.checkFieldVersion(key, field, version); err != nil {
+		return Value{}, 0, err
+	}
+
+	value := Value{Value: data, Version: VersionOf(data)}
+	g.stamp(&value)
+	encoded, err := value.MarshalMsg(nil)
+	if err != nil {
+		return Value{}, 0, err
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put(goleveldbHashFieldKey(key, field), encoded)
+	hashVersion, err := g.nextHashVersion(batch, key)
+	if err != nil {
+		return Value{}, 0, err
+	}
+	if err := g.writeBatch(batch); err != nil {
+		return Value{}, 0, err
+	}
+	return value, hashVersion, nil
+}
+
+// HDelete removes a field from the hash and returns the new version of the hash,
+// the version check works like the one for HSet. Removing a field that doesn't exist doesn't change the hash.
+func (g *goleveldbStore) HDelete(key, field string, version *uint64) (uint64, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	exists, err := g.checkFieldVersion(key, field, version)
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return goleveldbHashVersion(g.DB, key)
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Delete(goleveldbHashFieldKey(key, field))
+	hashVersion, err := g.nextHashVersion(batch, key)
+	if err != nil {
+		return 0, err
+	}
+	if err := g.writeBatch(batch); err != nil {
+		return 0, err
+	}
+	return hashVersion, nil
+}
@@ -0,0 +1,104 @@
+package persist
+
+import "testing"
+
+func TestHashConditionalSet(t *testing.T) {
+	store := newTestStore(t)
+	zero := uint64(0)
+
+	// a version of 0 only sets a field that doesn't exist yet
+	a, v1, err := store.HSet("h", "a", []byte("1"), &zero)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Version != VersionOf([]byte("1")) || v1 != 1 {
+		t.Errorf("the new field has version %d and the hash %d", a.Version, v1)
+	}
+	if _, _, err := store.HSet("h", "a", []byte("2"), &zero); err != ErrVersionMismatch {
+		t.Errorf("creating an existing field got %v", err)
+	}
+
+	// a version needs to match the field
+	stale := a.Version + 1
+	if _, _, err := store.HSet("h", "a", []byte("2"), &stale); err != ErrVersionMismatch {
+		t.Errorf("setting a field with a stale version got %v", err)
+	}
+	if _, _, err := store.HSet("h", "missing", []byte("2"), &a.Version); err != ErrVersionMismatch {
+		t.Errorf("setting a missing field with a version got %v", err)
+	}
+	updated, v2, err := store.HSet("h", "a", []byte("2"), &a.Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Version == a.Version || v2 != v1+1 {
+		t.Errorf("the updated field has version %d and the hash %d", updated.Version, v2)
+	}
+
+	// without a version the field is set whatever it holds
+	if _, v3, err := store.HSet("h", "b", []byte("x"), nil); err != nil || v3 != v2+1 {
+		t.Errorf("setting a field without a version got %d, %v", v3, err)
+	}
+	if _, v4, err := store.HSet("h", "b", []byte("y"), nil); err != nil || v4 != v2+2 {
+		t.Errorf("overwriting a field without a version got %d, %v", v4, err)
+	}
+
+	value, version, err := store.HGet("h", "a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value.Value) != "2" || version != v2+2 {
+		t.Errorf("the field holds %q in hash version %d", value.Value, version)
+	}
+	fields, version, err := store.HGetAll("h")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fields) != 2 || string(fields["a"].Value) != "2" || string(fields["b"].Value) != "y" || version != v2+2 {
+		t.Errorf("the hash holds %v in version %d", fields, version)
+	}
+	if _, _, err := store.HGet("h", "missing"); err != ErrNotFound {
+		t.Errorf("getting a missing field got %v", err)
+	}
+}
+
+func TestHashDelete(t *testing.T) {
+	store := newTestStore(t)
+	a, _, err := store.HSet("h", "a", []byte("1"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, version, err := store.HSet("h", "b", []byte("2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// removing a field that doesn't exist leaves the hash alone
+	if got, err := store.HDelete("h", "missing", nil); err != nil || got != version {
+		t.Errorf("removing a missing field got %d, %v", got, err)
+	}
+	stale := a.Version + 1
+	if _, err := store.HDelete("h", "a", &stale); err != ErrVersionMismatch {
+		t.Errorf("removing a field with a stale version got %v", err)
+	}
+	zero := uint64(0)
+	if _, err := store.HDelete("h", "a", &zero); err != ErrVersionMismatch {
+		t.Errorf("removing an existing field with version 0 got %v", err)
+	}
+	if got, err := store.HDelete("h", "a", &a.Version); err != nil || got != version+1 {
+		t.Errorf("removing a field got %d, %v", got, err)
+	}
+	if got, err := store.HDelete("h", "b", nil); err != nil || got != version+2 {
+		t.Errorf("removing the last field got %d, %v", got, err)
+	}
+
+	// a hash without fields is gone, but its version keeps increasing
+	if _, _, err := store.HGetAll("h"); err != ErrNotFound {
+		t.Errorf("getting an empty hash got %v", err)
+	}
+	if _, got, err := store.HSet("h", "c", []byte("3"), &zero); err != nil || got != version+3 {
+		t.Errorf("setting a field of an emptied hash got %d, %v", got, err)
+	}
+	if _, _, err := store.HGetAll("other"); err != ErrNotFound {
+		t.Errorf("getting a missing hash got %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package persist
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/jsonpointer"
+	"github.com/go-openapi/kvstore/filter"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbIndexDefsPrefix starts the keys of the index definitions
+	goleveldbIndexDefsPrefix = goleveldbInternalPrefix + "index-defs/"
+	// goleveldbIndexesPrefix starts the keys of the index entries, an index entry is the value followed by a 0 byte and the key
+	goleveldbIndexesPrefix = goleveldbInternalPrefix + "indexes/"
+	// goleveldbBackfillBatchSize is the number of entries indexed per write when backfilling an index
+	goleveldbBackfillBatchSize = 1000
+)
+
+func goleveldbIndexDefKey(name string) []byte {
+	return []byte(goleveldbIndexDefsPrefix + name)
+}
+
+func goleveldbIndexPrefix(name string) []byte {
+	return []byte(goleveldbIndexesPrefix + name + "/")
+}
+
+func goleveldbIndexValuePrefix(name, value string) []byte {
+	return []byte(goleveldbIndexesPrefix + name + "/" + value + "\x00")
+}
+
+func goleveldbIndexEntryKey(name, value, key string) []byte {
+	return []byte(goleveldbIndexesPrefix + name + "/" + value + "\x00" + key)
+}
+
+// goleveldbIndex is an index definition with its parsed pointer
+type goleveldbIndex struct {
+	Index
+	pointer jsonpointer.Pointer
+}
+
+func newGoleveldbIndex(def Index) (*goleveldbIndex, error) {
+	ptr, err := jsonpointer.New(def.Pointer)
+	if err != nil {
+		return nil, err
+	}
+	return &goleveldbIndex{Index: def, pointer: ptr}, nil
+}
+
+// jsonDocument decodes a value on first use, the value is nil when the entry doesn't exist
+type jsonDocument struct {
+	data    []byte
+	decoded bool
+	doc     interface{}
+	ok      bool
+}
+
+func (j *jsonDocument) get() (interface{}, bool) {
+	if !j.decoded {
+		j.decoded = true
+		if j.data != nil {
+			doc, err := filter.Decode(j.data)
+			j.doc, j.ok = doc, err == nil
+		}
+	}
+	return j.doc, j.ok
+}
+
+// value finds the value the pointer of the index points at in the document. Strings, numbers, booleans and null
+// are indexed as their text, so the string "true" and the boolean true are the same value.
+func (idx *goleveldbIndex) value(doc *jsonDocument) (string, bool) {
+	d, ok := doc.get()
+	if !ok {
+		return "", false
+	}
+	v, ok := filter.Get(d, idx.pointer)
+	if !ok {
+		return "", false
+	}
+
+	var value string
+	switch t := v.(type) {
+	case string:
+		value = t
+	case json.Number:
+		value = t.String()
+	case bool:
+		value = fmt.Sprint(t)
+	case nil:
+		value = "null"
+	default:
+		return "", false
+	}
+	// the 0 byte separates the value from the key in the index entries
+	if strings.IndexByte(value, 0) >= 0 {
+		return "", false
+	}
+	return value, true
+}
+
+// updateIndexes adds the change to the changes log and the changes to the session binding, the label index,
+// the merkle bucket, the index entries and the search index for the entry at key to the batch, prev and next are the entry before and after the change
+// and nil when the entry doesn't exist. This needs to be called while holding the write lock,
+// the batch needs to be written with writeChanges.
+func (g *goleveldbStore) updateIndexes(batch *leveldb.Batch, key string, prev, next *Value) error {
+	if err := g.recordChange(batch, key, next, nil); err != nil {
+		return err
+	}
+	g.updateDerived(batch, key, prev, next)
+	return nil
+}
+
+// updateDerived adds the changes to the session binding, the labels, the merkle bucket, the search index and the indexes to the batch
+func (g *goleveldbStore) updateDerived(batch *leveldb.Batch, key string, prev, next *Value) {
+	var prevData, nextData []byte
+	var prevLabels, nextLabels map[string]string
+	var prevSession, nextSession string
+	if prev != nil {
+		prevData, prevLabels, prevSession = prev.Value, prev.Labels, prev.Session
+	}
+	if next != nil {
+		nextData, nextLabels, nextSession = next.Value, next.Labels, next.Session
+	}
+
+	updateSessionEntry(batch, key, prevSession, nextSession)
+	updateLabels(batch, key, prevLabels, nextLabels)
+	updateMerkle(batch, key, prev, next)
+	g.updateSearch(batch, key, prevData, nextData)
+	if len(g.indexes) == 0 {
+		return
+	}
+
+	before, after := &jsonDocument{data: prevData}, &jsonDocument{data: nextData}
+	for _, idx := range g.indexes {
+		if !strings.HasPrefix(key, idx.Prefix) {
+			continue
+		}
+		old, hadOld := idx.value(before)
+		current, hasCurrent := idx.value(after)
+		if hadOld && hasCurrent && old == current {
+			continue
+		}
+		if hadOld {
+			batch.Delete(goleveldbIndexEntryKey(idx.Name, old, key))
+		}
+		if hasCurrent {
+			batch.Put(goleveldbIndexEntryKey(idx.Name, current, key), nil)
+		}
+	}
+}
+
+// loadIndexes reads the index definitions and resumes the backfills that didn't finish
+func (g *goleveldbStore) loadIndexes() error {
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbIndexDefsPrefix)), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		var def Index
+		if _, err := def.UnmarshalMsg(iter.Value()); err != nil {
+			return fmt.Errorf("msgp unmarshal failed: %v", err)
+		}
+		idx, err := newGoleveldbIndex(def)
+		if err != nil {
+			return err
+		}
+		g.indexes[def.Name] = idx
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+
+	for _, idx := range g.indexes {
+		if !idx.Ready {
+			go g.backfillIndex(idx)
+		}
+	}
+	return nil
+}
+
+func (g *goleveldbStore) putIndexDef(def Index) error {
+	data, err := def.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return goleveldbRewriteError(g.DB.Put(goleveldbIndexDefKey(def.Name), data, goleveldbSyncWrite))
+}
+
+// CreateIndex creates the index, the writes from now on maintain it right away.
+// The entries that already exist get indexed in the background, the index is ready when that is done.
+func (g *goleveldbStore) CreateIndex(def Index) (Index, error) {
+	def.Ready = false
+	idx, err := newGoleveldbIndex(def)
+	if err != nil {
+		return Index{}, err
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if _, ok := g.indexes[def.Name]; ok {
+		return Index{}, ErrIndexExists
+	}
+	if err := g.putIndexDef(def); err != nil {
+		return Index{}, err
+	}
+
+	g.indexLock.Lock()
+	g.indexes[def.Name] = idx
+	g.indexLock.Unlock()
+
+	go g.backfillIndex(idx)
+	return def, nil
+}
+
+// backfillIndex indexes the entries that start with the prefix of the index a batch at a time.
+// Every batch reads the current values while holding the write lock, so this doesn't race with the writes
+// that maintain the index and running it again after an interruption is harmless.
+func (g *goleveldbStore) backfillIndex(idx *goleveldbIndex) {
+	rng := goleveldbEntryRange(idx.Prefix)
+	for {
+		select {
+		case <-g.done:
+			return
+		default:
+		}
+
+		keys, err := g.backfillKeys(rng)
+		if err != nil {
+			return
+		}
+
+		g.writeLock.Lock()
+		if g.indexes[idx.Name] != idx {
+			// the index was dropped
+			g.writeLock.Unlock()
+			return
+		}
+		batch := new(leveldb.Batch)
+		for _, key := range keys {
+			value, err := goleveldbRewriteValueError(g.DB.Get(key, nil))
+			if err != nil {
+				continue
+			}
+			if v, ok := idx.value(&jsonDocument{data: value.Value}); ok {
+				batch.Put(goleveldbIndexEntryKey(idx.Name, v, string(key)), nil)
+			}
+		}
+		err = g.DB.Write(batch, goleveldbSyncWrite)
+		if err == nil && len(keys) < goleveldbBackfillBatchSize {
+			def := idx.Index
+			def.Ready = true
+			if err = g.putIndexDef(def); err == nil {
+				g.indexLock.Lock()
+				idx.Ready = true
+				g.indexLock.Unlock()
+			}
+			g.writeLock.Unlock()
+			return
+		}
+		g.writeLock.Unlock()
+		if err != nil {
+			// this gets picked up again when the store is opened
+			return
+		}
+
+		rng.Start = append(keys[len(keys)-1], 0)
+	}
+}
+
+// backfillKeys gets the next batch of keys to index
+func (g *goleveldbStore) backfillKeys(rng *util.Range) ([][]byte, error) {
+	iter := g.DB.NewIterator(rng, goleveldbNoCacheRead)
+	defer iter.Release()
+
+	keys := make([][]byte, 0, goleveldbBackfillBatchSize)
+	for len(keys) < goleveldbBackfillBatchSize && iter.Next() {
+		keys = append(keys, append([]byte(nil), iter.Key()...))
+	}
+	return keys, goleveldbRewriteError(iter.Error())
+}
+
+// ListIndexes lists the index definitions ordered by name
+func (g *goleveldbStore) ListIndexes() ([]Index, error) {
+	g.indexLock.RLock()
+	defer g.indexLock.RUnlock()
+
+	result := make([]Index, 0, len(g.indexes))
+	for _, idx := range g.indexes {
+		result = append(result, idx.Index)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result, nil
+}
+
+// DropIndex removes the index definition and its entries
+func (g *goleveldbStore) DropIndex(name string) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if _, ok := g.indexes[name]; !ok {
+		return ErrNotFound
+	}
+	if err := goleveldbRewriteError(g.DB.Delete(goleveldbIndexDefKey(name), goleveldbSyncWrite)); err != nil {
+		return err
+	}
+	g.indexLock.Lock()
+	delete(g.indexes, name)
+	g.indexLock.Unlock()
+
+	iter := g.DB.NewIterator(util.BytesPrefix(goleveldbIndexPrefix(name)), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		if batch.Len() < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
+
+// QueryIndex finds the keys of the entries where the pointer of the index points at the value,
+// while the index isn't ready this doesn't find all of them yet
+func (g *goleveldbStore) QueryIndex(name, value string) (Index, []string, error) {
+	g.indexLock.RLock()
+	idx, ok := g.indexes[name]
+	var def Index
+	if ok {
+		def = idx.Index
+	}
+	g.indexLock.RUnlock()
+	if !ok {
+		return Index{}, nil, ErrNotFound
+	}
+
+	prefix := goleveldbIndexValuePrefix(name, value)
+	iter := g.DB.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	var keys []string
+	for iter.Next() {
+		keys = append(keys, string(iter.Key()[len(prefix):]))
+	}
+	if err := iter.Error(); err != nil {
+		return Index{}, nil, goleveldbRewriteError(err)
+	}
+	return def, keys, nil
+}
@@ -0,0 +1,127 @@
+package persist
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// waitReady waits for the backfill of the index to finish
+func waitReady(t *testing.T, store Store, name string) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		indexes, err := store.ListIndexes()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, idx := range indexes {
+			if idx.Name == name && idx.Ready {
+				return
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the index %s isn't ready", name)
+}
+
+// queryKeys finds the keys of the entries where the index has the value
+func queryKeys(t *testing.T, store Store, name, value string) []string {
+	_, keys, err := store.QueryIndex(name, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return keys
+}
+
+func TestIndexes(t *testing.T) {
+	store := newTestStore(t)
+	for key, value := range map[string]string{
+		"jobs/1":  `{"status":"failed","attempts":3}`,
+		"jobs/2":  `{"status":"done","attempts":1}`,
+		"jobs/3":  `{"status":"failed","attempts":1}`,
+		"jobs/4":  `not a document`,
+		"jobs/5":  `{"attempts":2}`,
+		"other/1": `{"status":"failed"}`,
+	} {
+		if err := store.Put(key, &Value{Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the existing entries get backfilled
+	for _, def := range []Index{
+		{Name: "status", Prefix: "jobs/", Pointer: "/status"},
+		{Name: "attempts", Prefix: "jobs/", Pointer: "/attempts"},
+	} {
+		if _, err := store.CreateIndex(def); err != nil {
+			t.Fatal(err)
+		}
+		waitReady(t, store, def.Name)
+	}
+	if _, err := store.CreateIndex(Index{Name: "status", Prefix: "", Pointer: "/x"}); err != ErrIndexExists {
+		t.Errorf("creating an index twice got %v", err)
+	}
+	if got := queryKeys(t, store, "status", "failed"); !reflect.DeepEqual(got, []string{"jobs/1", "jobs/3"}) {
+		t.Errorf("the failed jobs are %v", got)
+	}
+	if got := queryKeys(t, store, "attempts", "1"); !reflect.DeepEqual(got, []string{"jobs/2", "jobs/3"}) {
+		t.Errorf("the jobs with one attempt are %v", got)
+	}
+
+	// the writes maintain the index
+	if err := store.Put("jobs/6", &Value{Value: []byte(`{"status":"failed","attempts":true}`)}); err != nil {
+		t.Fatal(err)
+	}
+	job1, err := store.Get("jobs/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("jobs/1", &Value{Value: []byte(`{"status":"done","attempts":null}`), Version: job1.Version}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("jobs/3"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Move("jobs/2", "other/2", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Copy("other/1", "jobs/7", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name, value string
+		want        []string
+	}{
+		{"status", "failed", []string{"jobs/6", "jobs/7"}},
+		{"status", "done", []string{"jobs/1"}},
+		{"attempts", "1", nil},
+		{"attempts", "3", nil},
+		{"attempts", "2", []string{"jobs/5"}},
+		// booleans and null are indexed as their text
+		{"attempts", "true", []string{"jobs/6"}},
+		{"attempts", "null", []string{"jobs/1"}},
+	}
+	for _, tt := range tests {
+		if got := queryKeys(t, store, tt.name, tt.value); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %s: got %v, want %v", tt.name, tt.value, got, tt.want)
+		}
+	}
+
+	if err := store.DropIndex("status"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := store.QueryIndex("status", "failed"); err != ErrNotFound {
+		t.Errorf("querying a dropped index got %v", err)
+	}
+	if err := store.DropIndex("status"); err != ErrNotFound {
+		t.Errorf("dropping a dropped index got %v", err)
+	}
+	iter := store.(*goleveldbStore).DB.NewIterator(util.BytesPrefix(goleveldbIndexPrefix("status")), nil)
+	defer iter.Release()
+	if iter.Next() {
+		t.Errorf("the dropped index still has %q", iter.Key())
+	}
+}
@@ -0,0 +1,161 @@
+package persist
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// goleveldbLabelsPrefix starts the keys of the label index, an entry in the label index is
+// the label name, the label value and the key separated by 0 bytes
+const goleveldbLabelsPrefix = goleveldbInternalPrefix + "labels/"
+
+func goleveldbLabelPrefix(name string) []byte {
+	return []byte(goleveldbLabelsPrefix + name + "\x00")
+}
+
+func goleveldbLabelValuePrefix(name, value string) []byte {
+	return []byte(goleveldbLabelsPrefix + name + "\x00" + value + "\x00")
+}
+
+func goleveldbLabelKey(name, value, key string) []byte {
+	return []byte(goleveldbLabelsPrefix + name + "\x00" + value + "\x00" + key)
+}
+
+// updateLabels adds the changes to the label index for the entry at key to the batch
+func updateLabels(batch *leveldb.Batch, key string, prev, next map[string]string) {
+	for name, value := range prev {
+		if current, ok := next[name]; !ok || current != value {
+			batch.Delete(goleveldbLabelKey(name, value, key))
+		}
+	}
+	for name, value := range next {
+		if old, ok := prev[name]; !ok || old != value {
+			batch.Put(goleveldbLabelKey(name, value, key), nil)
+		}
+	}
+}
+
+// SetLabels replaces the labels of the entry at key, the value stays the same but the version changes.
+// When version is not 0 the entry needs to have that version.
+func (g *goleveldbStore) SetLabels(key string, labels map[string]string, version uint64) (Value, error) {
+	if err := ValidateLabels(labels); err != nil {
+		return Value{}, err
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err != nil {
+		return Value{}, err
+	}
+	if version != 0 && prev.Version != version {
+		return Value{}, ErrVersionMismatch
+	}
+
+	value := Value{Value: prev.Value, Session: prev.Session, Labels: labels}
+	value.Version = versionOf(&value)
+	g.stamp(&value)
+	data, err := value.MarshalMsg(nil)
+	if err != nil {
+		return Value{}, err
+	}
+
+	batch := new(leveldb.Batch)
+	batch.Put([]byte(key), data)
+	if err := g.updateIndexes(batch, key, &prev, &value); err != nil {
+		return Value{}, err
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return Value{}, err
+	}
+	return value, nil
+}
+
+// SelectKeys lists the keys that start with prefix of the entries with labels that match the selector in order.
+// The requirements that can use the label index narrow down the entries, only a selector without any of those
+// needs to look at every entry below the prefix.
+func (g *goleveldbStore) SelectKeys(prefix string, selector Selector) ([]string, error) {
+	var candidates map[string]bool
+	for _, r := range selector {
+		if !r.Indexed() {
+			continue
+		}
+		found, err := g.labelledKeys(prefix, r)
+		if err != nil {
+			return nil, err
+		}
+		if candidates == nil {
+			candidates = found
+			continue
+		}
+		for key := range candidates {
+			if !found[key] {
+				delete(candidates, key)
+			}
+		}
+	}
+
+	var keys []string
+	if candidates == nil {
+		err := g.ScanPrefix(prefix, func(kv KeyValue) bool {
+			if selector.Matches(kv.Value.Labels) {
+				keys = append(keys, kv.Key)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		for key := range candidates {
+			value, err := g.Get(key)
+			if err == ErrNotFound {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			if selector.Matches(value.Labels) {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+	}
+	return keys, nil
+}
+
+// labelledKeys finds the keys that start with prefix of the entries that meet a requirement in the label index
+func (g *goleveldbStore) labelledKeys(prefix string, r Requirement) (map[string]bool, error) {
+	var prefixes [][]byte
+	if r.Operator == SelectExists {
+		prefixes = append(prefixes, goleveldbLabelPrefix(r.Name))
+	} else {
+		for _, value := range r.Values {
+			prefixes = append(prefixes, goleveldbLabelValuePrefix(r.Name, value))
+		}
+	}
+
+	found := make(map[string]bool)
+	for _, p := range prefixes {
+		iter := g.DB.NewIterator(util.BytesPrefix(p), nil)
+		for iter.Next() {
+			// for the exists requirement the label value is still in front of the key
+			key := string(iter.Key()[len(p):])
+			if r.Operator == SelectExists {
+				key = key[strings.IndexByte(key, 0)+1:]
+			}
+			if strings.HasPrefix(key, prefix) {
+				found[key] = true
+			}
+		}
+		iter.Release()
+		if err := iter.Error(); err != nil {
+			return nil, goleveldbRewriteError(err)
+		}
+	}
+	return found, nil
+}
@@ -0,0 +1,135 @@
+package persist
+
+import (
+	"fmt"
+	"time"
+)
+
+// goleveldbLocksPrefix starts the keys of the locks
+const goleveldbLocksPrefix = goleveldbInternalPrefix + "locks/"
+
+func goleveldbLockKey(name string) []byte {
+	return []byte(goleveldbLocksPrefix + name)
+}
+
+func goleveldbRewriteLockError(value []byte, err error) (Lock, error) {
+	if err != nil {
+		return Lock{}, goleveldbRewriteError(err)
+	}
+	var result Lock
+	if _, e := result.UnmarshalMsg(value); e != nil {
+		return Lock{}, fmt.Errorf("msgp unmarshal failed: %v", e)
+	}
+	return result, nil
+}
+
+func (l *Lock) held(now int64) bool {
+	return l.Holder != "" && l.ExpiresAt > now
+}
+
+func (g *goleveldbStore) putLock(lock Lock) error {
+	data, err := lock.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return goleveldbRewriteError(g.DB.Put(goleveldbLockKey(lock.Name), data, goleveldbSyncWrite))
+}
+
+// AcquireLock gives the lock to the holder for the ttl. When the holder already has the lock
+// this renews it, otherwise the lock gets a new fencing token.
+// The lock record stays around after a release, so the tokens keep increasing.
+// When someone else holds the lock this returns the lock together with ErrLockHeld, so a waiting
+// acquire knows when the lock expires.
+func (g *goleveldbStore) AcquireLock(name, holder string, ttl time.Duration) (Lock, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	lock, err := goleveldbRewriteLockError(g.DB.Get(goleveldbLockKey(name), nil))
+	if err != nil && err != ErrNotFound {
+		return Lock{}, err
+	}
+
+	now := time.Now().UTC().UnixNano()
+	if lock.held(now) && lock.Holder != holder {
+		return lock, ErrLockHeld
+	}
+	if !lock.held(now) {
+		lock.Token++
+	}
+	lock.Name = name
+	lock.Holder = holder
+	lock.TTL = int64(ttl)
+	lock.ExpiresAt = now + int64(ttl)
+
+	if err := g.putLock(lock); err != nil {
+		return Lock{}, err
+	}
+	return lock, nil
+}
+
+// GetLock gets the lock when it is held
+func (g *goleveldbStore) GetLock(name string) (Lock, error) {
+	lock, err := goleveldbRewriteLockError(g.DB.Get(goleveldbLockKey(name), nil))
+	if err != nil {
+		return Lock{}, err
+	}
+	if !lock.held(time.Now().UTC().UnixNano()) {
+		return Lock{}, ErrNotFound
+	}
+	return lock, nil
+}
+
+// heldLock gets the lock when it is still held with the token
+func (g *goleveldbStore) heldLock(name string, token uint64) (Lock, error) {
+	lock, err := goleveldbRewriteLockError(g.DB.Get(goleveldbLockKey(name), nil))
+	if err == ErrNotFound {
+		return Lock{}, ErrLockLost
+	}
+	if err != nil {
+		return Lock{}, err
+	}
+	if lock.Token != token || !lock.held(time.Now().UTC().UnixNano()) {
+		return Lock{}, ErrLockLost
+	}
+	return lock, nil
+}
+
+// RenewLock holds the lock for another ttl, this fails when the lock changed hands or expired
+func (g *goleveldbStore) RenewLock(name string, token uint64) (Lock, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	lock, err := g.heldLock(name, token)
+	if err != nil {
+		return Lock{}, err
+	}
+	lock.ExpiresAt = time.Now().UTC().UnixNano() + lock.TTL
+	if err := g.putLock(lock); err != nil {
+		return Lock{}, err
+	}
+	return lock, nil
+}
+
+// ReleaseLock releases the lock when it is still held with the token
+func (g *goleveldbStore) ReleaseLock(name string, token uint64) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	lock, err := g.heldLock(name, token)
+	if err != nil {
+		return err
+	}
+	lock.Holder = ""
+	lock.ExpiresAt = 0
+	if err := g.putLock(lock); err != nil {
+		return err
+	}
+	g.notify(string(goleveldbLockKey(name)))
+	return nil
+}
+
+// LockReleased returns a channel that gets closed the next time the lock is released,
+// get it before trying to acquire the lock so a release in between isn't missed
+func (g *goleveldbStore) LockReleased(name string) <-chan struct{} {
+	return g.watch(string(goleveldbLockKey(name)))
+}
@@ -0,0 +1,109 @@
+package persist
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockTokens(t *testing.T) {
+	store := newTestStore(t)
+
+	first, err := store.AcquireLock("l", "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Token != 1 || first.Holder != "a" {
+		t.Errorf("the first acquire got %+v", first)
+	}
+
+	// acquiring the lock again renews it with the same token
+	renewed, err := store.AcquireLock("l", "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if renewed.Token != first.Token || renewed.ExpiresAt < first.ExpiresAt {
+		t.Errorf("acquiring a held lock again got %+v after %+v", renewed, first)
+	}
+
+	held, err := store.AcquireLock("l", "b", time.Minute)
+	if err != ErrLockHeld {
+		t.Fatalf("acquiring a lock held by someone else got %v", err)
+	}
+	if held.Holder != "a" || held.ExpiresAt != renewed.ExpiresAt {
+		t.Errorf("a held lock came back as %+v", held)
+	}
+
+	// every new holder gets a higher token, whether the lock was released or expired
+	if err := store.ReleaseLock("l", first.Token); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetLock("l"); err != ErrNotFound {
+		t.Errorf("getting a released lock got %v", err)
+	}
+	second, err := store.AcquireLock("l", "b", 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(30 * time.Millisecond)
+	if _, err := store.GetLock("l"); err != ErrNotFound {
+		t.Errorf("getting an expired lock got %v", err)
+	}
+	third, err := store.AcquireLock("l", "b", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Token <= first.Token || third.Token <= second.Token {
+		t.Errorf("the tokens went %d, %d, %d", first.Token, second.Token, third.Token)
+	}
+
+	// the tokens of the earlier holders are fenced off
+	if _, err := store.RenewLock("l", second.Token); err != ErrLockLost {
+		t.Errorf("renewing with an expired token got %v", err)
+	}
+	if err := store.ReleaseLock("l", first.Token); err != ErrLockLost {
+		t.Errorf("releasing with a released token got %v", err)
+	}
+	if _, err := store.RenewLock("missing", 1); err != ErrLockLost {
+		t.Errorf("renewing a missing lock got %v", err)
+	}
+	lock, err := store.RenewLock("l", third.Token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lock.Token != third.Token || lock.Holder != "b" {
+		t.Errorf("the renewed lock is %+v", lock)
+	}
+}
+
+func TestLockReleased(t *testing.T) {
+	store := newTestStore(t)
+	lock, err := store.AcquireLock("l", "a", time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	released := store.LockReleased("l")
+	other := store.LockReleased("other")
+	if err := store.ReleaseLock("l", lock.Token+1); err != ErrLockLost {
+		t.Fatal(err)
+	}
+	select {
+	case <-released:
+		t.Fatal("a failed release woke up the waiters")
+	default:
+	}
+
+	if err := store.ReleaseLock("l", lock.Token); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-released:
+	default:
+		t.Error("releasing the lock didn't wake up the waiters")
+	}
+	select {
+	case <-other:
+		t.Error("releasing the lock woke up the waiters of another lock")
+	default:
+	}
+}
@@ -0,0 +1,252 @@
+package persist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tinylib/msgp/msgp"
+)
+
+const (
+	// goleveldbTombstonesPrefix starts the tombstones of the deleted entries, followed by the key of the entry
+	goleveldbTombstonesPrefix = goleveldbInternalPrefix + "tombstones/"
+	// goleveldbNodeIDKey holds the id of the store when it isn't configured
+	goleveldbNodeIDKey = goleveldbInternalPrefix + "node_id"
+	// goleveldbPeersPrefix starts the sequences of the next changes of the peers to merge, followed by the url of the peer
+	goleveldbPeersPrefix = goleveldbReplicationPrefix + "peers/"
+)
+
+func goleveldbTombstoneKey(key string) []byte {
+	return []byte(goleveldbTombstonesPrefix + key)
+}
+
+func goleveldbPeerAppliedKey(peer string) []byte {
+	return []byte(goleveldbPeersPrefix + peer)
+}
+
+func goleveldbRewriteTombstoneError(value []byte, err error) (Tombstone, error) {
+	if err != nil {
+		return Tombstone{}, goleveldbRewriteError(err)
+	}
+	var result Tombstone
+	if _, e := result.UnmarshalMsg(value); e != nil {
+		return Tombstone{}, fmt.Errorf("msgp unmarshal failed: %v", e)
+	}
+	return result, nil
+}
+
+// loadNodeID uses the configured id as the origin of the writes made here,
+// without one the store makes up an id the first time it opens and keeps it
+func (g *goleveldbStore) loadNodeID(configured string) error {
+	if configured != "" {
+		g.nodeID = configured
+		return nil
+	}
+	data, err := g.DB.Get([]byte(goleveldbNodeIDKey), nil)
+	if err == nil {
+		g.nodeID = string(data)
+		return nil
+	}
+	if err != leveldb.ErrNotFound {
+		return goleveldbRewriteError(err)
+	}
+	id, err := newUUID()
+	if err != nil {
+		return err
+	}
+	if err := g.DB.Put([]byte(goleveldbNodeIDKey), []byte(id), goleveldbSyncWrite); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	g.nodeID = id
+	return nil
+}
+
+// NodeID is the id of the store, it is the origin of the writes made here
+func (g *goleveldbStore) NodeID() string {
+	return g.nodeID
+}
+
+// PeerApplied returns the sequence of the next change of the peer at the url to merge, this is 0 when
+// nothing of the peer was merged yet
+func (g *goleveldbStore) PeerApplied(peer string) (uint64, error) {
+	data, err := g.DB.Get(goleveldbPeerAppliedKey(peer), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
+
+// MergeChanges merges the changes of a peer that takes writes too, last writer wins by the hybrid logical clock
+// time of the writes and the origin of the writes orders the ones made at the same time. The changes that lose are
+// skipped, so merging a change again or a change that came back through another peer does nothing.
+// Every change is written together with the sequence of the next change of the peer to merge,
+// it returns the number of changes that won.
+func (g *goleveldbStore) MergeChanges(peer string, changes []Change) (int, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	var merged int
+	for _, change := range changes {
+		batch := new(leveldb.Batch)
+		won, err := g.mergeChange(batch, change)
+		if err != nil {
+			return merged, err
+		}
+		if won {
+			merged++
+		}
+		batch.Put(goleveldbPeerAppliedKey(peer), goleveldbAppliedValue(change.Sequence+1))
+		if err := g.writeChanges(batch); err != nil {
+			return merged, err
+		}
+	}
+	return merged, nil
+}
+
+// MergeDump merges all the entries and tombstones in a dump of a peer, unlike Restore this keeps the entries
+// that were written later here. It returns the sequence of the next change of the peer to merge
+// and holds the write lock until it is done.
+func (g *goleveldbStore) MergeDump(peer string, r io.Reader) (uint64, error) {
+	mr := msgp.NewReader(r)
+	last, err := mr.ReadUint64()
+	if err != nil {
+		return 0, err
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	var pending int
+	batch := new(leveldb.Batch)
+	for !mr.IsNil() {
+		var change Change
+		if err := change.DecodeMsg(mr); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		won, err := g.mergeChange(batch, change)
+		if err != nil {
+			return 0, err
+		}
+		if won {
+			pending++
+		}
+		if pending < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.writeChanges(batch); err != nil {
+			return 0, err
+		}
+		pending = 0
+		batch.Reset()
+	}
+
+	if err := mr.ReadNil(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	batch.Put(goleveldbPeerAppliedKey(peer), goleveldbAppliedValue(last+1))
+	if err := g.writeChanges(batch); err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+// mergeChange adds the change to the batch when it was made after the entry or the tombstone here and reports
+// if it did, this needs to be called while holding the write lock
+func (g *goleveldbStore) mergeChange(batch *leveldb.Batch, change Change) (bool, error) {
+	at, origin := change.Revision, ""
+	switch {
+	case change.Op == ChangePut && change.Value != nil:
+		at, origin = change.Value.LastUpdated, change.Value.Origin
+	case change.Tombstone != nil:
+		at, origin = change.Tombstone.DeletedAt, change.Tombstone.Origin
+	}
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(change.Key), goleveldbNoCacheRead))
+	switch err {
+	case nil:
+		if !WrittenAfter(at, origin, prev.LastUpdated, prev.Origin) {
+			return false, nil
+		}
+	case ErrNotFound:
+		tombstone, err := goleveldbRewriteTombstoneError(g.DB.Get(goleveldbTombstoneKey(change.Key), goleveldbNoCacheRead))
+		if err != nil && err != ErrNotFound {
+			return false, err
+		}
+		if err == nil && !WrittenAfter(at, origin, tombstone.DeletedAt, tombstone.Origin) {
+			return false, nil
+		}
+	default:
+		return false, err
+	}
+	return true, g.applyChange(batch, change, &prev)
+}
+
+// pruneTombstones removes the tombstones that are older than the retention every prune interval,
+// a peer that didn't merge a delete within the retention can bring the entry back
+func (g *goleveldbStore) pruneTombstones(retention time.Duration) {
+	if retention <= 0 {
+		return
+	}
+	ticker := time.NewTicker(goleveldbChangesPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		// the tombstones that are left after a failure get removed the next time
+		_ = g.pruneTombstonesBefore(time.Now().UTC().Add(-retention).UnixNano())
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// pruneTombstonesBefore removes the tombstones of the deletes before the cutoff in bounded batches,
+// it holds the write lock so a tombstone can't get replaced by a newer one while it is removed
+func (g *goleveldbStore) pruneTombstonesBefore(cutoff int64) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbTombstonesPrefix)), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var pending int
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		tombstone, err := goleveldbRewriteTombstoneError(iter.Value(), nil)
+		if err != nil {
+			return err
+		}
+		if tombstone.DeletedAt >= cutoff {
+			continue
+		}
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		pending++
+		if pending < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		pending = 0
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
@@ -0,0 +1,167 @@
+package persist
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbMerkleBucketsPrefix starts the keys of the entries ordered by their merkle bucket, a key is the bucket
+	// in 2 bytes followed by the key of the entry. It holds the version, the last updated time and the origin of the entry.
+	goleveldbMerkleBucketsPrefix = goleveldbInternalPrefix + "merkle/buckets/"
+	// goleveldbMerkleReadyKey is there once the entries that were written before the buckets were kept are in them
+	goleveldbMerkleReadyKey = goleveldbInternalPrefix + "merkle/ready"
+)
+
+func goleveldbMerkleBucketPrefix(bucket int) []byte {
+	key := make([]byte, len(goleveldbMerkleBucketsPrefix)+2)
+	copy(key, goleveldbMerkleBucketsPrefix)
+	binary.BigEndian.PutUint16(key[len(goleveldbMerkleBucketsPrefix):], uint16(bucket))
+	return key
+}
+
+func goleveldbMerkleKey(key string) []byte {
+	return append(goleveldbMerkleBucketPrefix(MerkleBucket(key)), key...)
+}
+
+func goleveldbMerkleValue(value *Value) []byte {
+	data := make([]byte, 16, 16+len(value.Origin))
+	binary.BigEndian.PutUint64(data, value.Version)
+	binary.BigEndian.PutUint64(data[8:], uint64(value.LastUpdated))
+	return append(data, value.Origin...)
+}
+
+// updateMerkle adds the change to the bucket of the entry at key to the batch
+func updateMerkle(batch *leveldb.Batch, key string, prev, next *Value) {
+	switch {
+	case next != nil:
+		batch.Put(goleveldbMerkleKey(key), goleveldbMerkleValue(next))
+	case prev != nil:
+		batch.Delete(goleveldbMerkleKey(key))
+	}
+}
+
+// loadMerkle puts the entries that were written before the buckets were kept in their buckets
+func (g *goleveldbStore) loadMerkle() error {
+	if ok, err := g.DB.Has([]byte(goleveldbMerkleReadyKey), nil); err != nil || ok {
+		return goleveldbRewriteError(err)
+	}
+
+	iter := g.DB.NewIterator(goleveldbEntryRange(""), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return err
+		}
+		updateMerkle(batch, string(iter.Key()), nil, &value)
+		if batch.Len() < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	batch.Put([]byte(goleveldbMerkleReadyKey), nil)
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
+
+// MerkleTree builds the merkle tree over the entries, the tree is built again after the entries change
+func (g *goleveldbStore) MerkleTree() (*MerkleTree, error) {
+	g.merkleLock.Lock()
+	defer g.merkleLock.Unlock()
+
+	// the sequence moves before the change is written, so it is read together with the snapshot
+	g.writeLock.Lock()
+	sequence := g.NextChange()
+	if g.merkleTree != nil && g.merkleSequence == sequence {
+		g.writeLock.Unlock()
+		return g.merkleTree, nil
+	}
+	snap, err := g.DB.GetSnapshot()
+	g.writeLock.Unlock()
+	if err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	buckets := make([]uint64, MerkleBuckets)
+	err = scanMerkle(snap, util.BytesPrefix([]byte(goleveldbMerkleBucketsPrefix)), func(bucket int, key MerkleKey) {
+		buckets[bucket] += merkleLeaf(key.Key, key.Version)
+	})
+	if err != nil {
+		return nil, err
+	}
+	g.merkleTree, g.merkleSequence = newMerkleTree(buckets), sequence
+	return g.merkleTree, nil
+}
+
+// MerkleKeys lists the entries in the buckets sorted by key, it only reads the buckets
+func (g *goleveldbStore) MerkleKeys(buckets []int) ([]MerkleKey, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	keys := []MerkleKey{}
+	for _, bucket := range buckets {
+		if bucket < 0 || bucket >= MerkleBuckets {
+			continue
+		}
+		err := scanMerkle(snap, util.BytesPrefix(goleveldbMerkleBucketPrefix(bucket)), func(_ int, key MerkleKey) {
+			keys = append(keys, key)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Key < keys[j].Key })
+	return keys, nil
+}
+
+// scanMerkle calls fn with the entries in the buckets in the range
+func scanMerkle(snap *leveldb.Snapshot, rng *util.Range, fn func(int, MerkleKey)) error {
+	iter := snap.NewIterator(rng, goleveldbNoCacheRead)
+	defer iter.Release()
+	for iter.Next() {
+		k, v := iter.Key()[len(goleveldbMerkleBucketsPrefix):], iter.Value()
+		if len(k) < 2 || len(v) < 16 {
+			return fmt.Errorf("malformed merkle bucket entry %q", iter.Key())
+		}
+		fn(int(binary.BigEndian.Uint16(k)), MerkleKey{
+			Key:         string(k[2:]),
+			Version:     binary.BigEndian.Uint64(v),
+			LastUpdated: int64(binary.BigEndian.Uint64(v[8:])),
+			Origin:      string(v[16:]),
+		})
+	}
+	return goleveldbRewriteError(iter.Error())
+}
+
+// Merge writes the value of another store as it is, unless the entry here or its tombstone was written later.
+// The origin of the writes orders the ones made at the same time. It reports if the value was written.
+func (g *goleveldbStore) Merge(key string, value *Value) (bool, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	batch := new(leveldb.Batch)
+	won, err := g.mergeChange(batch, Change{Key: key, Version: value.Version, Op: ChangePut, Value: value})
+	if err != nil || !won {
+		return false, err
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,126 @@
+package persist
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// merkleKeys lists the keys in the buckets of the keys
+func merkleKeys(t *testing.T, store Store, keys ...string) map[string]MerkleKey {
+	var buckets []int
+	for _, key := range keys {
+		buckets = append(buckets, MerkleBucket(key))
+	}
+	listed, err := store.MerkleKeys(buckets)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := make(map[string]MerkleKey, len(listed))
+	for i, k := range listed {
+		if i > 0 && listed[i-1].Key >= k.Key {
+			t.Errorf("%s is listed after %s", k.Key, listed[i-1].Key)
+		}
+		result[k.Key] = k
+	}
+	return result
+}
+
+func TestMerkleKeys(t *testing.T) {
+	store := newTestStore(t)
+
+	var keys []string
+	for _, key := range []string{"a", "b", "c", "d", "e", "f"} {
+		if err := store.Put(key, &Value{Value: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+	// only the keys in the buckets are listed
+	listed := merkleKeys(t, store, "a", "b")
+	for _, key := range keys {
+		bucket := MerkleBucket(key)
+		_, ok := listed[key]
+		if want := bucket == MerkleBucket("a") || bucket == MerkleBucket("b"); ok != want {
+			t.Errorf("%s in bucket %d is listed: %t", key, bucket, ok)
+		}
+	}
+	a, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k := listed["a"]; k.Version != a.Version || k.LastUpdated != a.LastUpdated || k.Origin != a.Origin {
+		t.Errorf("a is listed as %+v, want %+v", k, a)
+	}
+
+	if err := store.Put("a", &Value{Value: []byte("updated"), Version: a.Version}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("b"); err != nil {
+		t.Fatal(err)
+	}
+	listed = merkleKeys(t, store, "a", "b")
+	if k := listed["a"]; k.Version != VersionOf([]byte("updated")) {
+		t.Errorf("the update of a is listed as %+v", k)
+	}
+	if _, ok := listed["b"]; ok {
+		t.Error("the deleted b is still listed")
+	}
+	if got, err := store.MerkleKeys([]int{-1, MerkleBuckets}); err != nil || len(got) != 0 {
+		t.Errorf("the buckets that don't exist list %v: %v", got, err)
+	}
+}
+
+func TestMerkleBucketsOfOldEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+	cfg := viper.New()
+	cfg.Set("store.path", path)
+	store, err := NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		if err := store.Put(key, &Value{Value: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before, err := store.MerkleTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the store was written before the entries were kept in their buckets
+	g := store.(*goleveldbStore)
+	batch := new(leveldb.Batch)
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbMerkleBucketsPrefix)), nil)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+	}
+	iter.Release()
+	batch.Delete([]byte(goleveldbMerkleReadyKey))
+	if err := g.DB.Write(batch, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	after, err := store.MerkleTree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Root() != before.Root() {
+		t.Errorf("the root is %x after opening the store again, want %x", after.Root(), before.Root())
+	}
+	if listed := merkleKeys(t, store, "a", "b", "c"); len(listed) != 3 {
+		t.Errorf("the buckets hold %v", listed)
+	}
+}
@@ -0,0 +1,268 @@
+package persist
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbQueuesPrefix starts the keys of the messages, the messages of a queue are ordered by id
+	goleveldbQueuesPrefix = goleveldbInternalPrefix + "queues/"
+	// goleveldbQueueSeqsPrefix starts the keys of the last ids handed out for the queues,
+	// these survive the queue getting empty so an id is never used twice
+	goleveldbQueueSeqsPrefix = goleveldbInternalPrefix + "queue-seqs/"
+	// goleveldbQueueVisibilityPrefix starts the keys that order the messages of a queue by the time they become
+	// visible, so finding the next visible message doesn't need to skip the messages in flight
+	goleveldbQueueVisibilityPrefix = goleveldbInternalPrefix + "queue-visibility/"
+)
+
+func goleveldbQueuePrefix(name string) []byte {
+	return []byte(goleveldbQueuesPrefix + name + "/")
+}
+
+func goleveldbMessageKey(name string, id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s/%016x", goleveldbQueuesPrefix, name, id))
+}
+
+func goleveldbVisibilityPrefix(name string) []byte {
+	return []byte(goleveldbQueueVisibilityPrefix + name + "/")
+}
+
+func goleveldbVisibilityKey(name string, visibleAt int64, id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s/%016x%016x", goleveldbQueueVisibilityPrefix, name, uint64(visibleAt), id))
+}
+
+// parseVisibilityKey gets the time the message becomes visible and the id of the message from a visibility key
+func parseVisibilityKey(key []byte) (int64, uint64, error) {
+	if len(key) < 32 {
+		return 0, 0, fmt.Errorf("malformed queue visibility key %q", key)
+	}
+	var raw [16]byte
+	if _, err := hex.Decode(raw[:], key[len(key)-32:]); err != nil {
+		return 0, 0, fmt.Errorf("malformed queue visibility key %q", key)
+	}
+	return int64(binary.BigEndian.Uint64(raw[:8])), binary.BigEndian.Uint64(raw[8:]), nil
+}
+
+func goleveldbRewriteMessageError(value []byte, err error) (QueueMessage, error) {
+	if err != nil {
+		return QueueMessage{}, goleveldbRewriteError(err)
+	}
+	var result QueueMessage
+	if _, e := result.UnmarshalMsg(value); e != nil {
+		return QueueMessage{}, fmt.Errorf("msgp unmarshal failed: %v", e)
+	}
+	return result, nil
+}
+
+// putMessage adds the message and its place in the visibility order to the batch,
+// prev is the message it replaces and nil for a new message
+func (g *goleveldbStore) putMessage(batch *leveldb.Batch, name string, prev *QueueMessage, msg QueueMessage) error {
+	data, err := msg.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	if prev != nil {
+		batch.Delete(goleveldbVisibilityKey(name, prev.VisibleAt, prev.ID))
+	}
+	batch.Put(goleveldbMessageKey(name, msg.ID), data)
+	batch.Put(goleveldbVisibilityKey(name, msg.VisibleAt, msg.ID), nil)
+	return nil
+}
+
+// Enqueue adds the body to the end of the queue
+func (g *goleveldbStore) Enqueue(name string, body []byte) (QueueMessage, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	seqKey := []byte(goleveldbQueueSeqsPrefix + name)
+	var seq uint64
+	data, err := g.DB.Get(seqKey, nil)
+	if err != nil && err != leveldb.ErrNotFound {
+		return QueueMessage{}, goleveldbRewriteError(err)
+	}
+	if len(data) == 8 {
+		seq = binary.BigEndian.Uint64(data)
+	}
+	seq++
+
+	now := time.Now().UTC().UnixNano()
+	msg := QueueMessage{
+		ID:         seq,
+		Body:       body,
+		EnqueuedAt: now,
+		VisibleAt:  now,
+	}
+
+	var next [8]byte
+	binary.BigEndian.PutUint64(next[:], seq)
+	batch := new(leveldb.Batch)
+	batch.Put(seqKey, next[:])
+	if err := g.putMessage(batch, name, nil, msg); err != nil {
+		return QueueMessage{}, err
+	}
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return QueueMessage{}, goleveldbRewriteError(err)
+	}
+	g.notify(string(goleveldbQueuePrefix(name)))
+	return msg, nil
+}
+
+// firstVisible finds the message that has been visible the longest, for the messages that were never dequeued
+// this is the order they were enqueued in. When there is none the result has the time the first in flight message
+// becomes visible again. This only looks at the first message in the visibility order.
+func (g *goleveldbStore) firstVisible(name string) (QueueMessage, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return QueueMessage{}, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	iter := snap.NewIterator(util.BytesPrefix(goleveldbVisibilityPrefix(name)), nil)
+	defer iter.Release()
+	if !iter.Next() {
+		if err := iter.Error(); err != nil {
+			return QueueMessage{}, goleveldbRewriteError(err)
+		}
+		return QueueMessage{}, ErrQueueEmpty
+	}
+
+	visibleAt, id, err := parseVisibilityKey(iter.Key())
+	if err != nil {
+		return QueueMessage{}, err
+	}
+	if visibleAt > time.Now().UTC().UnixNano() {
+		return QueueMessage{VisibleAt: visibleAt}, ErrQueueEmpty
+	}
+	return goleveldbRewriteMessageError(snap.Get(goleveldbMessageKey(name, id), nil))
+}
+
+// Dequeue takes the message that has been visible the longest and hides it for the visibility timeout,
+// the message gets a new receipt.
+// When the queue has no visible messages this returns ErrQueueEmpty together with a message that only has
+// the time the first in flight message becomes visible again, this is 0 when there are no messages.
+func (g *goleveldbStore) Dequeue(name string, visibility time.Duration) (QueueMessage, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := g.firstVisible(name)
+	if err != nil {
+		return prev, err
+	}
+
+	receipt, err := newUUID()
+	if err != nil {
+		return QueueMessage{}, err
+	}
+	msg := prev
+	msg.Receipt = receipt
+	msg.VisibleAt = time.Now().UTC().UnixNano() + int64(visibility)
+	msg.Deliveries++
+
+	batch := new(leveldb.Batch)
+	if err := g.putMessage(batch, name, &prev, msg); err != nil {
+		return QueueMessage{}, err
+	}
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return QueueMessage{}, goleveldbRewriteError(err)
+	}
+	return msg, nil
+}
+
+// Peek gets the first visible message without dequeuing it
+func (g *goleveldbStore) Peek(name string) (QueueMessage, error) {
+	msg, err := g.firstVisible(name)
+	if err != nil {
+		return QueueMessage{}, err
+	}
+	return msg, nil
+}
+
+// receivedMessage gets the message when the receipt is still the one it was last dequeued with
+func (g *goleveldbStore) receivedMessage(name string, id uint64, receipt string) (QueueMessage, error) {
+	msg, err := goleveldbRewriteMessageError(g.DB.Get(goleveldbMessageKey(name, id), nil))
+	if err != nil {
+		return QueueMessage{}, err
+	}
+	if msg.Receipt != receipt {
+		return QueueMessage{}, ErrReceiptMismatch
+	}
+	return msg, nil
+}
+
+// Ack removes the message from the queue
+func (g *goleveldbStore) Ack(name string, id uint64, receipt string) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	msg, err := g.receivedMessage(name, id, receipt)
+	if err != nil {
+		return err
+	}
+	batch := new(leveldb.Batch)
+	batch.Delete(goleveldbMessageKey(name, id))
+	batch.Delete(goleveldbVisibilityKey(name, msg.VisibleAt, id))
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
+
+// Nack puts the message back in the queue, it becomes visible again after the delay
+func (g *goleveldbStore) Nack(name string, id uint64, receipt string, delay time.Duration) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := g.receivedMessage(name, id, receipt)
+	if err != nil {
+		return err
+	}
+	msg := prev
+	msg.Receipt = ""
+	msg.VisibleAt = time.Now().UTC().UnixNano() + int64(delay)
+
+	batch := new(leveldb.Batch)
+	if err := g.putMessage(batch, name, &prev, msg); err != nil {
+		return err
+	}
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	if delay <= 0 {
+		g.notify(string(goleveldbQueuePrefix(name)))
+	}
+	return nil
+}
+
+// QueueStats counts the messages in the queue
+func (g *goleveldbStore) QueueStats(name string) (QueueStats, error) {
+	iter := g.DB.NewIterator(util.BytesPrefix(goleveldbQueuePrefix(name)), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	now := time.Now().UTC().UnixNano()
+	var stats QueueStats
+	for iter.Next() {
+		msg, err := goleveldbRewriteMessageError(iter.Value(), nil)
+		if err != nil {
+			return QueueStats{}, err
+		}
+		stats.Length++
+		if msg.VisibleAt <= now {
+			stats.Visible++
+		} else {
+			stats.InFlight++
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return QueueStats{}, goleveldbRewriteError(err)
+	}
+	return stats, nil
+}
+
+// MessageAvailable returns a channel that gets closed the next time a message is added to the queue or put back,
+// get it before trying to dequeue so a message that arrives in between isn't missed
+func (g *goleveldbStore) MessageAvailable(name string) <-chan struct{} {
+	return g.watch(string(goleveldbQueuePrefix(name)))
+}
@@ -0,0 +1,169 @@
+package persist
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// dequeue takes a message from the queue and checks it has the body
+func dequeue(t *testing.T, store Store, name, body string, visibility time.Duration) QueueMessage {
+	t.Helper()
+	msg, err := store.Dequeue(name, visibility)
+	if err != nil {
+		t.Fatalf("dequeuing %s: %v", body, err)
+	}
+	if string(msg.Body) != body {
+		t.Fatalf("dequeued %s, want %s", msg.Body, body)
+	}
+	return msg
+}
+
+func TestQueueOrder(t *testing.T) {
+	store := newTestStore(t)
+	for _, body := range []string{"a", "b", "c"} {
+		if _, err := store.Enqueue("q", []byte(body)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.Enqueue("other", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+
+	peeked, err := store.Peek("q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(peeked.Body) != "a" || peeked.Receipt != "" {
+		t.Errorf("peeked at %+v", peeked)
+	}
+	a := dequeue(t, store, "q", "a", time.Minute)
+	b := dequeue(t, store, "q", "b", time.Minute)
+	if a.ID >= b.ID || a.Deliveries != 1 || a.Receipt == "" || a.Receipt == b.Receipt {
+		t.Errorf("dequeued %+v and %+v", a, b)
+	}
+
+	// a message that is put back goes behind the messages that were visible before it
+	if err := store.Nack("q", a.ID, a.Receipt, 0); err != nil {
+		t.Fatal(err)
+	}
+	dequeue(t, store, "q", "c", time.Minute)
+	dequeue(t, store, "q", "a", time.Minute)
+
+	empty, err := store.Dequeue("q", time.Minute)
+	if err != ErrQueueEmpty {
+		t.Fatalf("dequeuing with all the messages in flight got %v", err)
+	}
+	if empty.VisibleAt < b.VisibleAt || empty.VisibleAt > time.Now().Add(time.Minute).UnixNano() {
+		t.Errorf("the first in flight message becomes visible at %d", empty.VisibleAt)
+	}
+
+	stats, err := store.QueueStats("q")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Length != 3 || stats.InFlight != 3 || stats.Visible != 0 {
+		t.Errorf("the queue has %+v", stats)
+	}
+}
+
+func TestQueueRedelivery(t *testing.T) {
+	store := newTestStore(t)
+	if _, err := store.Enqueue("q", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	first := dequeue(t, store, "q", "a", 20*time.Millisecond)
+	if _, err := store.Peek("q"); err != ErrQueueEmpty {
+		t.Errorf("peeking at a queue with the message in flight got %v", err)
+	}
+
+	// the message becomes visible again once the visibility timeout passes without an ack
+	time.Sleep(30 * time.Millisecond)
+	second := dequeue(t, store, "q", "a", time.Minute)
+	if second.ID != first.ID || second.Deliveries != 2 || second.Receipt == first.Receipt {
+		t.Errorf("the redelivery is %+v after %+v", second, first)
+	}
+	if err := store.Ack("q", first.ID, first.Receipt); err != ErrReceiptMismatch {
+		t.Errorf("acking with the receipt of the first delivery got %v", err)
+	}
+	if err := store.Nack("q", first.ID, first.Receipt, 0); err != ErrReceiptMismatch {
+		t.Errorf("nacking with the receipt of the first delivery got %v", err)
+	}
+	if err := store.Ack("q", second.ID, second.Receipt); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Ack("q", second.ID, second.Receipt); err != ErrNotFound {
+		t.Errorf("acking a removed message got %v", err)
+	}
+
+	empty, err := store.Dequeue("q", time.Minute)
+	if err != ErrQueueEmpty || empty.VisibleAt != 0 {
+		t.Errorf("dequeuing from an empty queue got %+v, %v", empty, err)
+	}
+	// acking removes the message from the visibility order too
+	iter := store.(*goleveldbStore).DB.NewIterator(util.BytesPrefix(goleveldbVisibilityPrefix("q")), nil)
+	defer iter.Release()
+	if iter.Next() {
+		t.Errorf("the empty queue still orders %q", iter.Key())
+	}
+}
+
+func TestQueueSkipsInFlightMessages(t *testing.T) {
+	store := newTestStore(t)
+	for i := 0; i < 100; i++ {
+		if _, err := store.Enqueue("q", []byte(fmt.Sprint(i))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 0; i < 99; i++ {
+		dequeue(t, store, "q", fmt.Sprint(i), time.Minute)
+	}
+
+	last := dequeue(t, store, "q", "99", 20*time.Millisecond)
+	if err := store.Nack("q", last.ID, last.Receipt, 10*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Dequeue("q", time.Minute); err != ErrQueueEmpty {
+		t.Errorf("dequeuing before the delay passed got %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	again := dequeue(t, store, "q", "99", time.Minute)
+	if again.Deliveries != 2 {
+		t.Errorf("the message was delivered %d times", again.Deliveries)
+	}
+}
+
+func TestMessageAvailable(t *testing.T) {
+	store := newTestStore(t)
+
+	available := store.MessageAvailable("q")
+	if _, err := store.Enqueue("other", []byte("x")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-available:
+		t.Fatal("a message in another queue woke up the waiters")
+	default:
+	}
+	if _, err := store.Enqueue("q", []byte("a")); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-available:
+	default:
+		t.Fatal("enqueuing didn't wake up the waiters")
+	}
+
+	msg := dequeue(t, store, "q", "a", time.Minute)
+	available = store.MessageAvailable("q")
+	if err := store.Nack("q", msg.ID, msg.Receipt, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-available:
+		t.Fatal("putting the message back with a delay woke up the waiters")
+	default:
+	}
+}
@@ -0,0 +1,269 @@
+package persist
+
+import (
+	"encoding/binary"
+	"io"
+	"sync/atomic"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tinylib/msgp/msgp"
+)
+
+const (
+	// goleveldbReplicationPrefix starts the keys that keep the state of a follower
+	goleveldbReplicationPrefix = goleveldbInternalPrefix + "replication/"
+	// goleveldbReplicationAppliedKey holds the sequence of the next change of the primary to apply
+	goleveldbReplicationAppliedKey = goleveldbReplicationPrefix + "applied"
+)
+
+func goleveldbAppliedValue(sequence uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], sequence)
+	return b[:]
+}
+
+// Dump writes the sequence of the last change in the changelog followed by all the entries as put changes,
+// the tombstones as delete changes and a nil in msgpack to w, the nil tells a complete dump from one that
+// got cut off. The entries are read
+// from a snapshot that has all the changes up to that sequence, so a store that restores the dump can follow
+// the changelog from the next sequence.
+func (g *goleveldbStore) Dump(w io.Writer) error {
+	g.writeLock.Lock()
+	snap, err := g.DB.GetSnapshot()
+	last := atomic.LoadUint64(&g.lastSequence)
+	g.writeLock.Unlock()
+	if err != nil {
+		return goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	mw := msgp.NewWriter(w)
+	if err := mw.WriteUint64(last); err != nil {
+		return err
+	}
+
+	iter := snap.NewIterator(goleveldbEntryRange(""), goleveldbNoCacheRead)
+	defer iter.Release()
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return err
+		}
+		change := Change{
+			Sequence: last,
+			Revision: value.LastUpdated,
+			Key:      string(iter.Key()),
+			Version:  value.Version,
+			Op:       ChangePut,
+			Value:    &value,
+		}
+		if err := change.EncodeMsg(mw); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+
+	tombs := snap.NewIterator(util.BytesPrefix([]byte(goleveldbTombstonesPrefix)), goleveldbNoCacheRead)
+	defer tombs.Release()
+	for tombs.Next() {
+		tombstone, err := goleveldbRewriteTombstoneError(tombs.Value(), nil)
+		if err != nil {
+			return err
+		}
+		change := Change{
+			Sequence:  last,
+			Revision:  tombstone.DeletedAt,
+			Key:       string(tombs.Key()[len(goleveldbTombstonesPrefix):]),
+			Op:        ChangeDelete,
+			Tombstone: &tombstone,
+		}
+		if err := change.EncodeMsg(mw); err != nil {
+			return err
+		}
+	}
+	if err := tombs.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	if err := mw.WriteNil(); err != nil {
+		return err
+	}
+	return mw.Flush()
+}
+
+// Restore replaces all the entries with the ones in a dump and returns the sequence of the next change
+// of the primary to apply. It holds the write lock until it is done.
+func (g *goleveldbStore) Restore(r io.Reader) (uint64, error) {
+	mr := msgp.NewReader(r)
+	last, err := mr.ReadUint64()
+	if err != nil {
+		return 0, err
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if _, err := g.deleteEntries(); err != nil {
+		return 0, err
+	}
+
+	var pending int
+	batch := new(leveldb.Batch)
+	for !mr.IsNil() {
+		var change Change
+		if err := change.DecodeMsg(mr); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+		if err := g.applyChange(batch, change, &Value{}); err != nil {
+			return 0, err
+		}
+		pending++
+		if pending < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.writeChanges(batch); err != nil {
+			return 0, err
+		}
+		pending = 0
+		batch.Reset()
+	}
+
+	if err := mr.ReadNil(); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+
+	batch.Put([]byte(goleveldbReplicationAppliedKey), goleveldbAppliedValue(last+1))
+	if err := g.writeChanges(batch); err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+// deleteEntries removes all the entries in bounded batches, this needs to be called while holding the write lock
+func (g *goleveldbStore) deleteEntries() (int, error) {
+	iter := g.DB.NewIterator(goleveldbEntryRange(""), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var deleted, pending int
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		prev, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return deleted, err
+		}
+		if err := g.updateIndexes(batch, string(iter.Key()), &prev, nil); err != nil {
+			return deleted, err
+		}
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		pending++
+		if pending < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.writeChanges(batch); err != nil {
+			return deleted, err
+		}
+		deleted += pending
+		pending = 0
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return deleted, goleveldbRewriteError(err)
+	}
+	if err := g.writeChanges(batch); err != nil {
+		return deleted, err
+	}
+	return deleted + pending, nil
+}
+
+// Apply writes the changes of a primary as they are, keeping their versions and times.
+// Every change is written together with the sequence of the next change to apply.
+func (g *goleveldbStore) Apply(changes []Change) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	for _, change := range changes {
+		prev, err := goleveldbRewriteValueError(g.DB.Get([]byte(change.Key), goleveldbNoCacheRead))
+		if err != nil && err != ErrNotFound {
+			return err
+		}
+		batch := new(leveldb.Batch)
+		if err := g.applyChange(batch, change, &prev); err != nil {
+			return err
+		}
+		batch.Put([]byte(goleveldbReplicationAppliedKey), goleveldbAppliedValue(change.Sequence+1))
+		if err := g.writeChanges(batch); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Replace writes the value of another store as it is, keeping its version and times, a nil value deletes the entry
+func (g *goleveldbStore) Replace(key string, value *Value) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	prev, err := goleveldbRewriteValueError(g.DB.Get(UnsafeStringToBytes(key), goleveldbNoCacheRead))
+	if err != nil && err != ErrNotFound {
+		return err
+	}
+	change := Change{Key: key, Op: ChangeDelete}
+	if value != nil {
+		change.Op, change.Version, change.Value = ChangePut, value.Version, value
+	}
+	batch := new(leveldb.Batch)
+	if err := g.applyChange(batch, change, &prev); err != nil {
+		return err
+	}
+	return g.writeChanges(batch)
+}
+
+// applyChange adds the change on top of the prev entry to the batch, a delete keeps the tombstone of the change.
+// The clock moves past the time of the change, so the writes made here next come after it.
+func (g *goleveldbStore) applyChange(batch *leveldb.Batch, change Change, prev *Value) error {
+	if change.Op == ChangeDelete || change.Value == nil {
+		if change.Tombstone != nil {
+			g.clock.observe(change.Tombstone.DeletedAt)
+		}
+		if err := g.recordChange(batch, change.Key, nil, change.Tombstone); err != nil {
+			return err
+		}
+		g.updateDerived(batch, change.Key, prev, nil)
+		batch.Delete([]byte(change.Key))
+		return nil
+	}
+	g.clock.observe(change.Value.LastUpdated)
+
+	// the sessions stay on the primary, it sends the deletes when they end
+	value := *change.Value
+	value.Session = ""
+	data, err := value.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	batch.Put([]byte(change.Key), data)
+	if err := g.updateIndexes(batch, change.Key, prev, &value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Applied returns the sequence of the next change of the primary to apply, this is 0 when nothing was restored yet
+func (g *goleveldbStore) Applied() (uint64, error) {
+	data, err := g.DB.Get([]byte(goleveldbReplicationAppliedKey), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	return binary.BigEndian.Uint64(data), nil
+}
@@ -0,0 +1,372 @@
+package persist
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/go-openapi/kvstore/filter"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbSearchPrefix starts the keys of the search index
+	goleveldbSearchPrefix = goleveldbInternalPrefix + "search/"
+	// goleveldbSearchDocsKey holds the number of entries in the search index
+	goleveldbSearchDocsKey = goleveldbSearchPrefix + "n"
+	// goleveldbSearchTermsPrefix starts the postings, a posting is the term followed by a 0 byte
+	// and the key, it holds the number of times the term occurs in the entry
+	goleveldbSearchTermsPrefix = goleveldbSearchPrefix + "t/"
+	// goleveldbMaxTermLength is the length in bytes of the longest word that gets indexed
+	goleveldbMaxTermLength = 64
+	// goleveldbSnippetContext is the number of bytes around the word that was found that make up a snippet
+	goleveldbSnippetContext = 60
+)
+
+func goleveldbSearchCount(count uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], count)
+	return b[:]
+}
+
+func goleveldbSearchTermPrefix(term string) []byte {
+	return []byte(goleveldbSearchTermsPrefix + term + "\x00")
+}
+
+func goleveldbSearchPostingKey(term, key string) []byte {
+	return []byte(goleveldbSearchTermsPrefix + term + "\x00" + key)
+}
+
+// searchText is the text the words of an entry come from. For a JSON document these are the strings
+// and numbers in it, other values are used as they are when they are valid UTF-8.
+func searchText(value []byte) string {
+	if len(value) == 0 {
+		return ""
+	}
+
+	if doc, err := filter.Decode(value); err == nil {
+		var parts []string
+		collectText(doc, &parts)
+		return strings.Join(parts, "\n")
+	}
+	if utf8.Valid(value) {
+		return string(value)
+	}
+	return ""
+}
+
+func collectText(doc interface{}, parts *[]string) {
+	switch v := doc.(type) {
+	case string:
+		*parts = append(*parts, v)
+	case json.Number:
+		*parts = append(*parts, v.String())
+	case []interface{}:
+		for _, item := range v {
+			collectText(item, parts)
+		}
+	case map[string]interface{}:
+		// the keys get sorted so the snippets don't change from one search to the next
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			collectText(v[k], parts)
+		}
+	}
+}
+
+// searchToken is a word in the text with its position
+type searchToken struct {
+	term       string
+	start, end int
+}
+
+// searchTokens splits the text in words made of letters and digits, the terms are lower case
+func searchTokens(text string) []searchToken {
+	var tokens []searchToken
+	start := -1
+	for i, r := range text {
+		inWord := unicode.IsLetter(r) || unicode.IsDigit(r)
+		switch {
+		case inWord && start < 0:
+			start = i
+		case !inWord && start >= 0:
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+func appendToken(tokens []searchToken, text string, start, end int) []searchToken {
+	term := strings.ToLower(text[start:end])
+	if len(term) > goleveldbMaxTermLength {
+		return tokens
+	}
+	return append(tokens, searchToken{term: term, start: start, end: end})
+}
+
+// searchTerms counts the occurrences of the words in a value
+func searchTerms(value []byte) map[string]int {
+	terms := make(map[string]int)
+	for _, tok := range searchTokens(searchText(value)) {
+		terms[tok.term]++
+	}
+	return terms
+}
+
+func (g *goleveldbStore) searchable(key string) bool {
+	for _, prefix := range g.searchPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateSearch adds the changes to the postings for the entry at key to the batch,
+// this needs to be called while holding the write lock
+func (g *goleveldbStore) updateSearch(batch *leveldb.Batch, key string, prev, next []byte) {
+	if !g.searchable(key) {
+		return
+	}
+
+	before, after := searchTerms(prev), searchTerms(next)
+	for term := range before {
+		if _, ok := after[term]; !ok {
+			batch.Delete(goleveldbSearchPostingKey(term, key))
+		}
+	}
+	for term, count := range after {
+		if before[term] != count {
+			batch.Put(goleveldbSearchPostingKey(term, key), goleveldbSearchCount(uint64(count)))
+		}
+	}
+
+	docs := atomic.LoadUint64(&g.searchDocs)
+	switch {
+	case len(before) == 0 && len(after) > 0:
+		docs++
+	case len(before) > 0 && len(after) == 0 && docs > 0:
+		docs--
+	default:
+		return
+	}
+	atomic.StoreUint64(&g.searchDocs, docs)
+	batch.Put([]byte(goleveldbSearchDocsKey), goleveldbSearchCount(docs))
+}
+
+func (g *goleveldbStore) loadSearch() error {
+	data, err := g.DB.Get([]byte(goleveldbSearchDocsKey), nil)
+	if err == leveldb.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return goleveldbRewriteError(err)
+	}
+	docs := binary.BigEndian.Uint64(data)
+	atomic.StoreUint64(&g.searchDocs, docs)
+	return nil
+}
+
+// Search finds the entries below the prefix that contain any of the words in the query,
+// the entries with the rarest words and the most occurrences come first
+func (g *goleveldbStore) Search(query, prefix string, limit int) ([]SearchResult, error) {
+	if len(g.searchPrefixes) == 0 {
+		return nil, ErrSearchDisabled
+	}
+
+	terms := make(map[string]bool)
+	for _, tok := range searchTokens(query) {
+		terms[tok.term] = true
+	}
+
+	docs := float64(atomic.LoadUint64(&g.searchDocs))
+	scores := make(map[string]float64)
+	for term := range terms {
+		if err := g.scoreTerm(term, prefix, docs, scores); err != nil {
+			return nil, err
+		}
+	}
+
+	result := make([]SearchResult, 0, len(scores))
+	for key, score := range scores {
+		result = append(result, SearchResult{Key: key, Score: score})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Score != result[j].Score {
+			return result[i].Score > result[j].Score
+		}
+		return result[i].Key < result[j].Key
+	})
+	if limit > 0 && len(result) > limit {
+		result = result[:limit]
+	}
+
+	for i := range result {
+		value, err := g.Get(result[i].Key)
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		result[i].Snippet = searchSnippet(searchText(value.Value), terms)
+	}
+	return result, nil
+}
+
+// scoreTerm adds the tf-idf score of the term to the entries below the prefix that contain it
+func (g *goleveldbStore) scoreTerm(term, prefix string, docs float64, scores map[string]float64) error {
+	termPrefix := goleveldbSearchTermPrefix(term)
+	iter := g.DB.NewIterator(util.BytesPrefix(termPrefix), nil)
+	defer iter.Release()
+
+	counts := make(map[string]uint64)
+	var found int
+	for iter.Next() {
+		found++
+		key := string(iter.Key()[len(termPrefix):])
+		if !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		counts[key] = binary.BigEndian.Uint64(iter.Value())
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+
+	idf := math.Log(1 + math.Max(docs, float64(found))/float64(found))
+	for key, count := range counts {
+		scores[key] += (1 + math.Log(float64(count))) * idf
+	}
+	return nil
+}
+
+// searchSnippet cuts the text around the first word that is one of the terms
+func searchSnippet(text string, terms map[string]bool) string {
+	for _, tok := range searchTokens(text) {
+		if !terms[tok.term] {
+			continue
+		}
+
+		start, end := tok.start-goleveldbSnippetContext, tok.end+goleveldbSnippetContext
+		if start < 0 {
+			start = 0
+		}
+		if end > len(text) {
+			end = len(text)
+		}
+		for start > 0 && !utf8.RuneStart(text[start]) {
+			start--
+		}
+		for end < len(text) && !utf8.RuneStart(text[end]) {
+			end++
+		}
+
+		snippet := strings.Join(strings.Fields(text[start:end]), " ")
+		if start > 0 {
+			snippet = "…" + snippet
+		}
+		if end < len(text) {
+			snippet += "…"
+		}
+		return snippet
+	}
+	return ""
+}
+
+// RebuildSearch replaces the search index with one built from the entries below the search prefixes,
+// this is needed after changing the prefixes. It holds the write lock until it is done.
+func (g *goleveldbStore) RebuildSearch() (int, error) {
+	if len(g.searchPrefixes) == 0 {
+		return 0, ErrSearchDisabled
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	if err := g.clearSearch(); err != nil {
+		return 0, err
+	}
+	atomic.StoreUint64(&g.searchDocs, 0)
+
+	// the entries below a prefix that is below another prefix would get indexed twice
+	prefixes := append([]string(nil), g.searchPrefixes...)
+	sort.Strings(prefixes)
+	var covering []string
+	for _, prefix := range prefixes {
+		if len(covering) > 0 && strings.HasPrefix(prefix, covering[len(covering)-1]) {
+			continue
+		}
+		covering = append(covering, prefix)
+	}
+
+	for _, prefix := range covering {
+		if err := g.indexSearchPrefix(prefix); err != nil {
+			return 0, err
+		}
+	}
+	return int(atomic.LoadUint64(&g.searchDocs)), nil
+}
+
+func (g *goleveldbStore) clearSearch() error {
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbSearchPrefix)), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+		if batch.Len() < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
+
+func (g *goleveldbStore) indexSearchPrefix(prefix string) error {
+	iter := g.DB.NewIterator(goleveldbEntryRange(prefix), goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var pending int
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		value, err := goleveldbRewriteValueError(iter.Value(), nil)
+		if err != nil {
+			return err
+		}
+		g.updateSearch(batch, string(iter.Key()), nil, value.Value)
+		pending++
+		if pending < goleveldbDeleteBatchSize {
+			continue
+		}
+		if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+			return goleveldbRewriteError(err)
+		}
+		pending = 0
+		batch.Reset()
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+	return goleveldbRewriteError(g.DB.Write(batch, goleveldbSyncWrite))
+}
@@ -0,0 +1,175 @@
+package persist
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// openSearchStore opens the store at the path with the entries below the prefixes in the search index
+func openSearchStore(t *testing.T, path string, prefixes ...string) Store {
+	cfg := viper.New()
+	cfg.Set("store.path", path)
+	cfg.Set("store.search_prefixes", prefixes)
+	store, err := NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+// searchKeys returns the keys the query finds in the order of their scores
+func searchKeys(t *testing.T, store Store, query, prefix string) []string {
+	results, err := store.Search(query, prefix, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(results))
+	for _, r := range results {
+		keys = append(keys, r.Key)
+	}
+	return keys
+}
+
+func TestSearch(t *testing.T) {
+	store := openSearchStore(t, filepath.Join(t.TempDir(), "store"), "docs/")
+	defer store.Close()
+
+	for key, value := range map[string]string{
+		"docs/a":   "the quick fox",
+		"docs/b":   "the Lazy dog",
+		"docs/c":   "the fox, the FOX",
+		"docs/d":   `{"city":"Paris","zip":75001,"tags":["lazy"]}`,
+		"others/e": "a fox that isn't indexed",
+	} {
+		if err := store.Put(key, &Value{Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		query, prefix string
+		want          []string
+	}{
+		{"fox", "", []string{"docs/c", "docs/a"}},
+		// the rarer word counts for more, then the number of times a word occurs
+		{"quick the", "", []string{"docs/a", "docs/c", "docs/b"}},
+		{"LAZY", "", []string{"docs/b", "docs/d"}},
+		{"paris 75001", "", []string{"docs/d"}},
+		{"fox", "docs/a", []string{"docs/a"}},
+		{"wolf", "", []string{}},
+	}
+	for _, tt := range tests {
+		got := searchKeys(t, store, tt.query, tt.prefix)
+		if strings.Join(got, " ") != strings.Join(tt.want, " ") {
+			t.Errorf("%q below %q finds %v, want %v", tt.query, tt.prefix, got, tt.want)
+		}
+	}
+
+	// the index follows the updates and the deletes
+	a, err := store.Get("docs/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("docs/a", &Value{Value: []byte("a slow wolf"), Version: a.Version}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Delete("docs/c"); err != nil {
+		t.Fatal(err)
+	}
+	if got := searchKeys(t, store, "fox", ""); len(got) != 0 {
+		t.Errorf("fox finds %v after the updates", got)
+	}
+	if got := searchKeys(t, store, "wolf", ""); strings.Join(got, " ") != "docs/a" {
+		t.Errorf("wolf finds %v after the updates", got)
+	}
+}
+
+func TestSearchSnippet(t *testing.T) {
+	store := openSearchStore(t, filepath.Join(t.TempDir(), "store"), "docs/")
+	defer store.Close()
+
+	long := strings.Repeat("lorem ipsum ", 20) + "needle " + strings.Repeat("dolor sit ", 20)
+	for key, value := range map[string]string{
+		"docs/short": "a needle in\n  a haystack",
+		"docs/long":  long,
+	} {
+		if err := store.Put(key, &Value{Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	results, err := store.Search("needle", "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snippets := make(map[string]string)
+	for _, r := range results {
+		snippets[r.Key] = r.Snippet
+	}
+	if got := snippets["docs/short"]; got != "a needle in a haystack" {
+		t.Errorf("the short snippet is %q", got)
+	}
+	got := snippets["docs/long"]
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") || !strings.Contains(got, "needle") || len(got) >= len(long) {
+		t.Errorf("the long snippet is %q", got)
+	}
+
+	if results, err := store.Search("needle", "", 1); err != nil || len(results) != 1 {
+		t.Errorf("the limited search got %v, %v", results, err)
+	}
+}
+
+func TestRebuildSearch(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+	store := openSearchStore(t, path)
+	if _, err := store.Search("fox", "", 0); err != ErrSearchDisabled {
+		t.Errorf("searching without prefixes got %v", err)
+	}
+	if _, err := store.RebuildSearch(); err != ErrSearchDisabled {
+		t.Errorf("rebuilding without prefixes got %v", err)
+	}
+	for key, value := range map[string]string{
+		"docs/a":     "the quick fox",
+		"docs/sub/b": "a fox below a nested prefix",
+		"others/c":   "a fox somewhere else",
+	} {
+		if err := store.Put(key, &Value{Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the entries written before the prefixes were configured are found after a rebuild,
+	// the entries below both prefixes count once
+	store = openSearchStore(t, path, "docs/", "docs/sub/")
+	defer store.Close()
+	if got := searchKeys(t, store, "fox", ""); len(got) != 0 {
+		t.Errorf("fox finds %v before the rebuild", got)
+	}
+	indexed, err := store.RebuildSearch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if indexed != 2 {
+		t.Errorf("the rebuild indexed %d entries, want 2", indexed)
+	}
+	if got := searchKeys(t, store, "fox", ""); strings.Join(got, " ") != "docs/a docs/sub/b" {
+		t.Errorf("fox finds %v after the rebuild", got)
+	}
+
+	// rebuilding again starts over rather than adding to the index
+	if indexed, err = store.RebuildSearch(); err != nil || indexed != 2 {
+		t.Errorf("the second rebuild indexed %d entries: %v", indexed, err)
+	}
+	if err := store.Delete("docs/a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := searchKeys(t, store, "quick", ""); len(got) != 0 {
+		t.Errorf("quick finds %v after the delete", got)
+	}
+}
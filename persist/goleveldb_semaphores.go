@@ -0,0 +1,152 @@
+package persist
+
+import (
+	"fmt"
+	"time"
+)
+
+// goleveldbSemaphoresPrefix starts the keys of the semaphores
+const goleveldbSemaphoresPrefix = goleveldbInternalPrefix + "semaphores/"
+
+func goleveldbSemaphoreKey(name string) []byte {
+	return []byte(goleveldbSemaphoresPrefix + name)
+}
+
+func goleveldbRewriteSemaphoreError(value []byte, err error) (Semaphore, error) {
+	if err != nil {
+		return Semaphore{}, goleveldbRewriteError(err)
+	}
+	var result Semaphore
+	if _, e := result.UnmarshalMsg(value); e != nil {
+		return Semaphore{}, fmt.Errorf("msgp unmarshal failed: %v", e)
+	}
+	return result, nil
+}
+
+// liveSemaphore gets the semaphore without the slots whose lease expired
+func (g *goleveldbStore) liveSemaphore(name string) (Semaphore, error) {
+	sem, err := goleveldbRewriteSemaphoreError(g.DB.Get(goleveldbSemaphoreKey(name), nil))
+	if err != nil {
+		return Semaphore{}, err
+	}
+
+	now := time.Now().UTC().UnixNano()
+	holders := sem.Holders[:0]
+	for _, slot := range sem.Holders {
+		if slot.ExpiresAt > now {
+			holders = append(holders, slot)
+		}
+	}
+	sem.Holders = holders
+	return sem, nil
+}
+
+func (g *goleveldbStore) putSemaphore(sem Semaphore) error {
+	data, err := sem.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	return goleveldbRewriteError(g.DB.Put(goleveldbSemaphoreKey(sem.Name), data, goleveldbSyncWrite))
+}
+
+// AcquireSlot gives the holder a slot of the semaphore for the ttl, when the holder already has a slot
+// this renews it. The semaphore gets the limit when it is created or when none of its slots are held,
+// while slots are held a different limit fails with ErrSemaphoreLimit.
+// When all the slots are taken this returns the semaphore together with ErrSemaphoreFull,
+// so the caller knows when the first lease expires.
+func (g *goleveldbStore) AcquireSlot(name, holder string, limit int64, ttl time.Duration) (Semaphore, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	sem, err := g.liveSemaphore(name)
+	if err != nil && err != ErrNotFound {
+		return Semaphore{}, err
+	}
+	if len(sem.Holders) > 0 && sem.Limit != limit {
+		return sem, ErrSemaphoreLimit
+	}
+	sem.Name = name
+	sem.Limit = limit
+
+	expiresAt := time.Now().UTC().UnixNano() + int64(ttl)
+	found := false
+	for i := range sem.Holders {
+		if sem.Holders[i].Holder == holder {
+			sem.Holders[i].TTL = int64(ttl)
+			sem.Holders[i].ExpiresAt = expiresAt
+			found = true
+			break
+		}
+	}
+	if !found {
+		if int64(len(sem.Holders)) >= limit {
+			return sem, ErrSemaphoreFull
+		}
+		sem.Holders = append(sem.Holders, Slot{Holder: holder, TTL: int64(ttl), ExpiresAt: expiresAt})
+	}
+
+	if err := g.putSemaphore(sem); err != nil {
+		return Semaphore{}, err
+	}
+	return sem, nil
+}
+
+// GetSemaphore gets the semaphore with the holders whose lease didn't expire
+func (g *goleveldbStore) GetSemaphore(name string) (Semaphore, error) {
+	return g.liveSemaphore(name)
+}
+
+// RenewSlot holds the slot for another ttl, this fails when the lease on the slot expired
+func (g *goleveldbStore) RenewSlot(name, holder string) (Semaphore, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	sem, err := g.liveSemaphore(name)
+	if err == ErrNotFound {
+		return Semaphore{}, ErrSlotLost
+	}
+	if err != nil {
+		return Semaphore{}, err
+	}
+	for i := range sem.Holders {
+		if sem.Holders[i].Holder == holder {
+			sem.Holders[i].ExpiresAt = time.Now().UTC().UnixNano() + sem.Holders[i].TTL
+			if err := g.putSemaphore(sem); err != nil {
+				return Semaphore{}, err
+			}
+			return sem, nil
+		}
+	}
+	return Semaphore{}, ErrSlotLost
+}
+
+// ReleaseSlot frees the slot of the holder and wakes up the ones waiting for a slot
+func (g *goleveldbStore) ReleaseSlot(name, holder string) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	sem, err := g.liveSemaphore(name)
+	if err == ErrNotFound {
+		return ErrSlotLost
+	}
+	if err != nil {
+		return err
+	}
+	for i := range sem.Holders {
+		if sem.Holders[i].Holder == holder {
+			sem.Holders = append(sem.Holders[:i], sem.Holders[i+1:]...)
+			if err := g.putSemaphore(sem); err != nil {
+				return err
+			}
+			g.notify(string(goleveldbSemaphoreKey(name)))
+			return nil
+		}
+	}
+	return ErrSlotLost
+}
+
+// SlotReleased returns a channel that gets closed the next time a slot of the semaphore is released,
+// get it before trying to acquire a slot so a release in between isn't missed
+func (g *goleveldbStore) SlotReleased(name string) <-chan struct{} {
+	return g.watch(string(goleveldbSemaphoreKey(name)))
+}
@@ -0,0 +1,109 @@
+package persist
+
+import (
+	"testing"
+	"time"
+)
+
+// holdersOf lists the holders of the semaphore that still hold a slot
+func holdersOf(t *testing.T, store Store, name string) []string {
+	sem, err := store.GetSemaphore(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	holders := make([]string, 0, len(sem.Holders))
+	for _, slot := range sem.Holders {
+		holders = append(holders, slot.Holder)
+	}
+	return holders
+}
+
+func TestSemaphoreSlots(t *testing.T) {
+	store := newTestStore(t)
+
+	if _, err := store.AcquireSlot("s", "a", 2, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.AcquireSlot("s", "b", 2, 20*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	full, err := store.AcquireSlot("s", "c", 2, time.Minute)
+	if err != ErrSemaphoreFull {
+		t.Fatalf("acquiring a slot of a full semaphore got %v", err)
+	}
+	if len(full.Holders) != 2 {
+		t.Errorf("the full semaphore came back with %+v", full.Holders)
+	}
+	if _, err := store.AcquireSlot("s", "c", 3, time.Minute); err != ErrSemaphoreLimit {
+		t.Errorf("acquiring with another limit got %v", err)
+	}
+	// a holder that already has a slot renews it
+	if _, err := store.AcquireSlot("s", "a", 2, time.Minute); err != nil {
+		t.Errorf("acquiring a held slot again got %v", err)
+	}
+
+	// the expired lease frees its slot
+	time.Sleep(30 * time.Millisecond)
+	if got := holdersOf(t, store, "s"); len(got) != 1 || got[0] != "a" {
+		t.Errorf("the semaphore is held by %v after the lease expired", got)
+	}
+	if _, err := store.RenewSlot("s", "b"); err != ErrSlotLost {
+		t.Errorf("renewing an expired slot got %v", err)
+	}
+	if _, err := store.AcquireSlot("s", "c", 2, time.Minute); err != nil {
+		t.Errorf("acquiring the freed slot got %v", err)
+	}
+
+	sem, err := store.RenewSlot("s", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sem.Holders) != 2 || sem.Limit != 2 {
+		t.Errorf("the renewed semaphore is %+v", sem)
+	}
+	if err := store.ReleaseSlot("s", "b"); err != ErrSlotLost {
+		t.Errorf("releasing an expired slot got %v", err)
+	}
+	if err := store.ReleaseSlot("missing", "a"); err != ErrSlotLost {
+		t.Errorf("releasing a slot of a missing semaphore got %v", err)
+	}
+
+	// once nobody holds a slot the semaphore takes a new limit
+	for _, holder := range []string{"a", "c"} {
+		if err := store.ReleaseSlot("s", holder); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sem, err = store.AcquireSlot("s", "d", 5, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sem.Limit != 5 {
+		t.Errorf("the semaphore has limit %d", sem.Limit)
+	}
+}
+
+func TestSlotReleased(t *testing.T) {
+	store := newTestStore(t)
+	if _, err := store.AcquireSlot("s", "a", 1, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	released := store.SlotReleased("s")
+	if err := store.ReleaseSlot("s", "b"); err != ErrSlotLost {
+		t.Fatal(err)
+	}
+	select {
+	case <-released:
+		t.Fatal("a failed release woke up the waiters")
+	default:
+	}
+	if err := store.ReleaseSlot("s", "a"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-released:
+	default:
+		t.Error("releasing the slot didn't wake up the waiters")
+	}
+}
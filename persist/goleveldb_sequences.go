@@ -0,0 +1,73 @@
+package persist
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/syndtr/goleveldb/leveldb"
+)
+
+// goleveldbSequencesPrefix starts the keys of the sequences, these hold the last id that was reserved
+const goleveldbSequencesPrefix = goleveldbInternalPrefix + "sequences/"
+
+// goleveldbDefaultSequenceBlockSize is the number of ids reserved at once when the config doesn't say
+const goleveldbDefaultSequenceBlockSize = 1000
+
+func goleveldbSequenceKey(name string) []byte {
+	return []byte(goleveldbSequencesPrefix + name)
+}
+
+// goleveldbSequence is the block of ids that is reserved for a sequence, next up to and including limit
+// can be handed out without writing to the database
+type goleveldbSequence struct {
+	next  int64
+	limit int64
+}
+
+// NextIDs hands out count ids of the sequence and returns the first one. The ids are reserved a block at a time,
+// after a restart the ids that were reserved but not handed out are skipped so an id is never handed out twice.
+func (g *goleveldbStore) NextIDs(name string, count int64) (int64, error) {
+	g.sequenceLock.Lock()
+	defer g.sequenceLock.Unlock()
+
+	seq, ok := g.sequences[name]
+	if !ok {
+		var reserved int64
+		data, err := g.DB.Get(goleveldbSequenceKey(name), nil)
+		if err != nil && err != leveldb.ErrNotFound {
+			return 0, goleveldbRewriteError(err)
+		}
+		if len(data) == 8 {
+			reserved = int64(binary.BigEndian.Uint64(data))
+		}
+		seq = &goleveldbSequence{next: reserved + 1, limit: reserved}
+		g.sequences[name] = seq
+	}
+
+	// next wraps around once the last possible id was handed out
+	if seq.next <= 0 || seq.next > math.MaxInt64-count+1 {
+		return 0, ErrOverflow
+	}
+	last := seq.next + count - 1
+	if last > seq.limit {
+		blockSize := g.sequenceBlockSize
+		if blockSize <= 0 {
+			blockSize = goleveldbDefaultSequenceBlockSize
+		}
+		limit := last + blockSize
+		if limit < last {
+			limit = math.MaxInt64
+		}
+
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(limit))
+		if err := g.DB.Put(goleveldbSequenceKey(name), b[:], goleveldbSyncWrite); err != nil {
+			return 0, goleveldbRewriteError(err)
+		}
+		seq.limit = limit
+	}
+
+	first := seq.next
+	seq.next = last + 1
+	return first, nil
+}
@@ -0,0 +1,80 @@
+package persist
+
+import (
+	"math"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// openSequenceStore opens the store at the path with the block size for the sequences
+func openSequenceStore(t *testing.T, path string, blockSize int64) Store {
+	cfg := viper.New()
+	cfg.Set("store.path", path)
+	cfg.Set("store.sequence_block_size", blockSize)
+	store, err := NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return store
+}
+
+func TestSequenceBlocks(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "store")
+	store := openSequenceStore(t, path, 10)
+
+	tests := []struct {
+		name         string
+		count, first int64
+	}{
+		{"s", 1, 1},
+		{"s", 3, 2},
+		{"other", 2, 1},
+		// this needs more ids than are left in the block
+		{"s", 20, 5},
+		{"s", 1, 25},
+	}
+	for _, tt := range tests {
+		first, err := store.NextIDs(tt.name, tt.count)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first != tt.first {
+			t.Errorf("%d ids of %s start at %d, want %d", tt.count, tt.name, first, tt.first)
+		}
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// after a restart the rest of the reserved block is skipped, s reserved up to 24+10 and other up to 2+10
+	store = openSequenceStore(t, path, 10)
+	defer store.Close()
+	for name, want := range map[string]int64{"s": 35, "other": 13, "new": 1} {
+		first, err := store.NextIDs(name, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first != want {
+			t.Errorf("after the restart %s starts at %d, want %d", name, first, want)
+		}
+	}
+}
+
+func TestSequenceOverflow(t *testing.T) {
+	store := newTestStore(t)
+	g := store.(*goleveldbStore)
+	g.sequences["s"] = &goleveldbSequence{next: math.MaxInt64 - 1, limit: math.MaxInt64 - 1}
+
+	first, err := store.NextIDs("s", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != math.MaxInt64-1 {
+		t.Errorf("the last ids start at %d", first)
+	}
+	if _, err := store.NextIDs("s", 1); err != ErrOverflow {
+		t.Errorf("handing out an id past the end got %v", err)
+	}
+}
@@ -0,0 +1,253 @@
+package persist
+
+import (
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// goleveldbSessionsPrefix starts the keys of the sessions
+	goleveldbSessionsPrefix = goleveldbInternalPrefix + "sessions/"
+	// goleveldbSessionEntriesPrefix starts the keys that track which entries are bound to a session
+	goleveldbSessionEntriesPrefix = goleveldbInternalPrefix + "session-entries/"
+)
+
+func goleveldbSessionKey(id string) []byte {
+	return []byte(goleveldbSessionsPrefix + id)
+}
+
+func goleveldbSessionEntryKey(id, key string) []byte {
+	return []byte(goleveldbSessionEntriesPrefix + id + "/" + key)
+}
+
+// updateSessionEntry moves the key that tracks the entry to the session the entry is bound to now
+func updateSessionEntry(batch *leveldb.Batch, key, prev, next string) {
+	if prev == next {
+		return
+	}
+	if prev != "" {
+		batch.Delete(goleveldbSessionEntryKey(prev, key))
+	}
+	if next != "" {
+		batch.Put(goleveldbSessionEntryKey(next, key), nil)
+	}
+}
+
+func newUUID() (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	// random uuid, version 4 variant 1
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+func goleveldbRewriteSessionError(value []byte, err error) (Session, error) {
+	if err != nil {
+		return Session{}, goleveldbRewriteError(err)
+	}
+	var result Session
+	if _, e := result.UnmarshalMsg(value); e != nil {
+		return Session{}, fmt.Errorf("msgp unmarshal failed: %v", e)
+	}
+	return result, nil
+}
+
+func (g *goleveldbStore) putSession(batch *leveldb.Batch, session Session) error {
+	data, err := session.MarshalMsg(nil)
+	if err != nil {
+		return err
+	}
+	batch.Put(goleveldbSessionKey(session.ID), data)
+	return nil
+}
+
+// liveSession gets the session with the id, a session that expired but wasn't cleaned up yet is not found
+func (g *goleveldbStore) liveSession(id string) (Session, error) {
+	session, err := goleveldbRewriteSessionError(g.DB.Get(goleveldbSessionKey(id), nil))
+	if err != nil {
+		return Session{}, err
+	}
+	if session.ExpiresAt <= time.Now().UTC().UnixNano() {
+		return Session{}, ErrNotFound
+	}
+	return session, nil
+}
+
+// CreateSession stores a new session with a generated id, the session expires after its TTL
+func (g *goleveldbStore) CreateSession(session Session) (Session, error) {
+	switch session.Behavior {
+	case "":
+		session.Behavior = SessionDelete
+	case SessionDelete, SessionRelease:
+	default:
+		return Session{}, fmt.Errorf("unknown session behavior %q", session.Behavior)
+	}
+
+	id, err := newUUID()
+	if err != nil {
+		return Session{}, err
+	}
+	session.ID = id
+	session.ExpiresAt = time.Now().UTC().UnixNano() + session.TTL
+
+	batch := new(leveldb.Batch)
+	if err := g.putSession(batch, session); err != nil {
+		return Session{}, err
+	}
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return Session{}, goleveldbRewriteError(err)
+	}
+	return session, nil
+}
+
+// GetSession gets the session with the id when it is still alive
+func (g *goleveldbStore) GetSession(id string) (Session, error) {
+	return g.liveSession(id)
+}
+
+// ListSessions lists the sessions that are still alive
+func (g *goleveldbStore) ListSessions() ([]Session, error) {
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbSessionsPrefix)), nil)
+	defer iter.Release()
+
+	now := time.Now().UTC().UnixNano()
+	var result []Session
+	for iter.Next() {
+		session, err := goleveldbRewriteSessionError(iter.Value(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if session.ExpiresAt > now {
+			result = append(result, session)
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	return result, nil
+}
+
+// RenewSession extends the life of the session by its TTL
+func (g *goleveldbStore) RenewSession(id string) (Session, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	session, err := g.liveSession(id)
+	if err != nil {
+		return Session{}, err
+	}
+	session.ExpiresAt = time.Now().UTC().UnixNano() + session.TTL
+
+	batch := new(leveldb.Batch)
+	if err := g.putSession(batch, session); err != nil {
+		return Session{}, err
+	}
+	if err := g.DB.Write(batch, goleveldbSyncWrite); err != nil {
+		return Session{}, goleveldbRewriteError(err)
+	}
+	return session, nil
+}
+
+// DestroySession ends the session, the entries that are still bound to it get deleted or released
+// depending on the behavior of the session. This happens in a single write.
+func (g *goleveldbStore) DestroySession(id string) error {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	session, err := goleveldbRewriteSessionError(g.DB.Get(goleveldbSessionKey(id), nil))
+	if err != nil {
+		return err
+	}
+
+	prefix := string(goleveldbSessionEntryKey(id, ""))
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(prefix)), nil)
+	defer iter.Release()
+
+	batch := new(leveldb.Batch)
+	for iter.Next() {
+		batch.Delete(append([]byte(nil), iter.Key()...))
+
+		key := strings.TrimPrefix(string(iter.Key()), prefix)
+		value, err := goleveldbRewriteValueError(g.DB.Get([]byte(key), nil))
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// the entry might have been deleted and written again without this session
+		if value.Session != session.ID {
+			continue
+		}
+
+		if session.Behavior == SessionRelease {
+			released := value
+			released.Session = ""
+			g.stamp(&released)
+			data, err := released.MarshalMsg(nil)
+			if err != nil {
+				return err
+			}
+			batch.Put([]byte(key), data)
+			if err := g.updateIndexes(batch, key, &value, &released); err != nil {
+				return err
+			}
+			continue
+		}
+		batch.Delete([]byte(key))
+		if err := g.updateIndexes(batch, key, &value, nil); err != nil {
+			return err
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return goleveldbRewriteError(err)
+	}
+
+	batch.Delete(goleveldbSessionKey(id))
+	return g.writeChanges(batch)
+}
+
+// expireSessions periodically destroys the sessions that weren't renewed in time,
+// because the sessions are stored this also picks up the sessions that expired while the store was closed
+func (g *goleveldbStore) expireSessions(interval time.Duration) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		g.destroyExpiredSessions()
+		select {
+		case <-g.done:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (g *goleveldbStore) destroyExpiredSessions() {
+	iter := g.DB.NewIterator(util.BytesPrefix([]byte(goleveldbSessionsPrefix)), nil)
+	now := time.Now().UTC().UnixNano()
+	var expired []string
+	for iter.Next() {
+		session, err := goleveldbRewriteSessionError(iter.Value(), nil)
+		if err == nil && session.ExpiresAt <= now {
+			expired = append(expired, session.ID)
+		}
+	}
+	iter.Release()
+
+	for _, id := range expired {
+		// a failure here gets retried on the next tick
+		_ = g.DestroySession(id)
+	}
+}
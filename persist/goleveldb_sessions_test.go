@@ -0,0 +1,92 @@
+package persist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// sessionEntries lists the keys that track the entries bound to the sessions as session/key
+func sessionEntries(t *testing.T, store Store) []string {
+	iter := store.(*goleveldbStore).DB.NewIterator(util.BytesPrefix([]byte(goleveldbSessionEntriesPrefix)), nil)
+	defer iter.Release()
+
+	var result []string
+	for iter.Next() {
+		result = append(result, strings.TrimPrefix(string(iter.Key()), goleveldbSessionEntriesPrefix))
+	}
+	if err := iter.Error(); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestSessionEntries(t *testing.T) {
+	store := newTestStore(t)
+	first, err := store.CreateSession(Session{TTL: int64(time.Minute), Behavior: SessionDelete})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.CreateSession(Session{TTL: int64(time.Minute), Behavior: SessionRelease})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"deleted", "moved", "overwritten", "kept", "rebound"} {
+		if err := store.Put(key, &Value{Value: []byte(key), Session: first.ID}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Put("source", &Value{Value: []byte("source")}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := store.Delete("deleted"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Move("moved", "target", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Copy("source", "overwritten", Precondition{}); err != nil {
+		t.Fatal(err)
+	}
+	// an update without a session keeps the binding
+	kept, err := store.Get("kept")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("kept", &Value{Value: []byte("updated"), Version: kept.Version}); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{first.ID + "/kept", first.ID + "/rebound"}
+	if got := sessionEntries(t, store); !reflect.DeepEqual(got, want) {
+		t.Fatalf("the sessions track %v, want %v", got, want)
+	}
+
+	// the entries written again without the session outlive it
+	if err := store.Put("deleted", &Value{Value: []byte("again")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.DestroySession(first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("rebound", &Value{Value: []byte("rebound"), Session: second.ID}); err != nil {
+		t.Fatal(err)
+	}
+	if got := keysOf(t, store); !reflect.DeepEqual(got, []string{"deleted", "overwritten", "rebound", "source", "target"}) {
+		t.Errorf("the store has %v", got)
+	}
+	want = []string{second.ID + "/rebound"}
+	if got := sessionEntries(t, store); !reflect.DeepEqual(got, want) {
+		t.Errorf("the sessions track %v, want %v", got, want)
+	}
+
+	if err := store.DestroySession(second.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got := sessionEntries(t, store); len(got) != 0 {
+		t.Errorf("the ended sessions still track %v", got)
+	}
+}
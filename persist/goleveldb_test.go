@@ -0,0 +1,385 @@
+package persist
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func newTestStore(t *testing.T) Store {
+	cfg := viper.New()
+	cfg.Set("store.path", filepath.Join(t.TempDir(), "store"))
+	store, err := NewGoLevelDBStore(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestPutVersions(t *testing.T) {
+	store := newTestStore(t)
+
+	if err := store.Put("a", &Value{Value: []byte("one")}); err != nil {
+		t.Fatal(err)
+	}
+	first, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Version != VersionOf([]byte("one")) {
+		t.Errorf("the new entry has version %d", first.Version)
+	}
+
+	// an update gets the version of what it writes
+	if err := store.Put("a", &Value{Value: []byte("two"), Version: first.Version}); err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Version != VersionOf([]byte("two")) || string(second.Value) != "two" {
+		t.Errorf("the update has version %d and value %q", second.Version, second.Value)
+	}
+
+	// so the version the update replaced is out of date
+	err = store.Put("a", &Value{Value: []byte("three"), Version: first.Version})
+	if err != ErrVersionMismatch {
+		t.Errorf("an update with the replaced version got %v", err)
+	}
+
+	// and the labels are part of the version
+	if err := store.Put("a", &Value{Value: []byte("two"), Version: second.Version, Labels: map[string]string{"env": "prod"}}); err != nil {
+		t.Fatal(err)
+	}
+	third, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.Version == second.Version {
+		t.Error("changing the labels kept the version")
+	}
+
+	if err := store.Put("b", &Value{Value: []byte("x"), Version: 1}); err != ErrGone {
+		t.Errorf("updating an entry that doesn't exist got %v", err)
+	}
+}
+
+func TestDeleteByPrefix(t *testing.T) {
+	store := newTestStore(t)
+
+	n := goleveldbDeleteBatchSize*2 + 10
+	for i := 0; i < n; i++ {
+		if err := store.Put(fmt.Sprintf("a/%05d", i), &Value{Value: []byte("x")}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := store.Put("b", &Value{Value: []byte("kept")}); err != nil {
+		t.Fatal(err)
+	}
+
+	deleted, err := store.DeleteByPrefix("a/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != n {
+		t.Errorf("deleted %d entries, want %d", deleted, n)
+	}
+	left, err := store.FindByPrefix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 1 || left[0].Key != "b" {
+		t.Errorf("%d entries are left", len(left))
+	}
+}
+
+// keysOf lists the keys of the store
+func keysOf(t *testing.T, store Store) []string {
+	kvs, err := store.FindByPrefix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := make([]string, 0, len(kvs))
+	for _, kv := range kvs {
+		keys = append(keys, kv.Key)
+	}
+	return keys
+}
+
+func TestTransferPrefix(t *testing.T) {
+	tests := []struct {
+		name     string
+		src, dst string
+		move     bool
+		// want maps the keys of the store to the key the value came from
+		want map[string]string
+	}{
+		{"copy", "a/", "c/", false, map[string]string{"a/1": "a/1", "a/2": "a/2", "b/1": "b/1", "c/1": "a/1", "c/2": "a/2"}},
+		{"move", "a/", "c/", true, map[string]string{"b/1": "b/1", "c/1": "a/1", "c/2": "a/2"}},
+		{"copy into itself", "a/", "a/x/", false, map[string]string{"a/1": "a/1", "a/2": "a/2", "a/x/1": "a/1", "a/x/2": "a/2", "b/1": "b/1"}},
+		{"move into itself", "a/", "a/x/", true, map[string]string{"a/x/1": "a/1", "a/x/2": "a/2", "b/1": "b/1"}},
+		{"move out of itself", "a/", "", true, map[string]string{"1": "a/1", "2": "a/2", "b/1": "b/1"}},
+		{"copy onto itself", "a/", "a/", false, map[string]string{"a/1": "a/1", "a/2": "a/2", "b/1": "b/1"}},
+		{"move onto itself", "a/", "a/", true, map[string]string{"a/1": "a/1", "a/2": "a/2", "b/1": "b/1"}},
+		{"missing prefix", "z/", "a/", true, map[string]string{"a/1": "a/1", "a/2": "a/2", "b/1": "b/1"}},
+	}
+	for _, tt := range tests {
+		store := newTestStore(t)
+		for _, key := range []string{"a/1", "a/2", "b/1"} {
+			if err := store.Put(key, &Value{Value: []byte(key)}); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		transfer := store.CopyPrefix
+		if tt.move {
+			transfer = store.MovePrefix
+		}
+		if _, err := transfer(tt.src, tt.dst); err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		kvs, err := store.FindByPrefix("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]string, len(kvs))
+		for _, kv := range kvs {
+			got[kv.Key] = string(kv.Value.Value)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: the store has %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	store := newTestStore(t)
+	if err := store.Put("a", &Value{Value: []byte("1")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("b", &Value{Value: []byte("2")}); err != nil {
+		t.Fatal(err)
+	}
+	a, err := store.Get("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	zero := uint64(0)
+	tests := []struct {
+		name string
+		move bool
+		src  string
+		dst  string
+		pre  Precondition
+		want error
+	}{
+		{"missing source", false, "x", "c", Precondition{}, ErrNotFound},
+		{"stale source", false, "a", "c", Precondition{SourceVersion: a.Version + 1}, ErrVersionMismatch},
+		{"existing destination", false, "a", "b", Precondition{DestinationVersion: &zero}, ErrVersionMismatch},
+		{"missing destination", false, "a", "x", Precondition{DestinationVersion: &a.Version}, ErrGone},
+		{"onto itself", true, "a", "a", Precondition{}, nil},
+		{"copy", false, "a", "c", Precondition{SourceVersion: a.Version, DestinationVersion: &zero}, nil},
+		{"move", true, "c", "d", Precondition{}, nil},
+	}
+	for _, tt := range tests {
+		transfer := store.Copy
+		if tt.move {
+			transfer = store.Move
+		}
+		if _, err := transfer(tt.src, tt.dst, tt.pre); err != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.name, err, tt.want)
+		}
+	}
+	if keys := keysOf(t, store); !reflect.DeepEqual(keys, []string{"a", "b", "d"}) {
+		t.Errorf("the store has %v", keys)
+	}
+}
+
+func TestStats(t *testing.T) {
+	store := newTestStore(t)
+
+	empty, err := store.Stats("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if empty != (Stats{}) {
+		t.Errorf("an empty store has %+v", empty)
+	}
+
+	for key, value := range map[string]string{"a/1": "xx", "a/2": "xxxx", "a/3": "", "b": "xxxxxxxx"} {
+		if err := store.Put(key, &Value{Value: []byte(value)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	first, err := store.Get("a/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, err := store.Get("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		prefix                string
+		keys, total, min, max int64
+	}{
+		{"a/", 3, 6, 0, 4},
+		{"", 4, 14, 0, 8},
+		{"b", 1, 8, 8, 8},
+		{"c", 0, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		stats, err := store.Stats(tt.prefix)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stats.Keys != tt.keys || stats.TotalBytes != tt.total || stats.MinSize != tt.min || stats.MaxSize != tt.max {
+			t.Errorf("%q: got %+v", tt.prefix, stats)
+		}
+		if stats.Keys > 0 && (stats.Oldest > stats.Newest || stats.Oldest == 0) {
+			t.Errorf("%q: the oldest entry is from %d and the newest from %d", tt.prefix, stats.Oldest, stats.Newest)
+		}
+	}
+
+	all, err := store.Stats("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	oldest, newest := first.LastUpdated, last.LastUpdated
+	if oldest > newest {
+		oldest, newest = newest, oldest
+	}
+	if all.Oldest > oldest || all.Newest < newest {
+		t.Errorf("the entries are from %d to %d, the stats say %d to %d", oldest, newest, all.Oldest, all.Newest)
+	}
+}
+
+func TestIncr(t *testing.T) {
+	store := newTestStore(t)
+	if err := store.Put("text", &Value{Value: []byte("abc")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Put("labelled", &Value{Value: []byte("5"), Labels: map[string]string{"env": "prod"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key            string
+		delta, initial int64
+		want           int64
+		err            error
+	}{
+		{"a", 1, 0, 1, nil},
+		{"a", 1, 100, 2, nil},
+		{"a", -5, 0, -3, nil},
+		{"b", -1, 10, 9, nil},
+		{"c", 0, 7, 7, nil},
+		{"labelled", 2, 0, 7, nil},
+		{"text", 1, 0, 0, ErrNotCounter},
+		{"max", 1, math.MaxInt64, 0, ErrOverflow},
+		{"min", -1, math.MinInt64, 0, ErrOverflow},
+	}
+	for _, tt := range tests {
+		got, value, err := store.Incr(tt.key, tt.delta, tt.initial)
+		if err != tt.err {
+			t.Errorf("%s %+d: got %v, want %v", tt.key, tt.delta, err, tt.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if got != tt.want || string(value.Value) != strconv.FormatInt(tt.want, 10) {
+			t.Errorf("%s %+d: got %d stored as %q, want %d", tt.key, tt.delta, got, value.Value, tt.want)
+		}
+		stored, err := store.Get(tt.key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stored.Version != value.Version || stored.Version != versionOf(&stored) {
+			t.Errorf("%s: the counter has version %d, the increment returned %d", tt.key, stored.Version, value.Version)
+		}
+	}
+
+	// an increment keeps the labels and a failed one leaves the entry alone
+	labelled, err := store.Get("labelled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labelled.Labels["env"] != "prod" {
+		t.Errorf("the increment dropped the labels %v", labelled.Labels)
+	}
+	if _, err := store.Get("max"); err != ErrNotFound {
+		t.Errorf("an overflowing increment created the counter: %v", err)
+	}
+}
+
+func TestAppend(t *testing.T) {
+	store := newTestStore(t)
+
+	if _, err := store.Append("log", []byte("x"), AppendOptions{Version: 1}); err != ErrGone {
+		t.Errorf("appending to a missing entry with a version got %v", err)
+	}
+	first, err := store.Append("log", []byte("a\n"), AppendOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.Append("log", []byte("b\n"), AppendOptions{Version: first.Version})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(second.Value) != "a\nb\n" || second.Version != VersionOf(second.Value) {
+		t.Errorf("the journal holds %q with version %d", second.Value, second.Version)
+	}
+	if _, err := store.Append("log", []byte("c\n"), AppendOptions{Version: first.Version}); err != ErrVersionMismatch {
+		t.Errorf("appending with the replaced version got %v", err)
+	}
+	trimmed, err := store.Append("log", []byte("cc\n"), AppendOptions{MaxSize: 6, Delimiter: []byte("\n")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(trimmed.Value) != "b\ncc\n" {
+		t.Errorf("the trimmed journal holds %q", trimmed.Value)
+	}
+	stored, err := store.Get("log")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(stored.Value) != string(trimmed.Value) || stored.Version != trimmed.Version {
+		t.Errorf("the store holds %q with version %d", stored.Value, stored.Version)
+	}
+}
+
+func TestTrimOldest(t *testing.T) {
+	tests := []struct {
+		value     string
+		maxSize   int
+		delimiter string
+		want      string
+	}{
+		{"abcdef", 0, "", "abcdef"},
+		{"abcdef", 6, "", "abcdef"},
+		{"abcdef", 4, "", "cdef"},
+		{"a\nb\nc\n", 4, "\n", "b\nc\n"},
+		{"a\nb\nc\n", 5, "\n", "b\nc\n"},
+		{"aa\nbb\n", 4, "\n", "bb\n"},
+		{"aa\nbbbbb\n", 4, "\n", "bbb\n"},
+		{"a--b--c", 3, "--", "c"},
+		{"a--b--c", 4, "--", "b--c"},
+	}
+	for _, tt := range tests {
+		got := trimOldest([]byte(tt.value), tt.maxSize, []byte(tt.delimiter))
+		if string(got) != tt.want {
+			t.Errorf("%q to %d by %q: got %q, want %q", tt.value, tt.maxSize, tt.delimiter, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,343 @@
+package persist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// goleveldbZsetsPrefix starts the keys of the sorted sets. Every sorted set has
+// the score of each member by member, an index ordered by score and the number of members.
+const goleveldbZsetsPrefix = goleveldbInternalPrefix + "zsets/"
+
+func goleveldbZsetMemberKey(name, member string) []byte {
+	return []byte(goleveldbZsetsPrefix + name + "/m/" + member)
+}
+
+func goleveldbZsetIndexPrefix(name string) []byte {
+	return []byte(goleveldbZsetsPrefix + name + "/s/")
+}
+
+func goleveldbZsetIndexKey(name, member string, score float64) []byte {
+	prefix := goleveldbZsetIndexPrefix(name)
+	key := make([]byte, 0, len(prefix)+8+len(member))
+	key = append(key, prefix...)
+	key = append(key, goleveldbSortableScore(score)...)
+	return append(key, member...)
+}
+
+func goleveldbZsetCardKey(name string) []byte {
+	return []byte(goleveldbZsetsPrefix + name + "/n")
+}
+
+// goleveldbSortableScore encodes the score so the byte order is the numeric order:
+// the sign bit is flipped for positive numbers and all the bits are flipped for negative numbers
+func goleveldbSortableScore(score float64) []byte {
+	if score == 0 {
+		// -0 and 0 are the same score
+		score = 0
+	}
+	bits := math.Float64bits(score)
+	if bits&(1<<63) != 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], bits)
+	return b[:]
+}
+
+// goleveldbZsetIndexMember decodes an index key into the member and its score
+func goleveldbZsetIndexMember(prefix, key []byte) ZMember {
+	key = key[len(prefix):]
+	bits := binary.BigEndian.Uint64(key[:8])
+	if bits&(1<<63) != 0 {
+		bits &^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	return ZMember{Member: string(key[8:]), Score: math.Float64frombits(bits)}
+}
+
+func (g *goleveldbStore) zsetScore(name, member string) (float64, bool, error) {
+	data, err := g.DB.Get(goleveldbZsetMemberKey(name, member), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, goleveldbRewriteError(err)
+	}
+	return math.Float64frombits(binary.BigEndian.Uint64(data)), true, nil
+}
+
+// ZCard counts the members of the sorted set
+func (g *goleveldbStore) ZCard(name string) (int64, error) {
+	data, err := g.DB.Get(goleveldbZsetCardKey(name), nil)
+	if err == leveldb.ErrNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, goleveldbRewriteError(err)
+	}
+	return int64(binary.BigEndian.Uint64(data)), nil
+}
+
+// zsetWrite collects the changes to a sorted set so they get written in a single batch
+type zsetWrite struct {
+	name  string
+	batch leveldb.Batch
+	card  int64
+}
+
+func (w *zsetWrite) put(member string, score float64, old float64, exists bool) {
+	if score == 0 {
+		score = 0
+	}
+	if exists {
+		w.batch.Delete(goleveldbZsetIndexKey(w.name, member, old))
+	} else {
+		w.card++
+	}
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], math.Float64bits(score))
+	w.batch.Put(goleveldbZsetMemberKey(w.name, member), b[:])
+	w.batch.Put(goleveldbZsetIndexKey(w.name, member, score), nil)
+}
+
+func (w *zsetWrite) remove(member string, score float64) {
+	w.batch.Delete(goleveldbZsetMemberKey(w.name, member))
+	w.batch.Delete(goleveldbZsetIndexKey(w.name, member, score))
+	w.card--
+}
+
+func (g *goleveldbStore) writeZset(w *zsetWrite) error {
+	if w.card == 0 {
+		w.batch.Delete(goleveldbZsetCardKey(w.name))
+	} else {
+		var b [8]byte
+		binary.BigEndian.PutUint64(b[:], uint64(w.card))
+		w.batch.Put(goleveldbZsetCardKey(w.name), b[:])
+	}
+	return g.writeBatch(&w.batch)
+}
+
+// ZAdd adds the members to the sorted set, the members that are already in the set get the new score.
+// It returns the number of members that were added.
+func (g *goleveldbStore) ZAdd(name string, members []ZMember) (int, error) {
+	for _, m := range members {
+		if math.IsNaN(m.Score) || math.IsInf(m.Score, 0) {
+			return 0, ErrInvalidScore
+		}
+	}
+
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	card, err := g.ZCard(name)
+	if err != nil {
+		return 0, err
+	}
+	w := &zsetWrite{name: name, card: card}
+	// the last score wins when a member is in the list more than once
+	pending := make(map[string]float64, len(members))
+	for _, m := range members {
+		old, exists := pending[m.Member]
+		if !exists {
+			if old, exists, err = g.zsetScore(name, m.Member); err != nil {
+				return 0, err
+			}
+		}
+		w.put(m.Member, m.Score, old, exists)
+		pending[m.Member] = m.Score
+	}
+
+	added := int(w.card - card)
+	if err := g.writeZset(w); err != nil {
+		return 0, err
+	}
+	return added, nil
+}
+
+// ZIncr adds the delta to the score of the member, a member that isn't in the set yet starts at 0
+func (g *goleveldbStore) ZIncr(name, member string, delta float64) (float64, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	old, exists, err := g.zsetScore(name, member)
+	if err != nil {
+		return 0, err
+	}
+	score := old + delta
+	if math.IsNaN(score) || math.IsInf(score, 0) {
+		return 0, ErrInvalidScore
+	}
+
+	card, err := g.ZCard(name)
+	if err != nil {
+		return 0, err
+	}
+	w := &zsetWrite{name: name, card: card}
+	w.put(member, score, old, exists)
+	if err := g.writeZset(w); err != nil {
+		return 0, err
+	}
+	return score, nil
+}
+
+// ZRemove removes the members from the sorted set and returns the number of members that were removed
+func (g *goleveldbStore) ZRemove(name string, members []string) (int, error) {
+	g.writeLock.Lock()
+	defer g.writeLock.Unlock()
+
+	card, err := g.ZCard(name)
+	if err != nil {
+		return 0, err
+	}
+	w := &zsetWrite{name: name, card: card}
+	removed := make(map[string]bool, len(members))
+	for _, member := range members {
+		if removed[member] {
+			continue
+		}
+		score, exists, err := g.zsetScore(name, member)
+		if err != nil {
+			return 0, err
+		}
+		if exists {
+			w.remove(member, score)
+			removed[member] = true
+		}
+	}
+
+	if err := g.writeZset(w); err != nil {
+		return 0, err
+	}
+	return len(removed), nil
+}
+
+// ZRank gets the member with its rank, this counts the members before it so it takes longer for higher ranks
+func (g *goleveldbStore) ZRank(name, member string, reverse bool) (ZMember, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return ZMember{}, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	data, err := snap.Get(goleveldbZsetMemberKey(name, member), nil)
+	if err != nil {
+		return ZMember{}, goleveldbRewriteError(err)
+	}
+	score := math.Float64frombits(binary.BigEndian.Uint64(data))
+
+	// the members before this one sort before its index key
+	rng := util.BytesPrefix(goleveldbZsetIndexPrefix(name))
+	rng.Limit = goleveldbZsetIndexKey(name, member, score)
+	if reverse {
+		rng.Start = append(rng.Limit, 0)
+		rng.Limit = util.BytesPrefix(goleveldbZsetIndexPrefix(name)).Limit
+	}
+	iter := snap.NewIterator(rng, goleveldbNoCacheRead)
+	defer iter.Release()
+
+	var rank int64
+	for iter.Next() {
+		rank++
+	}
+	if err := iter.Error(); err != nil {
+		return ZMember{}, goleveldbRewriteError(err)
+	}
+	return ZMember{Member: member, Score: score, Rank: rank}, nil
+}
+
+// ZRange lists the members from the start rank up to and including the stop rank,
+// negative ranks count from the end of the set
+func (g *goleveldbStore) ZRange(name string, start, stop int64, reverse bool) ([]ZMember, error) {
+	snap, err := g.DB.GetSnapshot()
+	if err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	defer snap.Release()
+
+	var card int64
+	data, err := snap.Get(goleveldbZsetCardKey(name), nil)
+	if err != nil && err != leveldb.ErrNotFound {
+		return nil, goleveldbRewriteError(err)
+	}
+	if len(data) == 8 {
+		card = int64(binary.BigEndian.Uint64(data))
+	}
+	if start < 0 {
+		start += card
+	}
+	if stop < 0 {
+		stop += card
+	}
+	if start < 0 {
+		start = 0
+	}
+	if stop >= card {
+		stop = card - 1
+	}
+	if start > stop {
+		return nil, nil
+	}
+
+	prefix := goleveldbZsetIndexPrefix(name)
+	iter := snap.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	next, first := iter.Next, iter.First
+	if reverse {
+		next, first = iter.Prev, iter.Last
+	}
+
+	result := make([]ZMember, 0, stop-start+1)
+	var rank int64
+	for ok := first(); ok && rank <= stop; ok = next() {
+		if rank >= start {
+			m := goleveldbZsetIndexMember(prefix, iter.Key())
+			m.Rank = rank
+			result = append(result, m)
+		}
+		rank++
+	}
+	if err := iter.Error(); err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	return result, nil
+}
+
+// ZRangeByScore lists at most limit members with a score between min and max, both included,
+// after skipping offset of them. The rank of the members isn't known so it is left at 0.
+func (g *goleveldbStore) ZRangeByScore(name string, min, max float64, offset, limit int) ([]ZMember, error) {
+	if math.IsNaN(min) || math.IsNaN(max) {
+		return nil, ErrInvalidScore
+	}
+
+	prefix := goleveldbZsetIndexPrefix(name)
+	rng := util.BytesPrefix(prefix)
+	rng.Start = append(append([]byte(nil), prefix...), goleveldbSortableScore(min)...)
+	iter := g.DB.NewIterator(rng, nil)
+	defer iter.Release()
+
+	upper := goleveldbSortableScore(max)
+	var result []ZMember
+	for iter.Next() && len(result) < limit {
+		if bytes.Compare(iter.Key()[len(prefix):len(prefix)+8], upper) > 0 {
+			break
+		}
+		if offset > 0 {
+			offset--
+			continue
+		}
+		result = append(result, goleveldbZsetIndexMember(prefix, iter.Key()))
+	}
+	if err := iter.Error(); err != nil {
+		return nil, goleveldbRewriteError(err)
+	}
+	return result, nil
+}
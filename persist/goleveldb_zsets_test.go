@@ -0,0 +1,165 @@
+package persist
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+// membersOf lists the members in the order they come in
+func membersOf(members []ZMember) []string {
+	result := make([]string, 0, len(members))
+	for _, m := range members {
+		result = append(result, m.Member)
+	}
+	return result
+}
+
+func TestZsetOrder(t *testing.T) {
+	store := newTestStore(t)
+	added, err := store.ZAdd("z", []ZMember{
+		{Member: "zero", Score: 0},
+		{Member: "neg", Score: -1.5},
+		{Member: "tiny", Score: math.SmallestNonzeroFloat64},
+		{Member: "min", Score: -math.MaxFloat64},
+		{Member: "negzero", Score: math.Copysign(0, -1)},
+		{Member: "big", Score: 1e300},
+		{Member: "negtiny", Score: -math.SmallestNonzeroFloat64},
+		{Member: "one", Score: 1},
+		{Member: "neg2", Score: -2},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 9 {
+		t.Errorf("added %d members", added)
+	}
+	// the members with the same score are ordered by member
+	want := []string{"min", "neg2", "neg", "negtiny", "negzero", "zero", "tiny", "one", "big"}
+
+	all, err := store.ZRange("z", 0, -1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := membersOf(all); !reflect.DeepEqual(got, want) {
+		t.Fatalf("the set is ordered as %v, want %v", got, want)
+	}
+	for i, m := range all {
+		if m.Rank != int64(i) {
+			t.Errorf("%s has rank %d in the range, want %d", m.Member, m.Rank, i)
+		}
+		rank, err := store.ZRank("z", m.Member, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		reverse, err := store.ZRank("z", m.Member, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rank.Rank != int64(i) || reverse.Rank != int64(len(want)-1-i) || rank.Score != m.Score {
+			t.Errorf("%s has rank %d and reverse rank %d with score %v, want %d and %d", m.Member, rank.Rank, reverse.Rank, rank.Score, i, len(want)-1-i)
+		}
+	}
+
+	reversed, err := store.ZRange("z", 0, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := membersOf(reversed); !reflect.DeepEqual(got, []string{"big", "one", "tiny"}) {
+		t.Errorf("the reverse range is %v", got)
+	}
+	tail, err := store.ZRange("z", -2, 100, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := membersOf(tail); !reflect.DeepEqual(got, []string{"one", "big"}) || tail[0].Rank != 7 {
+		t.Errorf("the last two members are %+v", tail)
+	}
+	if empty, err := store.ZRange("z", 5, 2, false); err != nil || len(empty) != 0 {
+		t.Errorf("an empty range got %v, %v", empty, err)
+	}
+
+	tests := []struct {
+		min, max      float64
+		offset, limit int
+		want          []string
+	}{
+		{-2, -1, 0, 10, []string{"neg2", "neg"}},
+		{0, 0, 0, 10, []string{"negzero", "zero"}},
+		{math.Copysign(0, -1), 0, 0, 10, []string{"negzero", "zero"}},
+		{-1, 1, 1, 3, []string{"negzero", "zero", "tiny"}},
+		{math.Inf(-1), math.Inf(1), 7, 10, []string{"one", "big"}},
+		{2, 3, 0, 10, []string{}},
+		{1, -1, 0, 10, []string{}},
+	}
+	for _, tt := range tests {
+		members, err := store.ZRangeByScore("z", tt.min, tt.max, tt.offset, tt.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := membersOf(members); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v to %v from %d: got %v, want %v", tt.min, tt.max, tt.offset, got, tt.want)
+		}
+	}
+	if _, err := store.ZRangeByScore("z", math.NaN(), 0, 0, 10); err != ErrInvalidScore {
+		t.Errorf("a range from NaN got %v", err)
+	}
+}
+
+func TestZsetUpdates(t *testing.T) {
+	store := newTestStore(t)
+	if _, err := store.ZAdd("z", []ZMember{{Member: "a", Score: 1}, {Member: "b", Score: 2}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// an existing member moves to its new score and the last score in the list wins
+	added, err := store.ZAdd("z", []ZMember{{Member: "a", Score: 3}, {Member: "c", Score: -1}, {Member: "c", Score: 0}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if added != 1 {
+		t.Errorf("added %d members", added)
+	}
+	score, err := store.ZIncr("z", "b", -2.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if score != -0.5 {
+		t.Errorf("the incremented score is %v", score)
+	}
+	if score, err = store.ZIncr("z", "d", -3); err != nil || score != -3 {
+		t.Errorf("incrementing a new member got %v, %v", score, err)
+	}
+	all, err := store.ZRange("z", 0, -1, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := membersOf(all); !reflect.DeepEqual(got, []string{"d", "b", "c", "a"}) {
+		t.Errorf("the set is ordered as %v", got)
+	}
+
+	if _, err := store.ZAdd("z", []ZMember{{Member: "e", Score: math.Inf(1)}}); err != ErrInvalidScore {
+		t.Errorf("adding an infinite score got %v", err)
+	}
+	if _, err := store.ZIncr("z", "a", math.NaN()); err != ErrInvalidScore {
+		t.Errorf("incrementing by NaN got %v", err)
+	}
+
+	removed, err := store.ZRemove("z", []string{"a", "a", "missing", "d"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := store.ZCard("z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 2 || card != 2 {
+		t.Errorf("removed %d members and %d are left", removed, card)
+	}
+	if _, err := store.ZRank("z", "a", false); err != ErrNotFound {
+		t.Errorf("the rank of a removed member got %v", err)
+	}
+	if card, err := store.ZCard("missing"); err != nil || card != 0 {
+		t.Errorf("a missing set has %d members, %v", card, err)
+	}
+}
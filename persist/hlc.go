@@ -0,0 +1,46 @@
+package persist
+
+import (
+	"sync"
+	"time"
+)
+
+// hybridClock is a hybrid logical clock, it hands out times in unix nanoseconds that follow the wall clock
+// but never go back and always come after the times it has seen. The logical part is folded into the
+// nanoseconds: while the wall clock is behind, the next time is the last one plus one.
+//
+// Stores that merge each other's writes observe the times of those writes, so a write made after seeing
+// another write gets a later time even when the clock of its store is behind.
+type hybridClock struct {
+	lock sync.Mutex
+	last int64
+}
+
+// now returns the next time
+func (c *hybridClock) now() int64 {
+	wall := time.Now().UTC().UnixNano()
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if wall > c.last {
+		c.last = wall
+	} else {
+		c.last++
+	}
+	return c.last
+}
+
+// observe moves the clock past a time it received, the times it hands out next come after it
+func (c *hybridClock) observe(t int64) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if t > c.last {
+		c.last = t
+	}
+}
+
+// WrittenAfter reports if the write at the time and origin comes after the other one,
+// the origin orders the writes made at the same time on different stores
+func WrittenAfter(at int64, origin string, than int64, thanOrigin string) bool {
+	return at > than || (at == than && origin > thanOrigin)
+}
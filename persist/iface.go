@@ -2,6 +2,9 @@ package persist
 
 import (
 	"errors"
+	"io"
+	"strings"
+	"time"
 	"unsafe"
 
 	"github.com/OneOfOne/xxhash"
@@ -16,6 +19,21 @@ var (
 	ErrIterReleased     = errors.New("iterator released")
 	ErrClosed           = errors.New("closed")
 	ErrVersionMismatch  = errors.New("version mismatch")
+	ErrNotCounter       = errors.New("the entry doesn't hold a counter")
+	ErrOverflow         = errors.New("the counter would overflow")
+	ErrSessionNotFound  = errors.New("session not found")
+	ErrSessionConflict  = errors.New("the entry is bound to another session")
+	ErrLockHeld         = errors.New("the lock is held by someone else")
+	ErrLockLost         = errors.New("the lock is no longer held with this token")
+	ErrSemaphoreFull    = errors.New("all the slots of the semaphore are taken")
+	ErrSemaphoreLimit   = errors.New("the slots of the semaphore are held with another limit")
+	ErrSlotLost         = errors.New("the holder no longer holds a slot of the semaphore")
+	ErrQueueEmpty       = errors.New("the queue has no visible messages")
+	ErrReceiptMismatch  = errors.New("the message was dequeued again after this receipt was handed out")
+	ErrInvalidScore     = errors.New("the score is not a finite number")
+	ErrIndexExists      = errors.New("an index with this name already exists")
+	ErrSearchDisabled   = errors.New("search is not enabled, there are no search prefixes configured")
+	ErrChangesPruned    = errors.New("the changes that were asked for were pruned from the changes log")
 )
 
 // UnsafeStringToBytes converts strings to []byte without memcopy
@@ -37,6 +55,17 @@ func VersionOf(data []byte) uint64 {
 	return h.Sum64()
 }
 
+// versionOf calculates the version of the value together with its labels,
+// for a value without labels this is the same as VersionOf
+func versionOf(value *Value) uint64 {
+	h := xxhash.New64()
+	_, _ = h.Write(value.Value)
+	if len(value.Labels) > 0 {
+		_, _ = h.Write([]byte("\x00" + FormatLabels(value.Labels)))
+	}
+	return h.Sum64()
+}
+
 // KeyValue represents an entry with key name
 type KeyValue struct {
 	Key   string
@@ -44,11 +73,176 @@ type KeyValue struct {
 	_     struct{}
 }
 
+// Precondition for copying or moving an entry, the zero value doesn't check anything
+type Precondition struct {
+	// SourceVersion when not 0 requires the source entry to have this version
+	SourceVersion uint64
+	// DestinationVersion when not nil requires the destination entry to have this version,
+	// a version of 0 requires the destination entry to not exist
+	DestinationVersion *uint64
+}
+
+// Stats for the entries that start with a prefix, the times are unix nanoseconds
+type Stats struct {
+	Keys       int64
+	TotalBytes int64
+	MinSize    int64
+	MaxSize    int64
+	Oldest     int64
+	Newest     int64
+	// DiskBytes is the approximate on disk footprint of the entries
+	DiskBytes int64
+	_         struct{}
+}
+
+// AppendOptions control how data gets appended to an entry
+type AppendOptions struct {
+	// Version when not 0 requires the entry to have this version
+	Version uint64
+	// MaxSize when not 0 trims the oldest data so the value fits in this many bytes
+	MaxSize int
+	// Delimiter when not empty separates the records in the value, trimming removes whole records
+	Delimiter []byte
+}
+
+// QueueStats counts the messages in a queue
+type QueueStats struct {
+	Length int64
+	// Visible is the number of messages that can be dequeued
+	Visible int64
+	// InFlight is the number of messages that were dequeued and are not visible yet
+	InFlight int64
+	_        struct{}
+}
+
+// ZMember is a member of a sorted set with its score
+type ZMember struct {
+	Member string
+	Score  float64
+	// Rank is the position of the member in the sorted set, starting at 0
+	Rank int64
+	_    struct{}
+}
+
+// Operations in the changes log
+const (
+	ChangePut    = "put"
+	ChangeDelete = "delete"
+)
+
+// SearchResult is an entry that contains the words that were searched for
+type SearchResult struct {
+	Key   string
+	Score float64
+	// Snippet is the part of the text around the first word that was found
+	Snippet string
+	_       struct{}
+}
+
+// What happens to the entries bound to a session when the session ends
+const (
+	SessionDelete  = "delete"
+	SessionRelease = "release"
+)
+
 // Store for values by key
 type Store interface {
 	Put(string, *Value) error
 	Get(string) (Value, error)
 	FindByPrefix(string) ([]KeyValue, error)
+	ScanPrefix(string, func(KeyValue) bool) error
+	FindKeys(string, string) ([]string, error)
+	Stats(string) (Stats, error)
 	Delete(string) error
+	DeleteByPrefix(string) (int, error)
+	Copy(string, string, Precondition) (Value, error)
+	Move(string, string, Precondition) (Value, error)
+	CopyPrefix(string, string) (int, error)
+	MovePrefix(string, string) (int, error)
+	Incr(string, int64, int64) (int64, Value, error)
+	Update(string, uint64, func([]byte) ([]byte, error)) (Value, error)
+	Append(string, []byte, AppendOptions) (Value, error)
+	CreateSession(Session) (Session, error)
+	GetSession(string) (Session, error)
+	ListSessions() ([]Session, error)
+	RenewSession(string) (Session, error)
+	DestroySession(string) error
+	AcquireLock(string, string, time.Duration) (Lock, error)
+	GetLock(string) (Lock, error)
+	RenewLock(string, uint64) (Lock, error)
+	ReleaseLock(string, uint64) error
+	LockReleased(string) <-chan struct{}
+	AcquireSlot(string, string, int64, time.Duration) (Semaphore, error)
+	GetSemaphore(string) (Semaphore, error)
+	RenewSlot(string, string) (Semaphore, error)
+	ReleaseSlot(string, string) error
+	SlotReleased(string) <-chan struct{}
+	Enqueue(string, []byte) (QueueMessage, error)
+	Dequeue(string, time.Duration) (QueueMessage, error)
+	Peek(string) (QueueMessage, error)
+	Ack(string, uint64, string) error
+	Nack(string, uint64, string, time.Duration) error
+	QueueStats(string) (QueueStats, error)
+	MessageAvailable(string) <-chan struct{}
+	ZAdd(string, []ZMember) (int, error)
+	ZIncr(string, string, float64) (float64, error)
+	ZRemove(string, []string) (int, error)
+	ZCard(string) (int64, error)
+	ZRank(string, string, bool) (ZMember, error)
+	ZRange(string, int64, int64, bool) ([]ZMember, error)
+	ZRangeByScore(string, float64, float64, int, int) ([]ZMember, error)
+	HGet(string, string) (Value, uint64, error)
+	HGetAll(string) (map[string]Value, uint64, error)
+	HSet(string, string, []byte, *uint64) (Value, uint64, error)
+	HDelete(string, string, *uint64) (uint64, error)
+	NextIDs(string, int64) (int64, error)
+	CreateIndex(Index) (Index, error)
+	ListIndexes() ([]Index, error)
+	DropIndex(string) error
+	QueryIndex(string, string) (Index, []string, error)
+	Search(string, string, int) ([]SearchResult, error)
+	RebuildSearch() (int, error)
+	SetLabels(string, map[string]string, uint64) (Value, error)
+	SelectKeys(string, Selector) ([]string, error)
+	Changes(string, int64, int) ([]Change, int64, error)
+	ChangeLog(uint64, int) ([]Change, uint64, uint64, error)
+	NextChange() uint64
+	Dump(io.Writer) error
+	Restore(io.Reader) (uint64, error)
+	Apply([]Change) error
+	Applied() (uint64, error)
+	Replace(string, *Value) error
+	ModifiedKeys(string, int64) ([]string, error)
+	MerkleTree() (*MerkleTree, error)
+	MerkleKeys([]int) ([]MerkleKey, error)
+	Merge(string, *Value) (bool, error)
+	NodeID() string
+	PeerApplied(string) (uint64, error)
+	MergeChanges(string, []Change) (int, error)
+	MergeDump(string, io.Reader) (uint64, error)
+	ApplyCommand(uint64, int64, string, func()) error
+	CommandApplied() (uint64, error)
+	SetCommandApplied(uint64) error
+	Now() int64
 	Close() error
 }
+
+// RollUp replaces the sorted keys that contain the delimiter after the prefix with their common prefix,
+// like FindKeys does
+func RollUp(keys []string, prefix, delimiter string) []string {
+	if delimiter == "" {
+		return keys
+	}
+
+	var result []string
+	for _, key := range keys {
+		if idx := strings.Index(key[len(prefix):], delimiter); idx >= 0 {
+			key = key[:len(prefix)+idx+len(delimiter)]
+		}
+		if len(result) > 0 && result[len(result)-1] == key {
+			continue
+		}
+		result = append(result, key)
+	}
+	return result
+}
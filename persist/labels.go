@@ -0,0 +1,197 @@
+package persist
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Label errors
+var (
+	ErrInvalidLabel    = errors.New("label names are 1 to 253 and label values up to 63 letters, digits, '-', '_', '.' or '/'")
+	ErrInvalidSelector = errors.New("a selector is a comma separated list of name, !name, name=value, name!=value, name in (values) or name notin (values)")
+)
+
+const (
+	maxLabelNameLength  = 253
+	maxLabelValueLength = 63
+)
+
+// the characters the label names and values are made of, this keeps the separators
+// of the label lists, the selectors and the index keys out of them
+var labelChars = regexp.MustCompile(`^[A-Za-z0-9_./-]*$`)
+
+func validLabelName(name string) bool {
+	return name != "" && len(name) <= maxLabelNameLength && labelChars.MatchString(name)
+}
+
+func validLabelValue(value string) bool {
+	return len(value) <= maxLabelValueLength && labelChars.MatchString(value)
+}
+
+// ValidateLabels checks the names and the values of the labels
+func ValidateLabels(labels map[string]string) error {
+	for name, value := range labels {
+		if !validLabelName(name) || !validLabelValue(value) {
+			return ErrInvalidLabel
+		}
+	}
+	return nil
+}
+
+// ParseLabels parses a comma separated list of name=value pairs
+func ParseLabels(list string) (map[string]string, error) {
+	labels := make(map[string]string)
+	if strings.TrimSpace(list) == "" {
+		return labels, nil
+	}
+	for _, pair := range strings.Split(list, ",") {
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return nil, ErrInvalidLabel
+		}
+		labels[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return labels, ValidateLabels(labels)
+}
+
+// FormatLabels formats the labels as a comma separated list of name=value pairs ordered by name
+func FormatLabels(labels map[string]string) string {
+	pairs := make([]string, 0, len(labels))
+	for name, value := range labels {
+		pairs = append(pairs, name+"="+value)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// Selector operators
+const (
+	SelectExists    = "exists"
+	SelectNotExists = "!"
+	SelectEquals    = "="
+	SelectNotEquals = "!="
+	SelectIn        = "in"
+	SelectNotIn     = "notin"
+)
+
+// Requirement is a condition on a label
+type Requirement struct {
+	Name     string
+	Operator string
+	Values   []string
+}
+
+// Matches reports if the labels meet the requirement, a missing label meets != and notin
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Name]
+	switch r.Operator {
+	case SelectExists:
+		return ok
+	case SelectNotExists:
+		return !ok
+	case SelectEquals, SelectIn:
+		return ok && contains(r.Values, value)
+	default:
+		return !ok || !contains(r.Values, value)
+	}
+}
+
+// Indexed reports if the entries that meet the requirement can be found with the label index
+func (r Requirement) Indexed() bool {
+	return r.Operator == SelectExists || r.Operator == SelectEquals || r.Operator == SelectIn
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// Selector selects entries by their labels, the labels need to meet all the requirements
+type Selector []Requirement
+
+// Matches reports if the labels meet all the requirements
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+var setRequirement = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+
+// ParseSelector parses a selector like env=prod,tier!=cache,region in (eu, us),!canary
+func ParseSelector(selector string) (Selector, error) {
+	var result Selector
+	for _, part := range splitSelector(selector) {
+		part = strings.TrimSpace(part)
+		r, err := parseRequirement(part)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, r)
+	}
+	if len(result) == 0 {
+		return nil, ErrInvalidSelector
+	}
+	return result, nil
+}
+
+// splitSelector splits the selector at the commas that aren't inside the values of a set
+func splitSelector(selector string) []string {
+	var parts []string
+	var depth, start int
+	for i, c := range selector {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, selector[start:])
+}
+
+func parseRequirement(part string) (Requirement, error) {
+	var r Requirement
+	if m := setRequirement.FindStringSubmatch(part); m != nil {
+		r = Requirement{Name: m[1], Operator: m[2]}
+		for _, v := range strings.Split(m[3], ",") {
+			r.Values = append(r.Values, strings.TrimSpace(v))
+		}
+	} else if strings.HasPrefix(part, "!") && !strings.Contains(part, "=") {
+		r = Requirement{Name: strings.TrimSpace(part[1:]), Operator: SelectNotExists}
+	} else if idx := strings.Index(part, "!="); idx >= 0 {
+		r = Requirement{Name: part[:idx], Operator: SelectNotEquals, Values: []string{part[idx+2:]}}
+	} else if idx := strings.Index(part, "=="); idx >= 0 {
+		r = Requirement{Name: part[:idx], Operator: SelectEquals, Values: []string{part[idx+2:]}}
+	} else if idx := strings.Index(part, "="); idx >= 0 {
+		r = Requirement{Name: part[:idx], Operator: SelectEquals, Values: []string{part[idx+1:]}}
+	} else {
+		r = Requirement{Name: part, Operator: SelectExists}
+	}
+
+	r.Name = strings.TrimSpace(r.Name)
+	if !validLabelName(r.Name) {
+		return Requirement{}, ErrInvalidSelector
+	}
+	for i, v := range r.Values {
+		r.Values[i] = strings.TrimSpace(v)
+		if !validLabelValue(r.Values[i]) {
+			return Requirement{}, ErrInvalidSelector
+		}
+	}
+	return r, nil
+}
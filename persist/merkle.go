@@ -0,0 +1,81 @@
+package persist
+
+import (
+	"encoding/binary"
+
+	"github.com/OneOfOne/xxhash"
+)
+
+// The shape of the merkle tree, every node has MerkleFanout children and the leaves are MerkleDepth levels
+// below the root. The leaves are the buckets the keys hash into.
+const (
+	MerkleFanout  = 16
+	MerkleDepth   = 3
+	MerkleBuckets = MerkleFanout * MerkleFanout * MerkleFanout
+)
+
+// MerkleBucket returns the bucket the key hashes into
+func MerkleBucket(key string) int {
+	return int(xxhash.ChecksumString64(key) % MerkleBuckets)
+}
+
+// merkleLeaf is the hash the entry adds to its bucket, the version of the entry already hashes its value and labels
+func merkleLeaf(key string, version uint64) uint64 {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], version)
+	h := xxhash.New64()
+	_, _ = h.WriteString(key)
+	_, _ = h.Write([]byte{0})
+	_, _ = h.Write(b[:])
+	return h.Sum64()
+}
+
+// MerkleTree summarizes the entries of a store, two stores with the same entries have the same root.
+// The nodes of a level are indexed from 0, the children of node i are the nodes i*MerkleFanout up to
+// (i+1)*MerkleFanout of the next level.
+type MerkleTree struct {
+	levels [MerkleDepth + 1][]uint64
+}
+
+// newMerkleTree builds the tree over the hashes of the buckets, a bucket hash is the sum of the leaves in it
+// so it doesn't depend on the order the entries are added in
+func newMerkleTree(buckets []uint64) *MerkleTree {
+	t := new(MerkleTree)
+	t.levels[MerkleDepth] = buckets
+	for level := MerkleDepth - 1; level >= 0; level-- {
+		children := t.levels[level+1]
+		nodes := make([]uint64, len(children)/MerkleFanout)
+		var b [8]byte
+		for i := range nodes {
+			h := xxhash.New64()
+			for _, child := range children[i*MerkleFanout : (i+1)*MerkleFanout] {
+				binary.BigEndian.PutUint64(b[:], child)
+				_, _ = h.Write(b[:])
+			}
+			nodes[i] = h.Sum64()
+		}
+		t.levels[level] = nodes
+	}
+	return t
+}
+
+// Root is the hash of all the entries
+func (t *MerkleTree) Root() uint64 {
+	return t.levels[0][0]
+}
+
+// Level returns the hashes of the nodes at the level, level 0 is the root and level MerkleDepth the buckets
+func (t *MerkleTree) Level(level int) []uint64 {
+	if level < 0 || level > MerkleDepth {
+		return nil
+	}
+	return t.levels[level]
+}
+
+// MerkleKey is an entry as the merkle tree sees it
+type MerkleKey struct {
+	Key         string
+	Version     uint64
+	LastUpdated int64
+	Origin      string
+}
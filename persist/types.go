@@ -5,5 +5,103 @@ type Value struct {
 	Value       []byte
 	Version     uint64
 	LastUpdated int64
-	_           struct{}
+	// Session the entry is bound to, empty when the entry isn't bound to a session
+	Session string
+	// Labels of the entry, they are part of the version. A Put without labels keeps the labels of the entry.
+	Labels map[string]string
+	// Origin is the id of the store the entry was written on, it orders the writes made at the same time
+	Origin string
+	_      struct{}
+}
+
+// Tombstone is what a delete leaves of an entry, it keeps the delete from losing to older writes of other stores
+type Tombstone struct {
+	// DeletedAt is the hybrid logical clock time of the delete in unix nanoseconds
+	DeletedAt int64
+	// Origin is the id of the store the entry was deleted on
+	Origin string
+	_      struct{}
+}
+
+// Session keeps the entries that are bound to it alive for as long as it gets renewed
+type Session struct {
+	ID   string
+	Name string
+	// TTL is the time to live in nanoseconds
+	TTL      int64
+	Behavior string
+	// ExpiresAt is the time the session expires in unix nanoseconds
+	ExpiresAt int64
+	_         struct{}
+}
+
+// Lock is a lease on a name, the token increases every time the lock changes hands
+type Lock struct {
+	Name   string
+	Holder string
+	Token  uint64
+	// TTL is the time to live in nanoseconds
+	TTL int64
+	// ExpiresAt is the time the lock gets released in unix nanoseconds
+	ExpiresAt int64
+	_         struct{}
+}
+
+// Semaphore allows at most Limit holders at the same time, every holder has a lease on its slot
+type Semaphore struct {
+	Name    string
+	Limit   int64
+	Holders []Slot
+	_       struct{}
+}
+
+// Slot of a semaphore that is held until it gets released or its lease expires
+type Slot struct {
+	Holder string
+	// TTL is the time to live in nanoseconds
+	TTL int64
+	// ExpiresAt is the time the slot gets released in unix nanoseconds
+	ExpiresAt int64
+	_         struct{}
+}
+
+// QueueMessage is a message in a queue, the messages are ordered by their id
+type QueueMessage struct {
+	ID   uint64
+	Body []byte
+	// EnqueuedAt is the time the message was added in unix nanoseconds
+	EnqueuedAt int64
+	// VisibleAt is the time the message can be dequeued in unix nanoseconds
+	VisibleAt int64
+	// Receipt is handed out when the message gets dequeued, it is needed to ack or nack the message
+	Receipt    string
+	Deliveries int64
+	_          struct{}
+}
+
+// Index over the values a JSON pointer points at in the JSON documents of the entries that start with the prefix
+type Index struct {
+	Name    string
+	Prefix  string
+	Pointer string
+	// Ready is false while the entries that existed before the index was created are being indexed
+	Ready bool
+	_     struct{}
+}
+
+// Change is a write or a delete of an entry in the changes log
+type Change struct {
+	// Sequence numbers the changes without gaps, starting at 1
+	Sequence uint64
+	// Revision orders the changes, it is the time of the change in unix nanoseconds made unique
+	Revision int64
+	Key      string
+	// Version of the entry after the change, 0 when the entry was deleted
+	Version uint64
+	Op      string
+	// Value is the entry after the change, nil when the entry was deleted
+	Value *Value
+	// Tombstone is what the delete left of the entry, nil when the entry was written
+	Tombstone *Tombstone
+	_         struct{}
 }
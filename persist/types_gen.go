@@ -8,6 +8,1632 @@ import (
 	"github.com/tinylib/msgp/msgp"
 )
 
+// DecodeMsg implements msgp.Decodable
+func (z *Change) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Sequence":
+			z.Sequence, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Revision":
+			z.Revision, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Key":
+			z.Key, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Version":
+			z.Version, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Op":
+			z.Op, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Value":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Value = nil
+			} else {
+				if z.Value == nil {
+					z.Value = new(Value)
+				}
+				err = z.Value.DecodeMsg(dc)
+				if err != nil {
+					return
+				}
+			}
+		case "Tombstone":
+			if dc.IsNil() {
+				err = dc.ReadNil()
+				if err != nil {
+					return
+				}
+				z.Tombstone = nil
+			} else {
+				if z.Tombstone == nil {
+					z.Tombstone = new(Tombstone)
+				}
+				var zb0002 uint32
+				zb0002, err = dc.ReadMapHeader()
+				if err != nil {
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "DeletedAt":
+						z.Tombstone.DeletedAt, err = dc.ReadInt64()
+						if err != nil {
+							return
+						}
+					case "Origin":
+						z.Tombstone.Origin, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Change) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 7
+	// write "Sequence"
+	err = en.Append(0x87, 0xa8, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Sequence)
+	if err != nil {
+		return
+	}
+	// write "Revision"
+	err = en.Append(0xa8, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Revision)
+	if err != nil {
+		return
+	}
+	// write "Key"
+	err = en.Append(0xa3, 0x4b, 0x65, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Key)
+	if err != nil {
+		return
+	}
+	// write "Version"
+	err = en.Append(0xa7, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Version)
+	if err != nil {
+		return
+	}
+	// write "Op"
+	err = en.Append(0xa2, 0x4f, 0x70)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Op)
+	if err != nil {
+		return
+	}
+	// write "Value"
+	err = en.Append(0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Value == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		err = z.Value.EncodeMsg(en)
+		if err != nil {
+			return
+		}
+	}
+	// write "Tombstone"
+	err = en.Append(0xa9, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65)
+	if err != nil {
+		return
+	}
+	if z.Tombstone == nil {
+		err = en.WriteNil()
+		if err != nil {
+			return
+		}
+	} else {
+		// map header, size 2
+		// write "DeletedAt"
+		err = en.Append(0x82, 0xa9, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Tombstone.DeletedAt)
+		if err != nil {
+			return
+		}
+		// write "Origin"
+		err = en.Append(0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Tombstone.Origin)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Change) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 7
+	// string "Sequence"
+	o = append(o, 0x87, 0xa8, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65)
+	o = msgp.AppendUint64(o, z.Sequence)
+	// string "Revision"
+	o = append(o, 0xa8, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendInt64(o, z.Revision)
+	// string "Key"
+	o = append(o, 0xa3, 0x4b, 0x65, 0x79)
+	o = msgp.AppendString(o, z.Key)
+	// string "Version"
+	o = append(o, 0xa7, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendUint64(o, z.Version)
+	// string "Op"
+	o = append(o, 0xa2, 0x4f, 0x70)
+	o = msgp.AppendString(o, z.Op)
+	// string "Value"
+	o = append(o, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	if z.Value == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		o, err = z.Value.MarshalMsg(o)
+		if err != nil {
+			return
+		}
+	}
+	// string "Tombstone"
+	o = append(o, 0xa9, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65)
+	if z.Tombstone == nil {
+		o = msgp.AppendNil(o)
+	} else {
+		// map header, size 2
+		// string "DeletedAt"
+		o = append(o, 0x82, 0xa9, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74)
+		o = msgp.AppendInt64(o, z.Tombstone.DeletedAt)
+		// string "Origin"
+		o = append(o, 0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+		o = msgp.AppendString(o, z.Tombstone.Origin)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Change) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Sequence":
+			z.Sequence, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Revision":
+			z.Revision, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Key":
+			z.Key, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Version":
+			z.Version, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Op":
+			z.Op, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Value":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Value = nil
+			} else {
+				if z.Value == nil {
+					z.Value = new(Value)
+				}
+				bts, err = z.Value.UnmarshalMsg(bts)
+				if err != nil {
+					return
+				}
+			}
+		case "Tombstone":
+			if msgp.IsNil(bts) {
+				bts, err = msgp.ReadNilBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Tombstone = nil
+			} else {
+				if z.Tombstone == nil {
+					z.Tombstone = new(Tombstone)
+				}
+				var zb0002 uint32
+				zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					return
+				}
+				for zb0002 > 0 {
+					zb0002--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "DeletedAt":
+						z.Tombstone.DeletedAt, bts, err = msgp.ReadInt64Bytes(bts)
+						if err != nil {
+							return
+						}
+					case "Origin":
+						z.Tombstone.Origin, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Change) Msgsize() (s int) {
+	s = 1 + 9 + msgp.Uint64Size + 9 + msgp.Int64Size + 4 + msgp.StringPrefixSize + len(z.Key) + 8 + msgp.Uint64Size + 3 + msgp.StringPrefixSize + len(z.Op) + 6
+	if z.Value == nil {
+		s += msgp.NilSize
+	} else {
+		s += z.Value.Msgsize()
+	}
+	s += 10
+	if z.Tombstone == nil {
+		s += msgp.NilSize
+	} else {
+		s += 1 + 10 + msgp.Int64Size + 7 + msgp.StringPrefixSize + len(z.Tombstone.Origin)
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Index) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Prefix":
+			z.Prefix, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Pointer":
+			z.Pointer, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Ready":
+			z.Ready, err = dc.ReadBool()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Index) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 4
+	// write "Name"
+	err = en.Append(0x84, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		return
+	}
+	// write "Prefix"
+	err = en.Append(0xa6, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Prefix)
+	if err != nil {
+		return
+	}
+	// write "Pointer"
+	err = en.Append(0xa7, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Pointer)
+	if err != nil {
+		return
+	}
+	// write "Ready"
+	err = en.Append(0xa5, 0x52, 0x65, 0x61, 0x64, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBool(z.Ready)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Index) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 4
+	// string "Name"
+	o = append(o, 0x84, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Prefix"
+	o = append(o, 0xa6, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78)
+	o = msgp.AppendString(o, z.Prefix)
+	// string "Pointer"
+	o = append(o, 0xa7, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Pointer)
+	// string "Ready"
+	o = append(o, 0xa5, 0x52, 0x65, 0x61, 0x64, 0x79)
+	o = msgp.AppendBool(o, z.Ready)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Index) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Prefix":
+			z.Prefix, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Pointer":
+			z.Pointer, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Ready":
+			z.Ready, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Index) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.StringPrefixSize + len(z.Prefix) + 8 + msgp.StringPrefixSize + len(z.Pointer) + 6 + msgp.BoolSize
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Lock) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Holder":
+			z.Holder, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Token":
+			z.Token, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Lock) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "Name"
+	err = en.Append(0x85, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		return
+	}
+	// write "Holder"
+	err = en.Append(0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Holder)
+	if err != nil {
+		return
+	}
+	// write "Token"
+	err = en.Append(0xa5, 0x54, 0x6f, 0x6b, 0x65, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.Token)
+	if err != nil {
+		return
+	}
+	// write "TTL"
+	err = en.Append(0xa3, 0x54, 0x54, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.TTL)
+	if err != nil {
+		return
+	}
+	// write "ExpiresAt"
+	err = en.Append(0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ExpiresAt)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Lock) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "Name"
+	o = append(o, 0x85, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Holder"
+	o = append(o, 0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Holder)
+	// string "Token"
+	o = append(o, 0xa5, 0x54, 0x6f, 0x6b, 0x65, 0x6e)
+	o = msgp.AppendUint64(o, z.Token)
+	// string "TTL"
+	o = append(o, 0xa3, 0x54, 0x54, 0x4c)
+	o = msgp.AppendInt64(o, z.TTL)
+	// string "ExpiresAt"
+	o = append(o, 0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.ExpiresAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Lock) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Holder":
+			z.Holder, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Token":
+			z.Token, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Lock) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 7 + msgp.StringPrefixSize + len(z.Holder) + 6 + msgp.Uint64Size + 4 + msgp.Int64Size + 10 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *QueueMessage) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, err = dc.ReadUint64()
+			if err != nil {
+				return
+			}
+		case "Body":
+			z.Body, err = dc.ReadBytes(z.Body)
+			if err != nil {
+				return
+			}
+		case "EnqueuedAt":
+			z.EnqueuedAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "VisibleAt":
+			z.VisibleAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Receipt":
+			z.Receipt, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Deliveries":
+			z.Deliveries, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *QueueMessage) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 6
+	// write "ID"
+	err = en.Append(0x86, 0xa2, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteUint64(z.ID)
+	if err != nil {
+		return
+	}
+	// write "Body"
+	err = en.Append(0xa4, 0x42, 0x6f, 0x64, 0x79)
+	if err != nil {
+		return
+	}
+	err = en.WriteBytes(z.Body)
+	if err != nil {
+		return
+	}
+	// write "EnqueuedAt"
+	err = en.Append(0xaa, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.EnqueuedAt)
+	if err != nil {
+		return
+	}
+	// write "VisibleAt"
+	err = en.Append(0xa9, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.VisibleAt)
+	if err != nil {
+		return
+	}
+	// write "Receipt"
+	err = en.Append(0xa7, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Receipt)
+	if err != nil {
+		return
+	}
+	// write "Deliveries"
+	err = en.Append(0xaa, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Deliveries)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *QueueMessage) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 6
+	// string "ID"
+	o = append(o, 0x86, 0xa2, 0x49, 0x44)
+	o = msgp.AppendUint64(o, z.ID)
+	// string "Body"
+	o = append(o, 0xa4, 0x42, 0x6f, 0x64, 0x79)
+	o = msgp.AppendBytes(o, z.Body)
+	// string "EnqueuedAt"
+	o = append(o, 0xaa, 0x45, 0x6e, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.EnqueuedAt)
+	// string "VisibleAt"
+	o = append(o, 0xa9, 0x56, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.VisibleAt)
+	// string "Receipt"
+	o = append(o, 0xa7, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74)
+	o = msgp.AppendString(o, z.Receipt)
+	// string "Deliveries"
+	o = append(o, 0xaa, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73)
+	o = msgp.AppendInt64(o, z.Deliveries)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *QueueMessage) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadUint64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Body":
+			z.Body, bts, err = msgp.ReadBytesBytes(bts, z.Body)
+			if err != nil {
+				return
+			}
+		case "EnqueuedAt":
+			z.EnqueuedAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "VisibleAt":
+			z.VisibleAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Receipt":
+			z.Receipt, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Deliveries":
+			z.Deliveries, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *QueueMessage) Msgsize() (s int) {
+	s = 1 + 3 + msgp.Uint64Size + 5 + msgp.BytesPrefixSize + len(z.Body) + 11 + msgp.Int64Size + 10 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len(z.Receipt) + 11 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Semaphore) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Limit":
+			z.Limit, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Holders":
+			var zb0002 uint32
+			zb0002, err = dc.ReadArrayHeader()
+			if err != nil {
+				return
+			}
+			if cap(z.Holders) >= int(zb0002) {
+				z.Holders = (z.Holders)[:zb0002]
+			} else {
+				z.Holders = make([]Slot, zb0002)
+			}
+			for za0001 := range z.Holders {
+				var zb0003 uint32
+				zb0003, err = dc.ReadMapHeader()
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, err = dc.ReadMapKeyPtr()
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Holder":
+						z.Holders[za0001].Holder, err = dc.ReadString()
+						if err != nil {
+							return
+						}
+					case "TTL":
+						z.Holders[za0001].TTL, err = dc.ReadInt64()
+						if err != nil {
+							return
+						}
+					case "ExpiresAt":
+						z.Holders[za0001].ExpiresAt, err = dc.ReadInt64()
+						if err != nil {
+							return
+						}
+					default:
+						err = dc.Skip()
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Semaphore) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Name"
+	err = en.Append(0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		return
+	}
+	// write "Limit"
+	err = en.Append(0xa5, 0x4c, 0x69, 0x6d, 0x69, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.Limit)
+	if err != nil {
+		return
+	}
+	// write "Holders"
+	err = en.Append(0xa7, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteArrayHeader(uint32(len(z.Holders)))
+	if err != nil {
+		return
+	}
+	for za0001 := range z.Holders {
+		// map header, size 3
+		// write "Holder"
+		err = en.Append(0x83, 0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(z.Holders[za0001].Holder)
+		if err != nil {
+			return
+		}
+		// write "TTL"
+		err = en.Append(0xa3, 0x54, 0x54, 0x4c)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Holders[za0001].TTL)
+		if err != nil {
+			return
+		}
+		// write "ExpiresAt"
+		err = en.Append(0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+		if err != nil {
+			return
+		}
+		err = en.WriteInt64(z.Holders[za0001].ExpiresAt)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Semaphore) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Name"
+	o = append(o, 0x83, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "Limit"
+	o = append(o, 0xa5, 0x4c, 0x69, 0x6d, 0x69, 0x74)
+	o = msgp.AppendInt64(o, z.Limit)
+	// string "Holders"
+	o = append(o, 0xa7, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73)
+	o = msgp.AppendArrayHeader(o, uint32(len(z.Holders)))
+	for za0001 := range z.Holders {
+		// map header, size 3
+		// string "Holder"
+		o = append(o, 0x83, 0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+		o = msgp.AppendString(o, z.Holders[za0001].Holder)
+		// string "TTL"
+		o = append(o, 0xa3, 0x54, 0x54, 0x4c)
+		o = msgp.AppendInt64(o, z.Holders[za0001].TTL)
+		// string "ExpiresAt"
+		o = append(o, 0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+		o = msgp.AppendInt64(o, z.Holders[za0001].ExpiresAt)
+	}
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Semaphore) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Limit":
+			z.Limit, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Holders":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if cap(z.Holders) >= int(zb0002) {
+				z.Holders = (z.Holders)[:zb0002]
+			} else {
+				z.Holders = make([]Slot, zb0002)
+			}
+			for za0001 := range z.Holders {
+				var zb0003 uint32
+				zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if err != nil {
+					return
+				}
+				for zb0003 > 0 {
+					zb0003--
+					field, bts, err = msgp.ReadMapKeyZC(bts)
+					if err != nil {
+						return
+					}
+					switch msgp.UnsafeString(field) {
+					case "Holder":
+						z.Holders[za0001].Holder, bts, err = msgp.ReadStringBytes(bts)
+						if err != nil {
+							return
+						}
+					case "TTL":
+						z.Holders[za0001].TTL, bts, err = msgp.ReadInt64Bytes(bts)
+						if err != nil {
+							return
+						}
+					case "ExpiresAt":
+						z.Holders[za0001].ExpiresAt, bts, err = msgp.ReadInt64Bytes(bts)
+						if err != nil {
+							return
+						}
+					default:
+						bts, err = msgp.Skip(bts)
+						if err != nil {
+							return
+						}
+					}
+				}
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Semaphore) Msgsize() (s int) {
+	s = 1 + 5 + msgp.StringPrefixSize + len(z.Name) + 6 + msgp.Int64Size + 8 + msgp.ArrayHeaderSize
+	for za0001 := range z.Holders {
+		s += 1 + 7 + msgp.StringPrefixSize + len(z.Holders[za0001].Holder) + 4 + msgp.Int64Size + 10 + msgp.Int64Size
+	}
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Session) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Name":
+			z.Name, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Behavior":
+			z.Behavior, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z *Session) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 5
+	// write "ID"
+	err = en.Append(0x85, 0xa2, 0x49, 0x44)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.ID)
+	if err != nil {
+		return
+	}
+	// write "Name"
+	err = en.Append(0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Name)
+	if err != nil {
+		return
+	}
+	// write "TTL"
+	err = en.Append(0xa3, 0x54, 0x54, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.TTL)
+	if err != nil {
+		return
+	}
+	// write "Behavior"
+	err = en.Append(0xa8, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Behavior)
+	if err != nil {
+		return
+	}
+	// write "ExpiresAt"
+	err = en.Append(0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ExpiresAt)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Session) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 5
+	// string "ID"
+	o = append(o, 0x85, 0xa2, 0x49, 0x44)
+	o = msgp.AppendString(o, z.ID)
+	// string "Name"
+	o = append(o, 0xa4, 0x4e, 0x61, 0x6d, 0x65)
+	o = msgp.AppendString(o, z.Name)
+	// string "TTL"
+	o = append(o, 0xa3, 0x54, 0x54, 0x4c)
+	o = msgp.AppendInt64(o, z.TTL)
+	// string "Behavior"
+	o = append(o, 0xa8, 0x42, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72)
+	o = msgp.AppendString(o, z.Behavior)
+	// string "ExpiresAt"
+	o = append(o, 0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.ExpiresAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Session) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "ID":
+			z.ID, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Name":
+			z.Name, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Behavior":
+			z.Behavior, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Session) Msgsize() (s int) {
+	s = 1 + 3 + msgp.StringPrefixSize + len(z.ID) + 5 + msgp.StringPrefixSize + len(z.Name) + 4 + msgp.Int64Size + 9 + msgp.StringPrefixSize + len(z.Behavior) + 10 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Slot) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Holder":
+			z.Holder, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Slot) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 3
+	// write "Holder"
+	err = en.Append(0x83, 0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Holder)
+	if err != nil {
+		return
+	}
+	// write "TTL"
+	err = en.Append(0xa3, 0x54, 0x54, 0x4c)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.TTL)
+	if err != nil {
+		return
+	}
+	// write "ExpiresAt"
+	err = en.Append(0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.ExpiresAt)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Slot) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 3
+	// string "Holder"
+	o = append(o, 0x83, 0xa6, 0x48, 0x6f, 0x6c, 0x64, 0x65, 0x72)
+	o = msgp.AppendString(o, z.Holder)
+	// string "TTL"
+	o = append(o, 0xa3, 0x54, 0x54, 0x4c)
+	o = msgp.AppendInt64(o, z.TTL)
+	// string "ExpiresAt"
+	o = append(o, 0xa9, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.ExpiresAt)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Slot) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "Holder":
+			z.Holder, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "TTL":
+			z.TTL, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "ExpiresAt":
+			z.ExpiresAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Slot) Msgsize() (s int) {
+	s = 1 + 7 + msgp.StringPrefixSize + len(z.Holder) + 4 + msgp.Int64Size + 10 + msgp.Int64Size
+	return
+}
+
+// DecodeMsg implements msgp.Decodable
+func (z *Tombstone) DecodeMsg(dc *msgp.Reader) (err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, err = dc.ReadMapHeader()
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, err = dc.ReadMapKeyPtr()
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DeletedAt":
+			z.DeletedAt, err = dc.ReadInt64()
+			if err != nil {
+				return
+			}
+		case "Origin":
+			z.Origin, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		default:
+			err = dc.Skip()
+			if err != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// EncodeMsg implements msgp.Encodable
+func (z Tombstone) EncodeMsg(en *msgp.Writer) (err error) {
+	// map header, size 2
+	// write "DeletedAt"
+	err = en.Append(0x82, 0xa9, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74)
+	if err != nil {
+		return
+	}
+	err = en.WriteInt64(z.DeletedAt)
+	if err != nil {
+		return
+	}
+	// write "Origin"
+	err = en.Append(0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Origin)
+	if err != nil {
+		return
+	}
+	return
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z Tombstone) MarshalMsg(b []byte) (o []byte, err error) {
+	o = msgp.Require(b, z.Msgsize())
+	// map header, size 2
+	// string "DeletedAt"
+	o = append(o, 0x82, 0xa9, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74)
+	o = msgp.AppendInt64(o, z.DeletedAt)
+	// string "Origin"
+	o = append(o, 0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	o = msgp.AppendString(o, z.Origin)
+	return
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Tombstone) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 uint32
+	zb0001, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if err != nil {
+		return
+	}
+	for zb0001 > 0 {
+		zb0001--
+		field, bts, err = msgp.ReadMapKeyZC(bts)
+		if err != nil {
+			return
+		}
+		switch msgp.UnsafeString(field) {
+		case "DeletedAt":
+			z.DeletedAt, bts, err = msgp.ReadInt64Bytes(bts)
+			if err != nil {
+				return
+			}
+		case "Origin":
+			z.Origin, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		default:
+			bts, err = msgp.Skip(bts)
+			if err != nil {
+				return
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z Tombstone) Msgsize() (s int) {
+	s = 1 + 10 + msgp.Int64Size + 7 + msgp.StringPrefixSize + len(z.Origin)
+	return
+}
+
 // DecodeMsg implements msgp.Decodable
 func (z *Value) DecodeMsg(dc *msgp.Reader) (err error) {
 	var field []byte
@@ -39,6 +1665,43 @@ func (z *Value) DecodeMsg(dc *msgp.Reader) (err error) {
 			if err != nil {
 				return
 			}
+		case "Session":
+			z.Session, err = dc.ReadString()
+			if err != nil {
+				return
+			}
+		case "Labels":
+			var zb0002 uint32
+			zb0002, err = dc.ReadMapHeader()
+			if err != nil {
+				return
+			}
+			if z.Labels == nil {
+				z.Labels = make(map[string]string, zb0002)
+			} else if len(z.Labels) > 0 {
+				for key := range z.Labels {
+					delete(z.Labels, key)
+				}
+			}
+			for zb0002 > 0 {
+				zb0002--
+				var za0001 string
+				var za0002 string
+				za0001, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				za0002, err = dc.ReadString()
+				if err != nil {
+					return
+				}
+				z.Labels[za0001] = za0002
+			}
+		case "Origin":
+			z.Origin, err = dc.ReadString()
+			if err != nil {
+				return
+			}
 		default:
 			err = dc.Skip()
 			if err != nil {
@@ -51,9 +1714,9 @@ func (z *Value) DecodeMsg(dc *msgp.Reader) (err error) {
 
 // EncodeMsg implements msgp.Encodable
 func (z *Value) EncodeMsg(en *msgp.Writer) (err error) {
-	// map header, size 3
+	// map header, size 6
 	// write "Value"
-	err = en.Append(0x83, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	err = en.Append(0x86, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
 	if err != nil {
 		return
 	}
@@ -79,15 +1742,52 @@ func (z *Value) EncodeMsg(en *msgp.Writer) (err error) {
 	if err != nil {
 		return
 	}
+	// write "Session"
+	err = en.Append(0xa7, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Session)
+	if err != nil {
+		return
+	}
+	// write "Labels"
+	err = en.Append(0xa6, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73)
+	if err != nil {
+		return
+	}
+	err = en.WriteMapHeader(uint32(len(z.Labels)))
+	if err != nil {
+		return
+	}
+	for za0001, za0002 := range z.Labels {
+		err = en.WriteString(za0001)
+		if err != nil {
+			return
+		}
+		err = en.WriteString(za0002)
+		if err != nil {
+			return
+		}
+	}
+	// write "Origin"
+	err = en.Append(0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	if err != nil {
+		return
+	}
+	err = en.WriteString(z.Origin)
+	if err != nil {
+		return
+	}
 	return
 }
 
 // MarshalMsg implements msgp.Marshaler
 func (z *Value) MarshalMsg(b []byte) (o []byte, err error) {
 	o = msgp.Require(b, z.Msgsize())
-	// map header, size 3
+	// map header, size 6
 	// string "Value"
-	o = append(o, 0x83, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
+	o = append(o, 0x86, 0xa5, 0x56, 0x61, 0x6c, 0x75, 0x65)
 	o = msgp.AppendBytes(o, z.Value)
 	// string "Version"
 	o = append(o, 0xa7, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e)
@@ -95,6 +1795,19 @@ func (z *Value) MarshalMsg(b []byte) (o []byte, err error) {
 	// string "LastUpdated"
 	o = append(o, 0xab, 0x4c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64)
 	o = msgp.AppendInt64(o, z.LastUpdated)
+	// string "Session"
+	o = append(o, 0xa7, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e)
+	o = msgp.AppendString(o, z.Session)
+	// string "Labels"
+	o = append(o, 0xa6, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73)
+	o = msgp.AppendMapHeader(o, uint32(len(z.Labels)))
+	for za0001, za0002 := range z.Labels {
+		o = msgp.AppendString(o, za0001)
+		o = msgp.AppendString(o, za0002)
+	}
+	// string "Origin"
+	o = append(o, 0xa6, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e)
+	o = msgp.AppendString(o, z.Origin)
 	return
 }
 
@@ -129,6 +1842,43 @@ func (z *Value) UnmarshalMsg(bts []byte) (o []byte, err error) {
 			if err != nil {
 				return
 			}
+		case "Session":
+			z.Session, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
+		case "Labels":
+			var zb0002 uint32
+			zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+			if err != nil {
+				return
+			}
+			if z.Labels == nil {
+				z.Labels = make(map[string]string, zb0002)
+			} else if len(z.Labels) > 0 {
+				for key := range z.Labels {
+					delete(z.Labels, key)
+				}
+			}
+			for zb0002 > 0 {
+				var za0001 string
+				var za0002 string
+				zb0002--
+				za0001, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				za0002, bts, err = msgp.ReadStringBytes(bts)
+				if err != nil {
+					return
+				}
+				z.Labels[za0001] = za0002
+			}
+		case "Origin":
+			z.Origin, bts, err = msgp.ReadStringBytes(bts)
+			if err != nil {
+				return
+			}
 		default:
 			bts, err = msgp.Skip(bts)
 			if err != nil {
@@ -142,6 +1892,13 @@ func (z *Value) UnmarshalMsg(bts []byte) (o []byte, err error) {
 
 // Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
 func (z *Value) Msgsize() (s int) {
-	s = 1 + 6 + msgp.BytesPrefixSize + len(z.Value) + 8 + msgp.Uint64Size + 12 + msgp.Int64Size
+	s = 1 + 6 + msgp.BytesPrefixSize + len(z.Value) + 8 + msgp.Uint64Size + 12 + msgp.Int64Size + 8 + msgp.StringPrefixSize + len(z.Session) + 7 + msgp.MapHeaderSize
+	if z.Labels != nil {
+		for za0001, za0002 := range z.Labels {
+			_ = za0002
+			s += msgp.StringPrefixSize + len(za0001) + msgp.StringPrefixSize + len(za0002)
+		}
+	}
+	s += 7 + msgp.StringPrefixSize + len(z.Origin)
 	return
 }
@@ -0,0 +1,335 @@
+package replication
+
+import (
+	"container/list"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// How a read through the cache got the entry
+const (
+	// CacheHit is a read of a copy that is still fresh
+	CacheHit = "hit"
+	// CacheRevalidated is a read of a copy the upstream said is still current
+	CacheRevalidated = "revalidated"
+	// CacheMiss is a read of what the upstream has, the copy was missing or out of date
+	CacheMiss = "miss"
+	// CacheStale is a read of a copy that couldn't be revalidated because the upstream is unreachable
+	CacheStale = "stale"
+)
+
+// cacheState is what the cache knows about an entry of the upstream, the local store has the copy
+type cacheState struct {
+	key string
+	// validated is the time the copy was last known to match the upstream, zero when it needs revalidation
+	validated time.Time
+	// changed counts the invalidations, a read that started before one doesn't store what it got
+	changed uint64
+}
+
+// Cache keeps copies of the entries of an upstream store in the store, the reads are served from the copies.
+// A copy is fresh for the freshness period after it was read or revalidated, after that the next read
+// revalidates it with the upstream. The cache tails the changelog of the upstream and replaces or removes
+// the copies of the entries that change upstream, so those are current without waiting for the freshness
+// period to end. When the upstream is unreachable the reads get the copies they can't revalidate.
+//
+// The cache follows at most max entries, the ones read least recently are dropped first. The copy of a dropped
+// entry stays in the store without following the changelog, the next read revalidates it.
+type Cache struct {
+	db           persist.Store
+	upstream     *url.URL
+	client       *client.KvStore
+	http         *http.Client
+	freshness    time.Duration
+	pollInterval time.Duration
+	maxEntries   int
+	log          logrus.FieldLogger
+
+	lock    sync.Mutex
+	entries map[string]*list.Element
+	// recent holds the states of the entries, the one read most recently in front
+	recent *list.List
+	// next is the sequence of the next change of the upstream to read, 0 before knowing where the upstream is
+	next uint64
+
+	done     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+// NewCache creates a cache of the upstream at the url, the requests to the upstream time out after the timeout
+// and the changelog of the upstream is checked every poll interval. It follows at most max entries, 0 for no bound.
+func NewCache(db persist.Store, upstream string, freshness, pollInterval, timeout time.Duration, maxEntries int, log logrus.FieldLogger) (*Cache, error) {
+	u, err := url.Parse(strings.TrimSuffix(upstream, "/"))
+	if err != nil {
+		return nil, err
+	}
+	c, err := client.New(upstream)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{
+		db:           db,
+		upstream:     u,
+		client:       c,
+		http:         &http.Client{Timeout: timeout},
+		freshness:    freshness,
+		pollInterval: pollInterval,
+		maxEntries:   maxEntries,
+		log:          log,
+		entries:      make(map[string]*list.Element),
+		recent:       list.New(),
+		done:         make(chan struct{}),
+		stopped:      make(chan struct{}),
+	}, nil
+}
+
+// Upstream is the url of the upstream this caches
+func (c *Cache) Upstream() *url.URL {
+	return c.upstream
+}
+
+// cacheReadAttempts bounds the reads of an entry that keeps changing while it is read from the upstream
+const cacheReadAttempts = 2
+
+// Read makes sure the store has the entry at key as the upstream has it, or doesn't have it when the upstream
+// doesn't, and returns how it did that. When the upstream is unreachable it keeps the copy it has, this fails
+// only when there is no copy to fall back on.
+func (c *Cache) Read(key string) (string, error) {
+	for attempt := 1; ; attempt++ {
+		how, retry, err := c.read(key, attempt == cacheReadAttempts)
+		if !retry {
+			return how, err
+		}
+	}
+}
+
+// read reads the entry at key once and reports if it needs to be read again because it changed while this
+// was reading it. The last attempt keeps what it read, without counting it as validated.
+func (c *Cache) read(key string, last bool) (string, bool, error) {
+	c.lock.Lock()
+	state := c.state(key)
+	if !state.validated.IsZero() && time.Since(state.validated) < c.freshness {
+		c.lock.Unlock()
+		return CacheHit, false, nil
+	}
+	known, changed := !state.validated.IsZero(), state.changed
+	c.lock.Unlock()
+
+	local, err := c.db.Get(key)
+	if err != nil && err != persist.ErrNotFound {
+		return "", false, err
+	}
+	// the copy of an entry that doesn't exist upstream is the absence of the entry
+	have := err == nil
+	known = known || have
+
+	value, status, err := c.fetch(key, local.Version)
+	if err != nil {
+		if known {
+			c.log.Debugf("serving the stale copy of %s, revalidating failed: %v", key, err)
+			return CacheStale, false, nil
+		}
+		return "", false, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	// the changelog or a write changed the entry while this was reading, what it read can be out of date
+	current := state.changed == changed
+	if !current && !last {
+		return "", true, nil
+	}
+	if status == http.StatusNotModified {
+		if current {
+			state.validated = time.Now()
+		}
+		return CacheRevalidated, false, nil
+	}
+	if value != nil || have {
+		if err := c.db.Replace(key, value); err != nil {
+			return "", false, err
+		}
+	}
+	if current {
+		state.validated = time.Now()
+	}
+	return CacheMiss, false, nil
+}
+
+// Invalidate makes the next read of the entry at key revalidate its copy
+func (c *Cache) Invalidate(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	state := c.state(key)
+	state.validated = time.Time{}
+	state.changed++
+}
+
+// state returns the state of the entry at key and makes it the one read most recently, past the max entries
+// this drops the state of the entry read least recently. This needs to be called while holding the lock.
+func (c *Cache) state(key string) *cacheState {
+	if elem, ok := c.entries[key]; ok {
+		c.recent.MoveToFront(elem)
+		return elem.Value.(*cacheState)
+	}
+	state := &cacheState{key: key}
+	c.entries[key] = c.recent.PushFront(state)
+	if c.maxEntries > 0 && c.recent.Len() > c.maxEntries {
+		oldest := c.recent.Remove(c.recent.Back()).(*cacheState)
+		delete(c.entries, oldest.key)
+	}
+	return state
+}
+
+// fetch reads the entry at key from the upstream, with a version it asks for the entry only when it has
+// another version. It returns the entry with a 200 status, nil with a 304 or a 404 status and an error
+// for the other statuses.
+func (c *Cache) fetch(key string, version uint64) (*persist.Value, int, error) {
+	req, err := http.NewRequest(http.MethodGet, c.upstream.String()+"/kv/"+url.PathEscape(key), nil)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version != 0 {
+		req.Header.Set("If-None-Match", strconv.FormatUint(version, 10))
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified, http.StatusNotFound:
+		return nil, res.StatusCode, nil
+	default:
+		return nil, res.StatusCode, fmt.Errorf("the upstream responded to the read of %s with %s", key, res.Status)
+	}
+
+	data, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	value := &persist.Value{Value: data}
+	if value.Version, err = strconv.ParseUint(res.Header.Get("ETag"), 10, 64); err != nil {
+		return nil, 0, err
+	}
+	if value.Labels, err = persist.ParseLabels(res.Header.Get("X-Labels")); err != nil {
+		return nil, 0, err
+	}
+	if value.LastUpdated, err = strconv.ParseInt(res.Header.Get("X-Last-Updated"), 10, 64); err != nil {
+		return nil, 0, err
+	}
+	return value, http.StatusOK, nil
+}
+
+// Start tailing the changelog of the upstream in the background
+func (c *Cache) Start() {
+	go c.run()
+}
+
+// Stop tailing the changelog of the upstream, this waits for the change that is being applied
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() { close(c.done) })
+	<-c.stopped
+}
+
+func (c *Cache) run() {
+	defer close(c.stopped)
+
+	for {
+		wait := c.pollInterval
+		caughtUp, err := c.poll()
+		switch {
+		case err != nil:
+			c.log.Warnf("reading the changelog of %s failed: %v", c.upstream, err)
+		case !caughtUp:
+			// there are more changes waiting
+			wait = 0
+		}
+
+		select {
+		case <-c.done:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// poll applies the next page of changes of the upstream to the copies and reports if those were all the changes
+func (c *Cache) poll() (bool, error) {
+	if c.next == 0 {
+		// the copies from before can have missed any change, they all get revalidated
+		status, err := c.client.ReplicationStatus()
+		if err != nil {
+			return false, err
+		}
+		c.invalidateAll()
+		c.next = swag.Uint64Value(status.Sequence)
+		return false, nil
+	}
+
+	page, err := c.client.Changelog(c.next, changelogPage)
+	if err == client.ErrChangesPruned {
+		c.log.Infof("the changes after %d were pruned from the changelog of %s, revalidating all the copies", c.next, c.upstream)
+		c.next = 0
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, entry := range page.Changes {
+		if err := c.apply(toChange(entry)); err != nil {
+			return false, err
+		}
+	}
+	c.next = swag.Uint64Value(page.Next)
+	return len(page.Changes) < changelogPage, nil
+}
+
+// apply replaces or removes the copy of the entry that changed, the entries that weren't read or were dropped
+// aren't followed
+func (c *Cache) apply(change persist.Change) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	elem, ok := c.entries[change.Key]
+	if !ok {
+		return nil
+	}
+	state := elem.Value.(*cacheState)
+	state.changed++
+	state.validated = time.Time{}
+	var value *persist.Value
+	if change.Op == persist.ChangePut {
+		value = change.Value
+	}
+	if err := c.db.Replace(change.Key, value); err != nil {
+		return err
+	}
+	state.validated = time.Now()
+	return nil
+}
+
+// invalidateAll makes the next reads revalidate all the copies
+func (c *Cache) invalidateAll() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for elem := c.recent.Front(); elem != nil; elem = elem.Next() {
+		state := elem.Value.(*cacheState)
+		state.validated = time.Time{}
+		state.changed++
+	}
+}
@@ -0,0 +1,276 @@
+// Package replication keeps a follower store up to date with the changes of a primary
+package replication
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/kvstore/gen/models"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// Follower states
+const (
+	// StateSnapshot is the state while the follower restores a snapshot of the primary
+	StateSnapshot = "snapshot"
+	// StateStreaming is the state while the follower applies the changelog of the primary
+	StateStreaming = "streaming"
+)
+
+// changelogPage is the number of changes that are read from the primary at once
+const changelogPage = 1000
+
+// Status of a follower
+type Status struct {
+	State string
+	// Applied is the sequence of the next change of the primary to apply
+	Applied uint64
+	// PrimaryNext is the sequence of the next change of the primary the last time its changelog was read
+	PrimaryNext uint64
+	// Staleness is the time since all the changes of the primary were applied
+	Staleness time.Duration
+	// LastError is the error of the last attempt to read from the primary when it failed
+	LastError error
+}
+
+// Lag is the number of changes of the primary that still need to be applied
+func (s Status) Lag() uint64 {
+	if s.PrimaryNext <= s.Applied {
+		return 0
+	}
+	return s.PrimaryNext - s.Applied
+}
+
+// Follower tails the changelog of a primary and applies the changes to the store.
+// It starts with a snapshot of the primary when the store has nothing of the primary yet
+// or when the changes it needs were pruned from the changelog of the primary.
+type Follower struct {
+	sink         changeSink
+	primary      string
+	client       *client.KvStore
+	pollInterval time.Duration
+	log          logrus.FieldLogger
+
+	lock        sync.RWMutex
+	state       string
+	applied     uint64
+	primaryNext uint64
+	caughtUp    time.Time
+	lastError   error
+
+	done     chan struct{}
+	stopOnce sync.Once
+	stopped  chan struct{}
+}
+
+// changeSink is where a follower puts the changes it reads
+type changeSink interface {
+	Applied() (uint64, error)
+	Apply([]persist.Change) error
+	Restore(io.Reader) (uint64, error)
+}
+
+// NewFollower creates a follower of the primary at the url that checks for changes every poll interval
+func NewFollower(db persist.Store, primary string, pollInterval time.Duration, log logrus.FieldLogger) (*Follower, error) {
+	return newFollower(db, primary, pollInterval, log)
+}
+
+func newFollower(sink changeSink, primary string, pollInterval time.Duration, log logrus.FieldLogger) (*Follower, error) {
+	c, err := client.New(primary)
+	if err != nil {
+		return nil, err
+	}
+	applied, err := sink.Applied()
+	if err != nil {
+		return nil, err
+	}
+	return &Follower{
+		sink:         sink,
+		primary:      primary,
+		client:       c,
+		pollInterval: pollInterval,
+		log:          log,
+		state:        StateStreaming,
+		applied:      applied,
+		primaryNext:  applied,
+		caughtUp:     time.Now(),
+		done:         make(chan struct{}),
+		stopped:      make(chan struct{}),
+	}, nil
+}
+
+// Primary is the url of the primary this follows
+func (f *Follower) Primary() string {
+	return f.primary
+}
+
+// Status reports how far the follower is behind the primary
+func (f *Follower) Status() Status {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return Status{
+		State:       f.state,
+		Applied:     f.applied,
+		PrimaryNext: f.primaryNext,
+		Staleness:   time.Since(f.caughtUp),
+		LastError:   f.lastError,
+	}
+}
+
+// Staleness is the time since all the changes of the primary were applied
+func (f *Follower) Staleness() time.Duration {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return time.Since(f.caughtUp)
+}
+
+// Start following the primary in the background
+func (f *Follower) Start() {
+	go f.run()
+}
+
+// Stop following the primary, this waits for the change that is being applied
+func (f *Follower) Stop() {
+	f.stopOnce.Do(func() { close(f.done) })
+	<-f.stopped
+}
+
+func (f *Follower) run() {
+	defer close(f.stopped)
+
+	for {
+		wait := f.pollInterval
+		caughtUp, err := f.poll()
+		f.lock.Lock()
+		f.lastError = err
+		f.lock.Unlock()
+		switch {
+		case err != nil:
+			f.log.Warnf("following %s failed: %v", f.primary, err)
+		case !caughtUp:
+			// there are more changes waiting
+			wait = 0
+		}
+
+		select {
+		case <-f.done:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// poll applies the next page of changes of the primary and reports if those were all the changes
+func (f *Follower) poll() (bool, error) {
+	applied, err := f.sink.Applied()
+	if err != nil {
+		return false, err
+	}
+	if applied == 0 {
+		return false, f.snapshot()
+	}
+
+	page, err := f.client.Changelog(applied, changelogPage)
+	if err == client.ErrChangesPruned {
+		f.log.Infof("the changes after %d were pruned from the changelog of %s, restoring a snapshot", applied, f.primary)
+		return false, f.snapshot()
+	}
+	if err != nil {
+		return false, err
+	}
+
+	changes := make([]persist.Change, 0, len(page.Changes))
+	for i, entry := range page.Changes {
+		if swag.Uint64Value(entry.Sequence) != applied+uint64(i) {
+			// this can't continue from where it is, so it starts over
+			f.log.Warnf("the changelog of %s skipped from %d to %d, restoring a snapshot", f.primary, applied+uint64(i), swag.Uint64Value(entry.Sequence))
+			return false, f.snapshot()
+		}
+		changes = append(changes, toChange(entry))
+	}
+	if err := f.sink.Apply(changes); err != nil {
+		return false, err
+	}
+
+	next := swag.Uint64Value(page.Next)
+	caughtUp := len(changes) < changelogPage
+	primaryNext := next
+	if !caughtUp {
+		status, err := f.client.ReplicationStatus()
+		if err != nil {
+			return false, err
+		}
+		primaryNext = swag.Uint64Value(status.Sequence)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.state = StateStreaming
+	f.applied = next
+	f.primaryNext = primaryNext
+	if caughtUp {
+		f.caughtUp = time.Now()
+	}
+	return caughtUp, nil
+}
+
+// snapshot replaces the entries of the store with the ones of the primary
+func (f *Follower) snapshot() error {
+	f.lock.Lock()
+	f.state = StateSnapshot
+	f.lock.Unlock()
+
+	r, w := io.Pipe()
+	transferred := make(chan error, 1)
+	go func() {
+		err := f.client.Snapshot(w)
+		w.CloseWithError(err)
+		transferred <- err
+	}()
+
+	applied, err := f.sink.Restore(r)
+	// this stops the transfer when restoring failed before reading all of it
+	r.Close()
+	if terr := <-transferred; err == nil {
+		err = terr
+	}
+	if err != nil {
+		return err
+	}
+
+	f.log.Infof("restored a snapshot of %s, following from %d", f.primary, applied)
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.state = StateStreaming
+	f.applied = applied
+	f.primaryNext = applied
+	return nil
+}
+
+// toChange converts a change of the changelog of the primary
+func toChange(entry *models.ChangelogEntry) persist.Change {
+	change := persist.Change{
+		Sequence: swag.Uint64Value(entry.Sequence),
+		Revision: swag.Int64Value(entry.Revision),
+		Key:      swag.StringValue(entry.Key),
+		Version:  swag.Uint64Value(entry.Version),
+		Op:       swag.StringValue(entry.Op),
+	}
+	switch {
+	case change.Op == persist.ChangePut:
+		change.Value = &persist.Value{
+			Value:       entry.Value,
+			Version:     change.Version,
+			LastUpdated: entry.LastUpdated,
+			Labels:      entry.Labels,
+			Origin:      entry.Origin,
+		}
+	case entry.LastUpdated != 0:
+		change.Tombstone = &persist.Tombstone{DeletedAt: entry.LastUpdated, Origin: entry.Origin}
+	}
+	return change
+}
@@ -0,0 +1,37 @@
+package replication
+
+import (
+	"io"
+	"time"
+
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/sirupsen/logrus"
+)
+
+// NewPeer creates a follower of a peer at the url that takes writes too, it merges the changes of the peer
+// into the store instead of applying them. Last writer wins by the hybrid logical clock time of the writes,
+// so the peers that merge each other's changes end up with the same entries whatever order they merge them in.
+// It starts with merging a snapshot of the peer when it has nothing of the peer yet or when the changes
+// it needs were pruned from the changelog of the peer.
+func NewPeer(db persist.Store, peer string, pollInterval time.Duration, log logrus.FieldLogger) (*Follower, error) {
+	return newFollower(&peerSink{db: db, peer: peer}, peer, pollInterval, log)
+}
+
+// peerSink merges the changes of a peer into the store
+type peerSink struct {
+	db   persist.Store
+	peer string
+}
+
+func (p *peerSink) Applied() (uint64, error) {
+	return p.db.PeerApplied(p.peer)
+}
+
+func (p *peerSink) Apply(changes []persist.Change) error {
+	_, err := p.db.MergeChanges(p.peer, changes)
+	return err
+}
+
+func (p *peerSink) Restore(r io.Reader) (uint64, error) {
+	return p.db.MergeDump(p.peer, r)
+}
@@ -0,0 +1,190 @@
+package replication
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/swag"
+	"github.com/sirupsen/logrus"
+)
+
+// merklePage is the number of nodes or buckets that are read from a peer at once
+const merklePage = 512
+
+// RepairResult tells what a repair found and did
+type RepairResult struct {
+	// Buckets is the number of buckets that differed
+	Buckets int
+	// Copied is the number of entries that were copied from the peer
+	Copied int
+	// Kept is the number of entries that differed but were updated later in the store
+	Kept int
+}
+
+// Repair copies the entries the peer updated later into the store, last writer wins by LastUpdated and the origin
+// of the writes orders the ones made at the same time. It walks down the merkle trees of both stores and only reads
+// the nodes below the ones that differ, so only the keys in the buckets that differ get compared and only the entries
+// that differ get copied. The entries that the peer doesn't have stay, the tombstones of the peer aren't read,
+// but an entry that was deleted here after the peer wrote it stays deleted.
+func Repair(db persist.Store, peer string) (RepairResult, error) {
+	var result RepairResult
+	c, err := client.New(peer)
+	if err != nil {
+		return result, err
+	}
+	tree, err := db.MerkleTree()
+	if err != nil {
+		return result, err
+	}
+
+	differ := []int{0}
+	for level := 0; level <= persist.MerkleDepth && len(differ) > 0; level++ {
+		nodes := differ
+		if level > 0 {
+			nodes = nil
+			for _, parent := range differ {
+				for i := 0; i < persist.MerkleFanout; i++ {
+					nodes = append(nodes, parent*persist.MerkleFanout+i)
+				}
+			}
+		}
+
+		local := tree.Level(level)
+		differ = nil
+		for start := 0; start < len(nodes); start += merklePage {
+			remote, err := c.MerkleLevel(level, nodes[start:min(start+merklePage, len(nodes))])
+			if err != nil {
+				return result, err
+			}
+			if swag.Int64Value(remote.Depth) != persist.MerkleDepth || swag.Int64Value(remote.Fanout) != persist.MerkleFanout {
+				return result, fmt.Errorf("the merkle tree of %s has a depth of %d and a fanout of %d", peer,
+					swag.Int64Value(remote.Depth), swag.Int64Value(remote.Fanout))
+			}
+			for _, node := range remote.Nodes {
+				index := int(swag.Int64Value(node.Index))
+				if local[index] != swag.Uint64Value(node.Hash) {
+					differ = append(differ, index)
+				}
+			}
+		}
+	}
+
+	result.Buckets = len(differ)
+	for start := 0; start < len(differ); start += merklePage {
+		if err := repairBuckets(db, c, differ[start:min(start+merklePage, len(differ))], &result); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func repairBuckets(db persist.Store, c *client.KvStore, buckets []int, result *RepairResult) error {
+	remote, err := c.MerkleKeys(buckets)
+	if err != nil {
+		return err
+	}
+	keys, err := db.MerkleKeys(buckets)
+	if err != nil {
+		return err
+	}
+	local := make(map[string]persist.MerkleKey, len(keys))
+	for _, k := range keys {
+		local[k.Key] = k
+	}
+
+	for _, rk := range remote {
+		key, version, lastUpdated := swag.StringValue(rk.Key), swag.Uint64Value(rk.Version), swag.Int64Value(rk.LastUpdated)
+		lk, ok := local[key]
+		delete(local, key)
+		if ok && lk.Version == version {
+			continue
+		}
+		if ok && !persist.WrittenAfter(lastUpdated, rk.Origin, lk.LastUpdated, lk.Origin) {
+			result.Kept++
+			continue
+		}
+
+		entry, err := c.Get(key, 0)
+		if err == client.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		// the entry changed since it was listed, the next repair gets the new one
+		if entry.Version != version {
+			continue
+		}
+		copied, err := db.Merge(key, &persist.Value{Value: entry.Data, Version: version, LastUpdated: lastUpdated, Labels: entry.Labels, Origin: rk.Origin})
+		if err != nil {
+			return err
+		}
+		if copied {
+			result.Copied++
+		} else {
+			result.Kept++
+		}
+	}
+	result.Kept += len(local)
+	return nil
+}
+
+// Repairer repairs the store from its peers every interval
+type Repairer struct {
+	db       persist.Store
+	peers    []string
+	interval time.Duration
+	log      logrus.FieldLogger
+
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+// NewRepairer creates a repairer that repairs the store from the peers at the urls one after the other
+func NewRepairer(db persist.Store, peers []string, interval time.Duration, log logrus.FieldLogger) *Repairer {
+	return &Repairer{
+		db:       db,
+		peers:    peers,
+		interval: interval,
+		log:      log,
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// Start repairing in the background
+func (r *Repairer) Start() {
+	go r.run()
+}
+
+// Stop repairing, this waits for the repair that runs to finish
+func (r *Repairer) Stop() {
+	close(r.done)
+	<-r.stopped
+}
+
+func (r *Repairer) run() {
+	defer close(r.stopped)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+		}
+		for _, peer := range r.peers {
+			result, err := Repair(r.db, peer)
+			if err != nil {
+				r.log.Warnf("repairing from %s failed: %v", peer, err)
+				continue
+			}
+			if result.Buckets > 0 {
+				r.log.Infof("repaired %d buckets from %s, copied %d entries and kept %d", result.Buckets, peer, result.Copied, result.Kept)
+			}
+		}
+	}
+}
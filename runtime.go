@@ -1,9 +1,15 @@
 package kvstore
 
 import (
+	"path/filepath"
+	"time"
+
 	app "github.com/casualjim/go-app"
 	"github.com/casualjim/go-app/tracing"
+	"github.com/go-openapi/kvstore/api/client"
+	"github.com/go-openapi/kvstore/cluster"
 	"github.com/go-openapi/kvstore/persist"
+	"github.com/go-openapi/kvstore/replication"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
@@ -22,8 +28,13 @@ func NewRuntime(app app.Application) (*Runtime, error) {
 
 // Runtime encapsulates the shared services for this application
 type Runtime struct {
-	db  persist.Store
-	app app.Application
+	db       persist.Store
+	app      app.Application
+	follower *replication.Follower
+	peers    []*replication.Follower
+	cache    *replication.Cache
+	node     *cluster.Node
+	repairer *replication.Repairer
 }
 
 // DB returns the persistent store
@@ -31,6 +42,126 @@ func (r *Runtime) DB() persist.Store {
 	return r.db
 }
 
+// Follow makes the store a follower of the primary at the url, it starts applying the changes of the primary
+func (r *Runtime) Follow(primary string) error {
+	follower, err := replication.NewFollower(r.db, primary, r.Config().GetDuration("replication.poll_interval"), r.NewLogger("replication", nil))
+	if err != nil {
+		return err
+	}
+	r.follower = follower
+	follower.Start()
+	return nil
+}
+
+// Follower returns the follower of the primary, this is nil when the store is a primary
+func (r *Runtime) Follower() *replication.Follower {
+	return r.follower
+}
+
+// PeerWith makes the store a peer of the stores at the urls, the peers all take writes and merge each other's
+// changes. It starts merging the changes of every peer.
+func (r *Runtime) PeerWith(urls []string) error {
+	for _, url := range urls {
+		peer, err := replication.NewPeer(r.db, url, r.Config().GetDuration("replication.poll_interval"), r.NewLogger("replication", nil))
+		if err != nil {
+			return err
+		}
+		r.peers = append(r.peers, peer)
+		peer.Start()
+	}
+	return nil
+}
+
+// Peers returns the followers of the peers of the store, this is empty unless the store is a peer
+func (r *Runtime) Peers() []*replication.Follower {
+	return r.peers
+}
+
+// CacheFrom makes the store a cache of the upstream at the url, it starts following the changes of the upstream.
+// It uses the config cache.freshness as the time a copy is served without revalidating it, cache.poll_interval
+// as the time between reading the changelog of the upstream, cache.timeout as the timeout of the revalidations
+// and cache.max_entries as the number of entries it follows.
+func (r *Runtime) CacheFrom(upstream string) error {
+	cfg := r.Config()
+	cache, err := replication.NewCache(r.db, upstream, cfg.GetDuration("cache.freshness"), cfg.GetDuration("cache.poll_interval"),
+		cfg.GetDuration("cache.timeout"), cfg.GetInt("cache.max_entries"), r.NewLogger("cache", nil))
+	if err != nil {
+		return err
+	}
+	r.cache = cache
+	cache.Start()
+	return nil
+}
+
+// Cache returns the cache of the upstream, this is nil unless the store is a cache
+func (r *Runtime) Cache() *replication.Cache {
+	return r.cache
+}
+
+// RepairFrom repairs the store from the peers at the urls in the background, it uses the config
+// antientropy.interval as the time between the repairs
+func (r *Runtime) RepairFrom(peers []string) {
+	r.repairer = replication.NewRepairer(r.db, peers, r.Config().GetDuration("antientropy.interval"), r.NewLogger("antientropy", nil))
+	r.repairer.Start()
+}
+
+// StartCluster makes the store a member of a raft cluster, the committed writes get applied with the apply func.
+// With bootstrap set and no raft log yet it starts a new cluster with this member as its only member.
+func (r *Runtime) StartCluster(self cluster.Member, bootstrap bool, apply cluster.ApplyFunc) error {
+	cfg := r.Config()
+	node, err := cluster.NewNode(r.db, cluster.Config{
+		Self:              self,
+		Path:              filepath.Join(cfg.GetString("cluster.path"), self.ID),
+		Bootstrap:         bootstrap,
+		HeartbeatInterval: cfg.GetDuration("cluster.heartbeat_interval"),
+		ElectionTimeout:   cfg.GetDuration("cluster.election_timeout"),
+		SnapshotThreshold: uint64(cfg.GetInt64("cluster.snapshot_threshold")),
+		Log:               r.NewLogger("cluster", nil),
+	})
+	if err != nil {
+		return err
+	}
+	if err := node.Start(apply); err != nil {
+		node.Stop()
+		return err
+	}
+	r.node = node
+	return nil
+}
+
+// JoinCluster asks a member of the cluster at the url to add this member, it retries until this member is added
+func (r *Runtime) JoinCluster(url string, self cluster.Member) {
+	log := r.NewLogger("cluster", nil)
+	c, err := client.New(url)
+	if err != nil {
+		log.Errorf("joining the cluster at %s failed: %v", url, err)
+		return
+	}
+	for {
+		err := c.AddClusterMember(self.ID, self.PeerAddress, self.APIURL)
+		if err == nil {
+			return
+		}
+		// a member that exists was added before
+		if err == client.ErrMembershipConflict {
+			if status, serr := c.ClusterStatus(); serr == nil {
+				for _, m := range status.Members {
+					if m.ID != nil && *m.ID == self.ID {
+						return
+					}
+				}
+			}
+		}
+		log.Warnf("joining the cluster at %s failed, retrying: %v", url, err)
+		time.Sleep(time.Second)
+	}
+}
+
+// Cluster returns the member of the raft cluster, this is nil when the store is not in cluster mode
+func (r *Runtime) Cluster() *cluster.Node {
+	return r.node
+}
+
 // Tracer returns the root tracer, this is typically the only one you need
 func (r *Runtime) Tracer() tracing.Tracer {
 	return r.app.Tracer()